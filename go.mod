@@ -6,6 +6,7 @@ require (
 	github.com/aws/aws-sdk-go v1.37.0 // indirect
 	github.com/client9/misspell v0.3.4
 	github.com/golangci/golangci-lint v1.25.0
+	github.com/hashicorp/go-cty v1.4.1-0.20200414143053-d3edf31b6320
 	github.com/hashicorp/go-uuid v1.0.2
 	github.com/hashicorp/hcl/v2 v2.8.2 // indirect
 	github.com/hashicorp/terraform-plugin-sdk/v2 v2.10.1
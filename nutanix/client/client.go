@@ -13,6 +13,7 @@ import (
 	"net/http"
 	"net/url"
 	"os"
+	"strconv"
 	"strings"
 
 	"github.com/PaesslerAG/jsonpath"
@@ -77,6 +78,19 @@ type Credentials struct {
 	NdbPassword        string
 }
 
+// DefaultAPIPort is the port of the Prism Central API.
+const DefaultAPIPort = 9440
+
+// APIPort returns the configured port of the Prism Central API, used by the v4
+// clients, or DefaultAPIPort if it is not set or not a number.
+func (c Credentials) APIPort() int {
+	port, err := strconv.Atoi(c.Port)
+	if err != nil || port <= 0 {
+		return DefaultAPIPort
+	}
+	return port
+}
+
 // AdditionalFilter specification for client side filters
 type AdditionalFilter struct {
 	Name   string
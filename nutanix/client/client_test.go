@@ -50,6 +50,14 @@ func TestNewClient(t *testing.T) {
 	}
 }
 
+func TestCredentials_APIPort(t *testing.T) {
+	for port, want := range map[string]int{"": DefaultAPIPort, "9441": 9441, "abc": DefaultAPIPort, "0": DefaultAPIPort} {
+		if got := (Credentials{Port: port}).APIPort(); got != want {
+			t.Errorf("APIPort() with port %q = %d, want %d", port, got, want)
+		}
+	}
+}
+
 func TestNewBaseClient(t *testing.T) {
 	c, err := NewBaseClient(&Credentials{"foo.com", "username", "password", "", "", true, false, "", "", "", nil, "", "", ""}, testAbsolutePath, true)
 	if err != nil {
@@ -2,6 +2,7 @@ package nutanix
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/terraform-providers/terraform-provider-nutanix/nutanix/client"
 	era "github.com/terraform-providers/terraform-provider-nutanix/nutanix/sdks/v3/era"
@@ -17,6 +18,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-nutanix/nutanix/sdks/v4/prism"
 	"github.com/terraform-providers/terraform-provider-nutanix/nutanix/sdks/v4/vmm"
 	"github.com/terraform-providers/terraform-provider-nutanix/nutanix/sdks/v4/volumes"
+	"github.com/terraform-providers/terraform-provider-nutanix/utils"
 )
 
 // Version represents api version
@@ -24,20 +26,24 @@ import (
 
 // Config ...
 type Config struct {
-	Endpoint           string
-	Username           string
-	Password           string
-	Port               string
-	Insecure           bool
-	SessionAuth        bool
-	WaitTimeout        int64
-	ProxyURL           string
-	FoundationEndpoint string              // Required field for connecting to foundation VM APIs
-	FoundationPort     string              // Port for connecting to foundation VM APIs
-	RequiredFields     map[string][]string // RequiredFields is client name to its required fields mapping for validations and usage in every client
-	NdbEndpoint        string
-	NdbUsername        string
-	NdbPassword        string
+	Endpoint              string
+	Username              string
+	Password              string
+	Port                  string
+	Insecure              bool
+	SessionAuth           bool
+	WaitTimeout           int64
+	ProxyURL              string
+	FoundationEndpoint    string              // Required field for connecting to foundation VM APIs
+	FoundationPort        string              // Port for connecting to foundation VM APIs
+	RequiredFields        map[string][]string // RequiredFields is client name to its required fields mapping for validations and usage in every client
+	NdbEndpoint           string
+	NdbUsername           string
+	NdbPassword           string
+	MaxParallelTasks      int    // MaxParallelTasks bounds the number of nutanix_users_v2 creations and updates run at the same time, 0 means no limit
+	DefaultClusterExtID   string // DefaultClusterExtID is the cluster used by v4 resources which do not set one
+	TaskPollBatchInterval int    // TaskPollBatchInterval is the interval in seconds v4 task polls are batched over, 0 disables batching
+	LogFormat             string // LogFormat is the format of the provider log lines, text or json
 }
 
 // Client ...
@@ -91,6 +97,8 @@ func (c *Config) Client() (*Client, error) {
 	if err != nil {
 		return nil, err
 	}
+	prismClient.TaskWatcher = utils.NewTaskWatcher(prismClient.ListTasksByExtIDs, time.Duration(c.TaskPollBatchInterval)*time.Second)
+	prismClient.LogFormat = c.LogFormat
 	microsegClient, err := microseg.NewMicrosegClient(configCreds)
 	if err != nil {
 		return nil, err
@@ -126,6 +134,10 @@ func (c *Config) Client() (*Client, error) {
 		VolumeAPI:           volumeClient,
 		DataProtectionAPI:   dataprotectionClient,
 		VmmAPI:              vmmClient,
+		TaskLimiter:         utils.NewParallelLimiter(c.MaxParallelTasks),
+		DefaultClusterExtID: c.DefaultClusterExtID,
+		LogFormat:           c.LogFormat,
+		TargetPortalCache:   utils.NewCache(),
 	}, nil
 }
 
@@ -145,4 +157,24 @@ type Client struct {
 	VolumeAPI           *volumes.Client
 	DataProtectionAPI   *dataprotection.Client
 	VmmAPI              *vmm.Client
+	TaskLimiter         *utils.ParallelLimiter
+	DefaultClusterExtID string
+	LogFormat           string
+	// TargetPortalCache keeps the iSCSI target portals of each cluster, keyed
+	// by the cluster ext_id, so that every Volume Group refresh does not read
+	// the cluster again.
+	TargetPortalCache *utils.Cache
+}
+
+// ClusterExtIDOrDefault returns clusterExtID, or the provider default_cluster_ext_id
+// when the resource does not set one. attribute is the name of the resource
+// argument, used in the error when neither is set.
+func (c *Client) ClusterExtIDOrDefault(clusterExtID, attribute string) (string, error) {
+	if clusterExtID != "" {
+		return clusterExtID, nil
+	}
+	if c.DefaultClusterExtID != "" {
+		return c.DefaultClusterExtID, nil
+	}
+	return "", fmt.Errorf("%s is not set and no default_cluster_ext_id is configured on the provider", attribute)
 }
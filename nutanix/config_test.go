@@ -74,3 +74,51 @@ func TestConfig_Client(t *testing.T) {
 		})
 	}
 }
+
+func TestClient_ClusterExtIDOrDefault(t *testing.T) {
+	c := &Client{}
+	if _, err := c.ClusterExtIDOrDefault("", "cluster_ext_id"); err == nil {
+		t.Errorf("expected an error without cluster and default cluster")
+	}
+	if got, _ := c.ClusterExtIDOrDefault("a", "cluster_ext_id"); got != "a" {
+		t.Errorf("got %q, want the explicit cluster", got)
+	}
+
+	c.DefaultClusterExtID = "default"
+	if got, _ := c.ClusterExtIDOrDefault("", "cluster_ext_id"); got != "default" {
+		t.Errorf("got %q, want the default cluster", got)
+	}
+	if got, _ := c.ClusterExtIDOrDefault("a", "cluster_ext_id"); got != "a" {
+		t.Errorf("got %q, explicit cluster must take precedence", got)
+	}
+}
+
+func TestConfig_ClientPerEndpoint(t *testing.T) {
+	source, err := (&Config{Endpoint: "10.0.0.1", Username: "source", Password: "source", Port: "9440"}).Client()
+	if err != nil {
+		t.Fatalf("failed to create source client: %s", err)
+	}
+	target, err := (&Config{Endpoint: "10.0.0.2", Username: "target", Password: "target", Port: "9441"}).Client()
+	if err != nil {
+		t.Fatalf("failed to create target client: %s", err)
+	}
+
+	for name, c := range map[string]struct {
+		client         *Client
+		host, username string
+		port           int
+	}{
+		"source": {source, "10.0.0.1", "source", 9440},
+		"target": {target, "10.0.0.2", "target", 9441},
+	} {
+		apiClient := c.client.DataProtectionAPI.RecoveryPoint.ApiClient
+		if apiClient.Host != c.host || apiClient.Username != c.username || apiClient.Port != c.port {
+			t.Errorf("%s data protection client targets %s@%s:%d, want %s@%s:%d",
+				name, apiClient.Username, apiClient.Host, apiClient.Port, c.username, c.host, c.port)
+		}
+		prismClient := c.client.PrismAPI.TaskRefAPI.ApiClient
+		if prismClient.Host != c.host || prismClient.Port != c.port {
+			t.Errorf("%s prism client targets %s:%d, want %s:%d", name, prismClient.Host, prismClient.Port, c.host, c.port)
+		}
+	}
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	conns "github.com/terraform-providers/terraform-provider-nutanix/nutanix"
 	"github.com/terraform-providers/terraform-provider-nutanix/nutanix/internal"
 	"github.com/terraform-providers/terraform-provider-nutanix/nutanix/services/clusters"
@@ -27,6 +28,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-nutanix/nutanix/services/vmm"
 	"github.com/terraform-providers/terraform-provider-nutanix/nutanix/services/vmmv2"
 	"github.com/terraform-providers/terraform-provider-nutanix/nutanix/services/volumesv2"
+	"github.com/terraform-providers/terraform-provider-nutanix/utils"
 )
 
 var requiredProviderFields map[string][]string = map[string][]string{
@@ -65,6 +67,18 @@ func Provider() *schema.Provider {
 		"foundation_port": "Port for foundation VM",
 
 		"ndb_endpoint": "endpoint for Era VM (era ip)",
+
+		"max_parallel_tasks": "Maximum number of nutanix_users_v2 creations and updates the provider runs at the same time, other resources are not limited. " +
+			"Default value is `0`, which means no limit",
+
+		"log_format": "Format of the provider log lines for task polling and CRUD operations. Valid values are `text` and `json`, " +
+			"default value is `text`",
+
+		"default_cluster_ext_id": "External identifier of the cluster used by v4 resources, such as volume groups and storage containers, " +
+			"which do not set their own cluster",
+
+		"task_poll_batch_interval": "Interval in seconds over which the polls of running v4 tasks are batched into a single request. " +
+			"Default value is `0`, which polls every task on its own",
 	}
 
 	// Nutanix provider schema
@@ -150,145 +164,183 @@ func Provider() *schema.Provider {
 				DefaultFunc: schema.EnvDefaultFunc("NDB_PASSWORD", nil),
 				Description: descriptions["ndb_password"],
 			},
+			"max_parallel_tasks": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("NUTANIX_MAX_PARALLEL_TASKS", 0),
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  descriptions["max_parallel_tasks"],
+			},
+			"log_format": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("NUTANIX_LOG_FORMAT", utils.LogFormatText),
+				ValidateFunc: validation.StringInSlice([]string{utils.LogFormatText, utils.LogFormatJSON}, false),
+				Description:  descriptions["log_format"],
+			},
+			"default_cluster_ext_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("NUTANIX_DEFAULT_CLUSTER_EXT_ID", ""),
+				Description: descriptions["default_cluster_ext_id"],
+			},
+			"task_poll_batch_interval": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("NUTANIX_TASK_POLL_BATCH_INTERVAL", 0),
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  descriptions["task_poll_batch_interval"],
+			},
 		},
 		DataSourcesMap: map[string]*schema.Resource{
-			"nutanix_image":                                   vmm.DataSourceNutanixImage(),
-			"nutanix_subnet":                                  networking.DataSourceNutanixSubnet(),
-			"nutanix_subnets":                                 networking.DataSourceNutanixSubnets(),
-			"nutanix_cluster":                                 clusters.DataSourceNutanixCluster(),
-			"nutanix_clusters":                                clusters.DataSourceNutanixClusters(),
-			"nutanix_virtual_machine":                         vmm.DataSourceNutanixVirtualMachine(),
-			"nutanix_category_key":                            prism.DataSourceNutanixCategoryKey(),
-			"nutanix_network_security_rule":                   networking.DataSourceNutanixNetworkSecurityRule(),
-			"nutanix_host":                                    clusters.DataSourceNutanixHost(),
-			"nutanix_hosts":                                   clusters.DataSourceNutanixHosts(),
-			"nutanix_access_control_policy":                   iam.DataSourceNutanixAccessControlPolicy(),
-			"nutanix_access_control_policies":                 iam.DataSourceNutanixAccessControlPolicies(),
-			"nutanix_project":                                 prism.DataSourceNutanixProject(),
-			"nutanix_projects":                                prism.DataSourceNutanixProjects(),
-			"nutanix_role":                                    iam.DataSourceNutanixRole(),
-			"nutanix_roles":                                   iam.DataSourceNutanixRoles(),
-			"nutanix_user":                                    iam.DataSourceNutanixUser(),
-			"nutanix_user_group":                              iam.DataSourceNutanixUserGroup(),
-			"nutanix_users":                                   iam.DataSourceNutanixUsers(),
-			"nutanix_user_groups":                             iam.DataSourceNutanixUserGroups(),
-			"nutanix_permission":                              iam.DataSourceNutanixPermission(),
-			"nutanix_permissions":                             iam.DataSourceNutanixPermissions(),
-			"nutanix_karbon_cluster_kubeconfig":               nke.DataSourceNutanixKarbonClusterKubeconfig(),
-			"nutanix_karbon_cluster":                          nke.DataSourceNutanixKarbonCluster(),
-			"nutanix_karbon_clusters":                         nke.DataSourceNutanixKarbonClusters(),
-			"nutanix_karbon_cluster_ssh":                      nke.DataSourceNutanixKarbonClusterSSH(),
-			"nutanix_karbon_private_registry":                 nke.DataSourceNutanixKarbonPrivateRegistry(),
-			"nutanix_karbon_private_registries":               nke.DataSourceNutanixKarbonPrivateRegistries(),
-			"nutanix_protection_rule":                         prism.DataSourceNutanixProtectionRule(),
-			"nutanix_protection_rules":                        prism.DataSourceNutanixProtectionRules(),
-			"nutanix_recovery_plan":                           prism.DataSourceNutanixRecoveryPlan(),
-			"nutanix_recovery_plans":                          prism.DataSourceNutanixRecoveryPlans(),
-			"nutanix_address_groups":                          networking.DataSourceNutanixAddressGroups(),
-			"nutanix_address_group":                           networking.DataSourceNutanixAddressGroup(),
-			"nutanix_service_group":                           networking.DataSourceNutanixServiceGroup(),
-			"nutanix_service_groups":                          networking.DataSourceNutanixServiceGroups(),
-			"nutanix_foundation_hypervisor_isos":              foundation.DataSourceFoundationHypervisorIsos(),
-			"nutanix_foundation_discover_nodes":               foundation.DataSourceFoundationDiscoverNodes(),
-			"nutanix_foundation_nos_packages":                 foundation.DataSourceFoundationNOSPackages(),
-			"nutanix_foundation_node_network_details":         foundation.DataSourceNodeNetworkDetails(),
-			"nutanix_assert_helper":                           internal.DataSourceAssertHelper(),
-			"nutanix_foundation_central_api_keys":             foundationCentral.DataSourceNutanixFCAPIKeys(),
-			"nutanix_foundation_central_list_api_keys":        foundationCentral.DataSourceNutanixFCListAPIKeys(),
-			"nutanix_foundation_central_imaged_nodes_list":    foundationCentral.DataSourceNutanixFCImagedNodesList(),
-			"nutanix_foundation_central_imaged_clusters_list": foundationCentral.DataSourceNutanixFCImagedClustersList(),
-			"nutanix_foundation_central_cluster_details":      foundationCentral.DataSourceNutanixFCClusterDetails(),
-			"nutanix_foundation_central_imaged_node_details":  foundationCentral.DataSourceFCImagedNodeDetails(),
-			"nutanix_vpc":                                     networking.DataSourceNutanixVPC(),
-			"nutanix_vpcs":                                    networking.DataSourceNutanixVPCs(),
-			"nutanix_pbr":                                     networking.DataSourceNutanixPbr(),
-			"nutanix_pbrs":                                    networking.DataSourceNutanixPbrs(),
-			"nutanix_floating_ip":                             networking.DataSourceNutanixFloatingIP(),
-			"nutanix_floating_ips":                            networking.DataSourceNutanixFloatingIPs(),
-			"nutanix_static_routes":                           networking.DataSourceNutanixStaticRoute(),
-			"nutanix_ndb_sla":                                 ndb.DataSourceNutanixEraSLA(),
-			"nutanix_ndb_slas":                                ndb.DataSourceNutanixEraSLAs(),
-			"nutanix_ndb_profile":                             ndb.DataSourceNutanixEraProfile(),
-			"nutanix_ndb_profiles":                            ndb.DataSourceNutanixEraProfiles(),
-			"nutanix_ndb_cluster":                             ndb.DataSourceNutanixEraCluster(),
-			"nutanix_ndb_clusters":                            ndb.DataSourceNutanixEraClusters(),
-			"nutanix_ndb_database":                            ndb.DataSourceNutanixEraDatabase(),
-			"nutanix_ndb_databases":                           ndb.DataSourceNutanixEraDatabases(),
-			"nutanix_ndb_time_machine":                        ndb.DataSourceNutanixNDBTimeMachine(),
-			"nutanix_ndb_time_machines":                       ndb.DataSourceNutanixNDBTimeMachines(),
-			"nutanix_ndb_clone":                               ndb.DataSourceNutanixNDBClone(),
-			"nutanix_ndb_clones":                              ndb.DataSourceNutanixNDBClones(),
-			"nutanix_ndb_snapshot":                            ndb.DataSourceNutanixNDBSnapshot(),
-			"nutanix_ndb_snapshots":                           ndb.DataSourceNutanixNDBSnapshots(),
-			"nutanix_ndb_tms_capability":                      ndb.DataSourceNutanixNDBTmsCapability(),
-			"nutanix_ndb_maintenance_window":                  ndb.DataSourceNutanixNDBMaintenanceWindow(),
-			"nutanix_ndb_maintenance_windows":                 ndb.DataSourceNutanixNDBMaintenanceWindows(),
-			"nutanix_ndb_tag":                                 ndb.DataSourceNutanixNDBTag(),
-			"nutanix_ndb_tags":                                ndb.DataSourceNutanixNDBTags(),
-			"nutanix_ndb_network":                             ndb.DataSourceNutanixEraNetwork(),
-			"nutanix_ndb_networks":                            ndb.DataSourceNutanixEraNetworks(),
-			"nutanix_ndb_dbserver":                            ndb.DataSourceNutanixNDBDBServer(),
-			"nutanix_ndb_dbservers":                           ndb.DataSourceNutanixNDBDBServers(),
-			"nutanix_ndb_network_available_ips":               ndb.DataSourceNutanixNDBProfileAvailableIPs(),
-			"nutanix_subnet_v2":                               networkingv2.DataSourceNutanixSubnetV2(),
-			"nutanix_subnets_v2":                              networkingv2.DataSourceNutanixSubnetsV2(),
-			"nutanix_vpc_v2":                                  networkingv2.DataSourceNutanixVPCv2(),
-			"nutanix_vpcs_v2":                                 networkingv2.DataSourceNutanixVPCsv2(),
-			"nutanix_floating_ip_v2":                          networkingv2.DatasourceNutanixFloatingIPV2(),
-			"nutanix_floating_ips_v2":                         networkingv2.DatasourceNutanixFloatingIPsV2(),
-			"nutanix_network_security_policy_v2":              networkingv2.DataSourceNutanixNetworkSecurityPolicyV2(),
-			"nutanix_network_security_policies_v2":            networkingv2.DataSourceNutanixNetworkSecurityPoliciesV2(),
-			"nutanix_route_table_v2":                          networkingv2.DatasourceNutanixRouteTableV2(),
-			"nutanix_route_tables_v2":                         networkingv2.DatasourceNutanixRouteTablesV2(),
-			"nutanix_route_v2":                                networkingv2.DatasourceNutanixRouteV2(),
-			"nutanix_routes_v2":                               networkingv2.DatasourceNutanixRoutesV2(),
-			"nutanix_pbr_v2":                                  networkingv2.DatasourceNutanixPbrV2(),
-			"nutanix_pbrs_v2":                                 networkingv2.DatasourceNutanixPbrsV2(),
-			"nutanix_service_group_v2":                        networkingv2.DatasourceNutanixServiceGroupV2(),
-			"nutanix_service_groups_v2":                       networkingv2.DatasourceNutanixServiceGroupsV2(),
-			"nutanix_address_group_v2":                        networkingv2.DatasourceNutanixAddressGroupV2(),
-			"nutanix_address_groups_v2":                       networkingv2.DatasourceNutanixAddressGroupsV2(),
-			"nutanix_directory_service_v2":                    iamv2.DatasourceNutanixDirectoryServiceV2(),
-			"nutanix_directory_services_v2":                   iamv2.DatasourceNutanixDirectoryServicesV2(),
-			"nutanix_saml_identity_provider_v2":               iamv2.DatasourceNutanixSamlIDPV2(),
-			"nutanix_saml_identity_providers_v2":              iamv2.DatasourceNutanixSamlIDPsV2(),
-			"nutanix_user_group_v2":                           iamv2.DatasourceNutanixUserGroupV2(),
-			"nutanix_user_groups_v2":                          iamv2.DatasourceNutanixUserGroupsV2(),
-			"nutanix_roles_v2":                                iamv2.DatasourceNutanixRolesV2(),
-			"nutanix_role_v2":                                 iamv2.DatasourceNutanixRoleV2(),
-			"nutanix_operation_v2":                            iamv2.DatasourceNutanixOperationV2(),
-			"nutanix_operations_v2":                           iamv2.DatasourceNutanixOperationsV2(),
-			"nutanix_user_v2":                                 iamv2.DatasourceNutanixUserV2(),
-			"nutanix_users_v2":                                iamv2.DatasourceNutanixUsersV2(),
-			"nutanix_authorization_policy_v2":                 iamv2.DatasourceNutanixAuthorizationPolicyV2(),
-			"nutanix_authorization_policies_v2":               iamv2.DatasourceNutanixAuthorizationPoliciesV2(),
-			"nutanix_storage_container_v2":                    storagecontainersv2.DatasourceNutanixStorageContainerV2(),
-			"nutanix_storage_containers_v2":                   storagecontainersv2.DatasourceNutanixStorageContainersV2(),
-			"nutanix_storage_container_stats_info_v2":         storagecontainersv2.DatasourceNutanixStorageStatsInfoV2(),
-			"nutanix_category_v2":                             prismv2.DatasourceNutanixCategoryV2(),
-			"nutanix_categories_v2":                           prismv2.DatasourceNutanixCategoriesV2(),
-			"nutanix_volume_groups_v2":                        volumesv2.DatasourceNutanixVolumeGroupsV2(),
-			"nutanix_volume_group_v2":                         volumesv2.DatasourceNutanixVolumeGroupV2(),
-			"nutanix_volume_group_disks_v2":                   volumesv2.DatasourceNutanixVolumeDisksV2(),
-			"nutanix_volume_group_disk_v2":                    volumesv2.DatasourceNutanixVolumeDiskV2(),
-			"nutanix_volume_iscsi_clients_v2":                 volumesv2.DatasourceNutanixVolumeIscsiClientsV2(),
-			"nutanix_volume_iscsi_client_v2":                  volumesv2.DatasourceNutanixVolumeIscsiClientV2(),
-			"nutanix_recovery_point_v2":                       dataprotectionv2.DatasourceNutanixRecoveryPointV2(),
-			"nutanix_recovery_points_v2":                      dataprotectionv2.DatasourceNutanixRecoveryPointsV2(),
-			"nutanix_vm_recovery_point_info_v2":               dataprotectionv2.DatasourceNutanixVMRecoveryPointInfoV2(),
-			"nutanix_image_v2":                                vmmv2.DatasourceNutanixImageV4(),
-			"nutanix_images_v2":                               vmmv2.DatasourceNutanixImagesV4(),
-			"nutanix_virtual_machine_v2":                      vmmv2.DatasourceNutanixVirtualMachineV4(),
-			"nutanix_virtual_machines_v2":                     vmmv2.DatasourceNutanixVirtualMachinesV4(),
-			"nutanix_template_v2":                             vmmv2.DatasourceNutanixTemplateV2(),
-			"nutanix_templates_v2":                            vmmv2.DatasourceNutanixTemplatesV2(),
-			"nutanix_ngt_configuration_v2":                    vmmv2.DatasourceNutanixNGTConfigurationV4(),
-			"nutanix_image_placement_policy_v2":               vmmv2.DatasourceNutanixImagePlacementV4(),
-			"nutanix_image_placement_policies_v2":             vmmv2.DatasourceNutanixImagePlacementsV4(),
-			"nutanix_cluster_v2":                              clustersv2.DatasourceNutanixClusterEntityV2(),
-			"nutanix_clusters_v2":                             clustersv2.DatasourceNutanixClusterEntitiesV2(),
-			"nutanix_host_v2":                                 clustersv2.DatasourceNutanixHostEntityV2(),
-			"nutanix_hosts_v2":                                clustersv2.DatasourceNutanixHostEntitiesV2(),
+			"nutanix_image":                                      vmm.DataSourceNutanixImage(),
+			"nutanix_subnet":                                     networking.DataSourceNutanixSubnet(),
+			"nutanix_subnets":                                    networking.DataSourceNutanixSubnets(),
+			"nutanix_cluster":                                    clusters.DataSourceNutanixCluster(),
+			"nutanix_clusters":                                   clusters.DataSourceNutanixClusters(),
+			"nutanix_virtual_machine":                            vmm.DataSourceNutanixVirtualMachine(),
+			"nutanix_category_key":                               prism.DataSourceNutanixCategoryKey(),
+			"nutanix_network_security_rule":                      networking.DataSourceNutanixNetworkSecurityRule(),
+			"nutanix_host":                                       clusters.DataSourceNutanixHost(),
+			"nutanix_hosts":                                      clusters.DataSourceNutanixHosts(),
+			"nutanix_access_control_policy":                      iam.DataSourceNutanixAccessControlPolicy(),
+			"nutanix_access_control_policies":                    iam.DataSourceNutanixAccessControlPolicies(),
+			"nutanix_project":                                    prism.DataSourceNutanixProject(),
+			"nutanix_projects":                                   prism.DataSourceNutanixProjects(),
+			"nutanix_role":                                       iam.DataSourceNutanixRole(),
+			"nutanix_roles":                                      iam.DataSourceNutanixRoles(),
+			"nutanix_user":                                       iam.DataSourceNutanixUser(),
+			"nutanix_user_group":                                 iam.DataSourceNutanixUserGroup(),
+			"nutanix_iam_directory_service_user_principal":       iam.DataSourceNutanixIamDirectoryServiceUserPrincipal(),
+			"nutanix_users":                                      iam.DataSourceNutanixUsers(),
+			"nutanix_user_groups":                                iam.DataSourceNutanixUserGroups(),
+			"nutanix_permission":                                 iam.DataSourceNutanixPermission(),
+			"nutanix_permissions":                                iam.DataSourceNutanixPermissions(),
+			"nutanix_karbon_cluster_kubeconfig":                  nke.DataSourceNutanixKarbonClusterKubeconfig(),
+			"nutanix_karbon_cluster":                             nke.DataSourceNutanixKarbonCluster(),
+			"nutanix_karbon_clusters":                            nke.DataSourceNutanixKarbonClusters(),
+			"nutanix_karbon_cluster_ssh":                         nke.DataSourceNutanixKarbonClusterSSH(),
+			"nutanix_karbon_private_registry":                    nke.DataSourceNutanixKarbonPrivateRegistry(),
+			"nutanix_karbon_private_registries":                  nke.DataSourceNutanixKarbonPrivateRegistries(),
+			"nutanix_protection_rule":                            prism.DataSourceNutanixProtectionRule(),
+			"nutanix_protection_rules":                           prism.DataSourceNutanixProtectionRules(),
+			"nutanix_recovery_plan":                              prism.DataSourceNutanixRecoveryPlan(),
+			"nutanix_recovery_plans":                             prism.DataSourceNutanixRecoveryPlans(),
+			"nutanix_address_groups":                             networking.DataSourceNutanixAddressGroups(),
+			"nutanix_address_group":                              networking.DataSourceNutanixAddressGroup(),
+			"nutanix_service_group":                              networking.DataSourceNutanixServiceGroup(),
+			"nutanix_service_groups":                             networking.DataSourceNutanixServiceGroups(),
+			"nutanix_foundation_hypervisor_isos":                 foundation.DataSourceFoundationHypervisorIsos(),
+			"nutanix_foundation_discover_nodes":                  foundation.DataSourceFoundationDiscoverNodes(),
+			"nutanix_foundation_nos_packages":                    foundation.DataSourceFoundationNOSPackages(),
+			"nutanix_foundation_node_network_details":            foundation.DataSourceNodeNetworkDetails(),
+			"nutanix_assert_helper":                              internal.DataSourceAssertHelper(),
+			"nutanix_foundation_central_api_keys":                foundationCentral.DataSourceNutanixFCAPIKeys(),
+			"nutanix_foundation_central_list_api_keys":           foundationCentral.DataSourceNutanixFCListAPIKeys(),
+			"nutanix_foundation_central_imaged_nodes_list":       foundationCentral.DataSourceNutanixFCImagedNodesList(),
+			"nutanix_foundation_central_imaged_clusters_list":    foundationCentral.DataSourceNutanixFCImagedClustersList(),
+			"nutanix_foundation_central_cluster_details":         foundationCentral.DataSourceNutanixFCClusterDetails(),
+			"nutanix_foundation_central_imaged_node_details":     foundationCentral.DataSourceFCImagedNodeDetails(),
+			"nutanix_vpc":                                        networking.DataSourceNutanixVPC(),
+			"nutanix_vpcs":                                       networking.DataSourceNutanixVPCs(),
+			"nutanix_pbr":                                        networking.DataSourceNutanixPbr(),
+			"nutanix_pbrs":                                       networking.DataSourceNutanixPbrs(),
+			"nutanix_floating_ip":                                networking.DataSourceNutanixFloatingIP(),
+			"nutanix_floating_ips":                               networking.DataSourceNutanixFloatingIPs(),
+			"nutanix_static_routes":                              networking.DataSourceNutanixStaticRoute(),
+			"nutanix_ndb_sla":                                    ndb.DataSourceNutanixEraSLA(),
+			"nutanix_ndb_slas":                                   ndb.DataSourceNutanixEraSLAs(),
+			"nutanix_ndb_profile":                                ndb.DataSourceNutanixEraProfile(),
+			"nutanix_ndb_profiles":                               ndb.DataSourceNutanixEraProfiles(),
+			"nutanix_ndb_cluster":                                ndb.DataSourceNutanixEraCluster(),
+			"nutanix_ndb_clusters":                               ndb.DataSourceNutanixEraClusters(),
+			"nutanix_ndb_database":                               ndb.DataSourceNutanixEraDatabase(),
+			"nutanix_ndb_databases":                              ndb.DataSourceNutanixEraDatabases(),
+			"nutanix_ndb_time_machine":                           ndb.DataSourceNutanixNDBTimeMachine(),
+			"nutanix_ndb_time_machines":                          ndb.DataSourceNutanixNDBTimeMachines(),
+			"nutanix_ndb_clone":                                  ndb.DataSourceNutanixNDBClone(),
+			"nutanix_ndb_clones":                                 ndb.DataSourceNutanixNDBClones(),
+			"nutanix_ndb_snapshot":                               ndb.DataSourceNutanixNDBSnapshot(),
+			"nutanix_ndb_snapshots":                              ndb.DataSourceNutanixNDBSnapshots(),
+			"nutanix_ndb_tms_capability":                         ndb.DataSourceNutanixNDBTmsCapability(),
+			"nutanix_ndb_maintenance_window":                     ndb.DataSourceNutanixNDBMaintenanceWindow(),
+			"nutanix_ndb_maintenance_windows":                    ndb.DataSourceNutanixNDBMaintenanceWindows(),
+			"nutanix_ndb_tag":                                    ndb.DataSourceNutanixNDBTag(),
+			"nutanix_ndb_tags":                                   ndb.DataSourceNutanixNDBTags(),
+			"nutanix_ndb_network":                                ndb.DataSourceNutanixEraNetwork(),
+			"nutanix_ndb_networks":                               ndb.DataSourceNutanixEraNetworks(),
+			"nutanix_ndb_dbserver":                               ndb.DataSourceNutanixNDBDBServer(),
+			"nutanix_ndb_dbservers":                              ndb.DataSourceNutanixNDBDBServers(),
+			"nutanix_ndb_network_available_ips":                  ndb.DataSourceNutanixNDBProfileAvailableIPs(),
+			"nutanix_subnet_v2":                                  networkingv2.DataSourceNutanixSubnetV2(),
+			"nutanix_subnets_v2":                                 networkingv2.DataSourceNutanixSubnetsV2(),
+			"nutanix_vpc_v2":                                     networkingv2.DataSourceNutanixVPCv2(),
+			"nutanix_vpcs_v2":                                    networkingv2.DataSourceNutanixVPCsv2(),
+			"nutanix_floating_ip_v2":                             networkingv2.DatasourceNutanixFloatingIPV2(),
+			"nutanix_floating_ips_v2":                            networkingv2.DatasourceNutanixFloatingIPsV2(),
+			"nutanix_network_security_policy_v2":                 networkingv2.DataSourceNutanixNetworkSecurityPolicyV2(),
+			"nutanix_network_security_policies_v2":               networkingv2.DataSourceNutanixNetworkSecurityPoliciesV2(),
+			"nutanix_route_table_v2":                             networkingv2.DatasourceNutanixRouteTableV2(),
+			"nutanix_route_tables_v2":                            networkingv2.DatasourceNutanixRouteTablesV2(),
+			"nutanix_route_v2":                                   networkingv2.DatasourceNutanixRouteV2(),
+			"nutanix_routes_v2":                                  networkingv2.DatasourceNutanixRoutesV2(),
+			"nutanix_pbr_v2":                                     networkingv2.DatasourceNutanixPbrV2(),
+			"nutanix_pbrs_v2":                                    networkingv2.DatasourceNutanixPbrsV2(),
+			"nutanix_service_group_v2":                           networkingv2.DatasourceNutanixServiceGroupV2(),
+			"nutanix_service_groups_v2":                          networkingv2.DatasourceNutanixServiceGroupsV2(),
+			"nutanix_address_group_v2":                           networkingv2.DatasourceNutanixAddressGroupV2(),
+			"nutanix_address_groups_v2":                          networkingv2.DatasourceNutanixAddressGroupsV2(),
+			"nutanix_directory_service_v2":                       iamv2.DatasourceNutanixDirectoryServiceV2(),
+			"nutanix_directory_services_v2":                      iamv2.DatasourceNutanixDirectoryServicesV2(),
+			"nutanix_saml_identity_provider_v2":                  iamv2.DatasourceNutanixSamlIDPV2(),
+			"nutanix_saml_identity_providers_v2":                 iamv2.DatasourceNutanixSamlIDPsV2(),
+			"nutanix_user_group_v2":                              iamv2.DatasourceNutanixUserGroupV2(),
+			"nutanix_user_groups_v2":                             iamv2.DatasourceNutanixUserGroupsV2(),
+			"nutanix_roles_v2":                                   iamv2.DatasourceNutanixRolesV2(),
+			"nutanix_role_v2":                                    iamv2.DatasourceNutanixRoleV2(),
+			"nutanix_operation_v2":                               iamv2.DatasourceNutanixOperationV2(),
+			"nutanix_operations_v2":                              iamv2.DatasourceNutanixOperationsV2(),
+			"nutanix_entity_types_v2":                            iamv2.DatasourceNutanixEntityTypesV2(),
+			"nutanix_operations_by_entity_v2":                    iamv2.DatasourceNutanixOperationsByEntityV2(),
+			"nutanix_user_v2":                                    iamv2.DatasourceNutanixUserV2(),
+			"nutanix_users_v2":                                   iamv2.DatasourceNutanixUsersV2(),
+			"nutanix_user_effective_permissions_v2":              iamv2.DatasourceNutanixUserEffectivePermissionsV2(),
+			"nutanix_current_user_v2":                            iamv2.DatasourceNutanixCurrentUserV2(),
+			"nutanix_authorization_policy_v2":                    iamv2.DatasourceNutanixAuthorizationPolicyV2(),
+			"nutanix_authorization_policies_v2":                  iamv2.DatasourceNutanixAuthorizationPoliciesV2(),
+			"nutanix_storage_container_v2":                       storagecontainersv2.DatasourceNutanixStorageContainerV2(),
+			"nutanix_storage_containers_v2":                      storagecontainersv2.DatasourceNutanixStorageContainersV2(),
+			"nutanix_storage_container_stats_info_v2":            storagecontainersv2.DatasourceNutanixStorageStatsInfoV2(),
+			"nutanix_category_v2":                                prismv2.DatasourceNutanixCategoryV2(),
+			"nutanix_categories_v2":                              prismv2.DatasourceNutanixCategoriesV2(),
+			"nutanix_volume_groups_v2":                           volumesv2.DatasourceNutanixVolumeGroupsV2(),
+			"nutanix_volume_group_v2":                            volumesv2.DatasourceNutanixVolumeGroupV2(),
+			"nutanix_volume_group_stats_v2":                      volumesv2.DatasourceNutanixVolumeGroupStatsV2(),
+			"nutanix_volume_group_disks_v2":                      volumesv2.DatasourceNutanixVolumeDisksV2(),
+			"nutanix_volume_group_disk_v2":                       volumesv2.DatasourceNutanixVolumeDiskV2(),
+			"nutanix_volume_group_iscsi_clients_v2":              volumesv2.DatasourceNutanixVolumeGroupIscsiClientsV2(),
+			"nutanix_volume_iscsi_clients_v2":                    volumesv2.DatasourceNutanixVolumeIscsiClientsV2(),
+			"nutanix_volume_iscsi_client_v2":                     volumesv2.DatasourceNutanixVolumeIscsiClientV2(),
+			"nutanix_volume_groups_by_category_v2":               volumesv2.DatasourceNutanixVolumeGroupsByCategoryV2(),
+			"nutanix_recovery_point_v2":                          dataprotectionv2.DatasourceNutanixRecoveryPointV2(),
+			"nutanix_recovery_points_v2":                         dataprotectionv2.DatasourceNutanixRecoveryPointsV2(),
+			"nutanix_recovery_points_by_location_agnostic_id_v2": dataprotectionv2.DatasourceNutanixRecoveryPointsByLocationAgnosticIDV2(),
+			"nutanix_vm_recovery_point_info_v2":                  dataprotectionv2.DatasourceNutanixVMRecoveryPointInfoV2(),
+			"nutanix_image_v2":                                   vmmv2.DatasourceNutanixImageV4(),
+			"nutanix_images_v2":                                  vmmv2.DatasourceNutanixImagesV4(),
+			"nutanix_virtual_machine_v2":                         vmmv2.DatasourceNutanixVirtualMachineV4(),
+			"nutanix_virtual_machines_v2":                        vmmv2.DatasourceNutanixVirtualMachinesV4(),
+			"nutanix_template_v2":                                vmmv2.DatasourceNutanixTemplateV2(),
+			"nutanix_templates_v2":                               vmmv2.DatasourceNutanixTemplatesV2(),
+			"nutanix_ngt_configuration_v2":                       vmmv2.DatasourceNutanixNGTConfigurationV4(),
+			"nutanix_image_placement_policy_v2":                  vmmv2.DatasourceNutanixImagePlacementV4(),
+			"nutanix_image_placement_policies_v2":                vmmv2.DatasourceNutanixImagePlacementsV4(),
+			"nutanix_cluster_v2":                                 clustersv2.DatasourceNutanixClusterEntityV2(),
+			"nutanix_clusters_v2":                                clustersv2.DatasourceNutanixClusterEntitiesV2(),
+			"nutanix_host_v2":                                    clustersv2.DatasourceNutanixHostEntityV2(),
+			"nutanix_hosts_v2":                                   clustersv2.DatasourceNutanixHostEntitiesV2(),
+			"nutanix_pc_status_v2":                               clustersv2.DatasourceNutanixPCStatusV2(),
+			"nutanix_cluster_capacity_v2":                        clustersv2.DatasourceNutanixClusterCapacityV2(),
 		},
 		ResourcesMap: map[string]*schema.Resource{
 			"nutanix_virtual_machine":                         vmm.ResourceNutanixVirtualMachine(),
@@ -353,18 +405,22 @@ func Provider() *schema.Provider {
 			"nutanix_user_groups_v2":                          iamv2.ResourceNutanixUserGroupsV2(),
 			"nutanix_roles_v2":                                iamv2.ResourceNutanixRolesV2(),
 			"nutanix_users_v2":                                iamv2.ResourceNutanixUserV2(),
+			"nutanix_user_password_reset_v2":                  iamv2.ResourceNutanixUserPasswordResetV2(),
 			"nutanix_authorization_policy_v2":                 iamv2.ResourceNutanixAuthPoliciesV2(),
 			"nutanix_saml_identity_providers_v2":              iamv2.ResourceNutanixSamlIdpV2(),
 			"nutanix_storage_containers_v2":                   storagecontainersv2.ResourceNutanixStorageContainersV2(),
 			"nutanix_category_v2":                             prismv2.ResourceNutanixCategoriesV2(),
 			"nutanix_volume_group_v2":                         volumesv2.ResourceNutanixVolumeGroupV2(),
 			"nutanix_volume_group_disk_v2":                    volumesv2.ResourceNutanixVolumeGroupDiskV2(),
+			"nutanix_volume_group_disks_resize_v2":            volumesv2.ResourceNutanixVolumeGroupDisksResizeV2(),
 			"nutanix_volume_group_iscsi_client_v2":            volumesv2.ResourceNutanixVolumeGroupIscsiClientV2(),
 			"nutanix_volume_group_vm_v2":                      volumesv2.ResourceNutanixVolumeAttachVMToVolumeGroupV2(),
 			"nutanix_recovery_points_v2":                      dataprotectionv2.ResourceNutanixRecoveryPointsV2(),
 			"nutanix_recovery_point_replicate_v2":             dataprotectionv2.ResourceNutanixRecoveryPointReplicateV2(),
 			"nutanix_recovery_point_restore_v2":               dataprotectionv2.ResourceNutanixRecoveryPointRestoreV2(),
+			"nutanix_recovery_points_cleanup_v2":              dataprotectionv2.ResourceNutanixRecoveryPointsCleanupV2(),
 			"nutanix_vm_revert_v2":                            vmmv2.ResourceNutanixRevertVMRecoveryPointV2(),
+			"nutanix_vm_recovery_point_revert_v2":             vmmv2.ResourceNutanixVMRecoveryPointRevertV2(),
 			"nutanix_virtual_machine_v2":                      vmmv2.ResourceNutanixVirtualMachineV2(),
 			"nutanix_vm_shutdown_action_v2":                   vmmv2.ResourceNutanixVmsShutdownActionV2(),
 			"nutanix_vm_cdrom_insert_eject_v2":                vmmv2.ResourceNutanixVmsCdRomsInsertEjectV2(),
@@ -417,20 +473,24 @@ func providerConfigure(ctx context.Context, d *schema.ResourceData) (interface{}
 	}
 
 	config := conns.Config{
-		Endpoint:           d.Get("endpoint").(string),
-		Username:           d.Get("username").(string),
-		Password:           d.Get("password").(string),
-		Insecure:           d.Get("insecure").(bool),
-		SessionAuth:        d.Get("session_auth").(bool),
-		Port:               d.Get("port").(string),
-		WaitTimeout:        int64(d.Get("wait_timeout").(int)),
-		ProxyURL:           d.Get("proxy_url").(string),
-		FoundationEndpoint: d.Get("foundation_endpoint").(string),
-		FoundationPort:     d.Get("foundation_port").(string),
-		NdbEndpoint:        d.Get("ndb_endpoint").(string),
-		NdbUsername:        d.Get("ndb_username").(string),
-		NdbPassword:        d.Get("ndb_password").(string),
-		RequiredFields:     requiredProviderFields,
+		Endpoint:              d.Get("endpoint").(string),
+		Username:              d.Get("username").(string),
+		Password:              d.Get("password").(string),
+		Insecure:              d.Get("insecure").(bool),
+		SessionAuth:           d.Get("session_auth").(bool),
+		Port:                  d.Get("port").(string),
+		WaitTimeout:           int64(d.Get("wait_timeout").(int)),
+		ProxyURL:              d.Get("proxy_url").(string),
+		FoundationEndpoint:    d.Get("foundation_endpoint").(string),
+		FoundationPort:        d.Get("foundation_port").(string),
+		NdbEndpoint:           d.Get("ndb_endpoint").(string),
+		NdbUsername:           d.Get("ndb_username").(string),
+		NdbPassword:           d.Get("ndb_password").(string),
+		RequiredFields:        requiredProviderFields,
+		MaxParallelTasks:      d.Get("max_parallel_tasks").(int),
+		DefaultClusterExtID:   d.Get("default_cluster_ext_id").(string),
+		TaskPollBatchInterval: d.Get("task_poll_batch_interval").(int),
+		LogFormat:             d.Get("log_format").(string),
 	}
 	c, err := config.Client()
 	if err != nil {
@@ -89,6 +89,7 @@ type Service interface {
 	DeleteUser(uuid string) (*DeleteResponse, error)
 	ListUser(getEntitiesRequest *DSMetadata) (*UserListResponse, error)
 	ListAllUser(filter string) (*UserListResponse, error)
+	SearchDirectoryService(uuid string, body *DirectoryServiceSearchInput) (*DirectoryServiceSearchResponse, error)
 	CreateUserGroup(ctx context.Context, body *UserGroupIntentInput) (*UserGroupIntentResponse, error)
 	GetUserGroup(userUUID string) (*UserGroupIntentResponse, error)
 	ListUserGroup(getEntitiesRequest *DSMetadata) (*UserGroupListResponse, error)
@@ -1718,6 +1719,26 @@ func (op Operations) CreateUser(request *UserIntentInput) (*UserIntentResponse,
 	return UserIntentResponse, op.client.Do(ctx, req, UserIntentResponse)
 }
 
+/*SearchDirectoryService This operation searches the users and groups of a directory service.
+ *
+ * @param uuid The directory service uuid - string.
+ * @param body The search query and attributes to return.
+ * @return *DirectoryServiceSearchResponse
+ */
+func (op Operations) SearchDirectoryService(uuid string, body *DirectoryServiceSearchInput) (*DirectoryServiceSearchResponse, error) {
+	ctx := context.TODO()
+
+	path := fmt.Sprintf("/directory_services/%s/search", uuid)
+	searchResponse := new(DirectoryServiceSearchResponse)
+
+	req, err := op.client.NewRequest(ctx, http.MethodPost, path, body)
+	if err != nil {
+		return nil, err
+	}
+
+	return searchResponse, op.client.Do(ctx, req, searchResponse)
+}
+
 /*GetUser This operation gets a User.
  *
  * @param uuid The user uuid - string.
@@ -4396,6 +4396,69 @@ func TestOperations_GetUser(t *testing.T) {
 	}
 }
 
+func TestOperations_SearchDirectoryService(t *testing.T) {
+	mux, c, server := setup()
+
+	defer server.Close()
+
+	mux.HandleFunc("/api/nutanix/v3/directory_services/cfde831a-4e87-4a75-960f-89b0148aa2cc/search", func(w http.ResponseWriter, r *http.Request) {
+		testHTTPMethod(t, r, http.MethodPost)
+
+		expected := map[string]interface{}{
+			"query":                   "jdoe",
+			"searched_attribute_list": []interface{}{"userPrincipalName"},
+			"returned_attribute_list": []interface{}{"userPrincipalName"},
+			"is_wildcard_search":      true,
+		}
+
+		var v map[string]interface{}
+		err := json.NewDecoder(r.Body).Decode(&v)
+		if err != nil {
+			t.Fatalf("decode json: %v", err)
+		}
+
+		if !reflect.DeepEqual(v, expected) {
+			t.Errorf("Request body\n got=%#v\nwant=%#v", v, expected)
+		}
+
+		fmt.Fprint(w, `{"domain_name":"example.com","search_result_list":[{"name":"jdoe","type":"person","attribute_list":[{"name":"userPrincipalName","value_list":["jdoe@example.com"]}]}]}`)
+	})
+
+	input := &DirectoryServiceSearchInput{
+		Query:                 utils.StringPtr("jdoe"),
+		SearchedAttributeList: []*string{utils.StringPtr("userPrincipalName")},
+		ReturnedAttributeList: []*string{utils.StringPtr("userPrincipalName")},
+		IsWildcardSearch:      utils.BoolPtr(true),
+	}
+
+	response := &DirectoryServiceSearchResponse{
+		DomainName: utils.StringPtr("example.com"),
+		SearchResultList: []*DirectoryServiceSearchResult{
+			{
+				Name: utils.StringPtr("jdoe"),
+				Type: utils.StringPtr("person"),
+				AttributeList: []*DirectoryServiceSearchAttribute{
+					{
+						Name:      utils.StringPtr("userPrincipalName"),
+						ValueList: []*string{utils.StringPtr("jdoe@example.com")},
+					},
+				},
+			},
+		},
+	}
+
+	op := Operations{
+		client: c,
+	}
+	got, err := op.SearchDirectoryService("cfde831a-4e87-4a75-960f-89b0148aa2cc", input)
+	if err != nil {
+		t.Fatalf("Operations.SearchDirectoryService() error = %v", err)
+	}
+	if !reflect.DeepEqual(got, response) {
+		t.Errorf("Operations.SearchDirectoryService() = %v, want %v", got, response)
+	}
+}
+
 func TestOperations_ListUser(t *testing.T) {
 	mux, c, server := setup()
 
@@ -2166,6 +2166,33 @@ type DirectoryServiceUser struct {
 	UserPrincipalName         *string    `json:"user_principal_name,omitempty"`         // The UserPrincipalName of the user from the directory service.
 }
 
+// Search query on the users and groups of a directory service.
+type DirectoryServiceSearchInput struct {
+	Query                 *string   `json:"query,omitempty"`                   // The string to search for.
+	SearchedAttributeList []*string `json:"searched_attribute_list,omitempty"` // The attributes the query is matched against.
+	ReturnedAttributeList []*string `json:"returned_attribute_list,omitempty"` // The attributes returned for every match.
+	IsWildcardSearch      *bool     `json:"is_wildcard_search,omitempty"`      // Whether the query matches as a substring.
+}
+
+// Result of a directory service search.
+type DirectoryServiceSearchResponse struct {
+	DomainName       *string                         `json:"domain_name,omitempty"`        // The domain name of the directory service.
+	SearchResultList []*DirectoryServiceSearchResult `json:"search_result_list,omitempty"` // The users and groups matching the query.
+}
+
+// A user or group matching a directory service search.
+type DirectoryServiceSearchResult struct {
+	Name          *string                            `json:"name,omitempty"` // The name of the entity.
+	Type          *string                            `json:"type,omitempty"` // The type of the entity, e.g. person or group.
+	AttributeList []*DirectoryServiceSearchAttribute `json:"attribute_list,omitempty"`
+}
+
+// An attribute of a directory service search result.
+type DirectoryServiceSearchAttribute struct {
+	Name      *string   `json:"name,omitempty"`
+	ValueList []*string `json:"value_list,omitempty"`
+}
+
 // An Identity Provider user.
 type IdentityProvider struct {
 	IdentityProviderReference *Reference `json:"identity_provider_reference,omitempty"` // The reference to a identity_provider
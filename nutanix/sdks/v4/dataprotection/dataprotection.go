@@ -21,7 +21,7 @@ func NewDataProtectionClient(credentials client.Credentials) (*Client, error) {
 		pcClient.Host = credentials.Endpoint
 		pcClient.Password = credentials.Password
 		pcClient.Username = credentials.Username
-		pcClient.Port = 9440
+		pcClient.Port = credentials.APIPort()
 		pcClient.VerifySSL = false
 
 		baseClient = pcClient
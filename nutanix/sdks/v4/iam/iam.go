@@ -15,6 +15,7 @@ type Client struct {
 	RolesAPIInstance            *api.RolesApi
 	OperationsAPIInstance       *api.OperationsApi
 	AuthAPIInstance             *api.AuthorizationPoliciesApi
+	EntitiesAPIInstance         *api.EntitiesApi
 }
 
 func NewIamClient(credentials client.Credentials) (*Client, error) {
@@ -27,7 +28,7 @@ func NewIamClient(credentials client.Credentials) (*Client, error) {
 		pcClient.Host = credentials.Endpoint
 		pcClient.Password = credentials.Password
 		pcClient.Username = credentials.Username
-		pcClient.Port = 9440
+		pcClient.Port = credentials.APIPort()
 		pcClient.VerifySSL = false
 
 		baseClient = pcClient
@@ -41,6 +42,7 @@ func NewIamClient(credentials client.Credentials) (*Client, error) {
 		OperationsAPIInstance:       api.NewOperationsApi(baseClient),
 		UsersAPIInstance:            api.NewUsersApi(baseClient),
 		AuthAPIInstance:             api.NewAuthorizationPoliciesApi(baseClient),
+		EntitiesAPIInstance:         api.NewEntitiesApi(baseClient),
 		APIClientInstance:           iam.NewApiClient(),
 	}
 
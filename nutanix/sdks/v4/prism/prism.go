@@ -1,15 +1,23 @@
 package prism
 
 import (
+	"context"
+
 	"github.com/nutanix/ntnx-api-golang-clients/prism-go-client/v4/api"
 	prism "github.com/nutanix/ntnx-api-golang-clients/prism-go-client/v4/client"
+	"github.com/nutanix/ntnx-api-golang-clients/prism-go-client/v4/models/prism/v4/config"
 	"github.com/terraform-providers/terraform-provider-nutanix/nutanix/client"
+	"github.com/terraform-providers/terraform-provider-nutanix/utils"
 )
 
 type Client struct {
 	TaskRefAPI               *api.TasksApi
 	CategoriesAPIInstance    *api.CategoriesApi
 	DomainManagerAPIInstance *api.DomainManagerApi
+	// TaskWatcher batches the task polls of GetTask, nil polls every task on its own
+	TaskWatcher *utils.TaskWatcher
+	// LogFormat is the format of the task poll log lines, text or json
+	LogFormat string
 }
 
 func NewPrismClient(credentials client.Credentials) (*Client, error) {
@@ -22,7 +30,7 @@ func NewPrismClient(credentials client.Credentials) (*Client, error) {
 		pcClient.Host = credentials.Endpoint
 		pcClient.Password = credentials.Password
 		pcClient.Username = credentials.Username
-		pcClient.Port = 9440
+		pcClient.Port = credentials.APIPort()
 		pcClient.VerifySSL = false
 
 		baseClient = pcClient
@@ -36,3 +44,31 @@ func NewPrismClient(credentials client.Credentials) (*Client, error) {
 
 	return f, nil
 }
+
+// GetTask fetches the task extID, batched with the other polled tasks when the
+// client has a TaskWatcher.
+func (c *Client) GetTask(ctx context.Context, extID string) (config.Task, error) {
+	if c.TaskWatcher != nil {
+		return c.TaskWatcher.Get(ctx, extID)
+	}
+	resp, err := c.TaskRefAPI.GetTaskById(utils.StringPtr(extID), nil)
+	if err != nil {
+		return config.Task{}, err
+	}
+	return resp.Data.GetValue().(config.Task), nil
+}
+
+// ListTasksByExtIDs fetches the tasks with the given ext ids in one request,
+// it is the utils.TaskFetcher of the client TaskWatcher.
+func (c *Client) ListTasksByExtIDs(extIDs []string) ([]config.Task, error) {
+	filter := utils.TaskExtIDsFilter(extIDs)
+	resp, err := c.TaskRefAPI.ListTasks(nil, utils.IntPtr(len(extIDs)), utils.StringPtr(filter), nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if resp.Data == nil {
+		return nil, nil
+	}
+	tasks, _ := resp.Data.GetValue().([]config.Task)
+	return tasks, nil
+}
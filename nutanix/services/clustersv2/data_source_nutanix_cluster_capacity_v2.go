@@ -0,0 +1,193 @@
+package clustersv2
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	clustermgmtConfig "github.com/nutanix/ntnx-api-golang-clients/clustermgmt-go-client/v4/models/clustermgmt/v4/config"
+	clustermgmtStats "github.com/nutanix/ntnx-api-golang-clients/clustermgmt-go-client/v4/models/clustermgmt/v4/stats"
+	clsstats "github.com/nutanix/ntnx-api-golang-clients/clustermgmt-go-client/v4/models/common/v1/stats"
+	conns "github.com/terraform-providers/terraform-provider-nutanix/nutanix"
+	"github.com/terraform-providers/terraform-provider-nutanix/nutanix/sdks/v4/clusters"
+	"github.com/terraform-providers/terraform-provider-nutanix/utils"
+)
+
+// DatasourceNutanixClusterCapacityV2 reports the storage capacity of a
+// cluster from its latest stats sample, so that a precondition can fail a
+// plan when there is not enough headroom left.
+func DatasourceNutanixClusterCapacityV2() *schema.Resource {
+	return &schema.Resource{
+		Description: "Reports the storage capacity, usage and overcommit status of a cluster.",
+		ReadContext: DatasourceNutanixClusterCapacityV2Read,
+		Schema: map[string]*schema.Schema{
+			"cluster_ext_id": {
+				Description: "The external identifier of the cluster. Defaults to the provider default cluster.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+			},
+			"total_storage_bytes": {
+				Description: "Total physical storage capacity of the cluster.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"used_storage_bytes": {
+				Description: "Physical storage used on the cluster.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"free_storage_bytes": {
+				Description: "Physical storage still free on the cluster.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"logical_usage_bytes": {
+				Description: "Logical storage used on the cluster.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"advertised_capacity_bytes": {
+				Description: "Sum of the advertised capacities of the storage containers of the cluster.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"is_overcommitted": {
+				Description: "Whether the storage containers advertise more capacity than the cluster has.",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func DatasourceNutanixClusterCapacityV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.Client).ClusterAPI
+
+	clusterExtID, err := meta.(*conns.Client).ClusterExtIDOrDefault(d.Get("cluster_ext_id").(string), "cluster_ext_id")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	stats, err := clusterStorageStats(conn, clusterExtID)
+	if err != nil {
+		return diag.Errorf("error while fetching the stats of cluster %s : %v", clusterExtID, err)
+	}
+	total := latestStatValue(stats.StorageCapacityBytes)
+	if total == nil {
+		return diag.Errorf("cluster %s did not report its storage capacity in the last hour", clusterExtID)
+	}
+	used := utils.Int64Value(latestStatValue(stats.StorageUsageBytes))
+	free := utils.Int64Value(total) - used
+	if v := latestStatValue(stats.FreePhysicalStorageBytes); v != nil {
+		free = *v
+	}
+
+	advertised, err := clusterAdvertisedCapacityBytes(conn, clusterExtID)
+	if err != nil {
+		return diag.Errorf("error while fetching the storage containers of cluster %s : %v", clusterExtID, err)
+	}
+
+	if err := d.Set("cluster_ext_id", clusterExtID); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("total_storage_bytes", utils.Int64Value(total)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("used_storage_bytes", used); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("free_storage_bytes", free); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("logical_usage_bytes", utils.Int64Value(latestStatValue(stats.LogicalStorageUsageBytes))); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("advertised_capacity_bytes", advertised); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("is_overcommitted", advertised > utils.Int64Value(total)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(clusterExtID)
+	return nil
+}
+
+// clusterStorageStats returns the stats of a cluster over the last hour, down
+// sampled to the last value of every interval.
+func clusterStorageStats(conn *clusters.Client, clusterExtID string) (*clustermgmtStats.ClusterStats, error) {
+	const samplingIntervalSecs = 300
+	endTime := time.Now().UTC()
+	startTime := endTime.Add(-1 * time.Hour)
+	statType := clsstats.DownSamplingOperator(7) // LAST
+
+	resp, err := conn.ClusterEntityAPI.GetClusterStats(utils.StringPtr(clusterExtID), &startTime, &endTime, utils.IntPtr(samplingIntervalSecs), &statType, nil)
+	if err != nil {
+		return nil, err
+	}
+	if resp.Data == nil {
+		return &clustermgmtStats.ClusterStats{}, nil
+	}
+	stats, ok := resp.Data.GetValue().(clustermgmtStats.ClusterStats)
+	if !ok {
+		return nil, fmt.Errorf("unexpected cluster stats response %T", resp.Data.GetValue())
+	}
+	return &stats, nil
+}
+
+// latestStatValue returns the most recent non nil value of a stat, or nil
+// when none was sampled.
+func latestStatValue(values []clustermgmtStats.TimeValuePair) *int64 {
+	var latest *int64
+	var sampledAt time.Time
+	for _, v := range values {
+		if v.Value == nil {
+			continue
+		}
+		if latest == nil || (v.Timestamp != nil && v.Timestamp.After(sampledAt)) {
+			latest = v.Value
+			if v.Timestamp != nil {
+				sampledAt = *v.Timestamp
+			}
+		}
+	}
+	return latest
+}
+
+// clusterAdvertisedCapacityBytes sums the advertised capacity of the storage
+// containers of a cluster. Containers without an advertised capacity are not
+// counted.
+func clusterAdvertisedCapacityBytes(conn *clusters.Client, clusterExtID string) (int64, error) {
+	filter := fmt.Sprintf("clusterExtId eq %s", utils.ODataString(clusterExtID))
+	items, err := utils.ListAllPages(nil, nil, func(page, limit *int) ([]interface{}, *int, error) {
+		resp, err := conn.StorageContainersAPI.ListStorageContainers(page, limit, &filter, nil, nil)
+		if err != nil {
+			return nil, nil, err
+		}
+		var total *int
+		if resp.Metadata != nil {
+			total = resp.Metadata.TotalAvailableResults
+		}
+		if resp.Data == nil {
+			return nil, total, nil
+		}
+		containers, _ := resp.Data.GetValue().([]clustermgmtConfig.StorageContainer)
+		items := make([]interface{}, len(containers))
+		for k, v := range containers {
+			items[k] = v
+		}
+		return items, total, nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	var advertised int64
+	for _, item := range items {
+		advertised += utils.Int64Value(item.(clustermgmtConfig.StorageContainer).LogicalAdvertisedCapacityBytes)
+	}
+	return advertised, nil
+}
@@ -0,0 +1,54 @@
+package clustersv2_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	acc "github.com/terraform-providers/terraform-provider-nutanix/nutanix/acctest"
+)
+
+const datasourceNameClusterCapacity = "data.nutanix_cluster_capacity_v2.test"
+
+func TestAccV2NutanixClusterCapacityDatasource_Basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testClusterCapacityDatasourceV4Config(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(datasourceNameClusterCapacity, "cluster_ext_id"),
+					resource.TestCheckResourceAttrSet(datasourceNameClusterCapacity, "total_storage_bytes"),
+					resource.TestCheckResourceAttrSet(datasourceNameClusterCapacity, "used_storage_bytes"),
+					resource.TestCheckResourceAttrSet(datasourceNameClusterCapacity, "free_storage_bytes"),
+					resource.TestCheckResourceAttrSet(datasourceNameClusterCapacity, "advertised_capacity_bytes"),
+					resource.TestCheckResourceAttrSet(datasourceNameClusterCapacity, "is_overcommitted"),
+				),
+			},
+		},
+	})
+}
+
+func testClusterCapacityDatasourceV4Config() string {
+	return `
+	data "nutanix_clusters_v2" "clusters" {}
+
+	locals {
+		cluster = [
+			for cluster in data.nutanix_clusters_v2.clusters.cluster_entities :
+			cluster.ext_id if cluster.config[0].cluster_function[0] != "PRISM_CENTRAL"
+		][0]
+	}
+
+	data "nutanix_cluster_capacity_v2" "test" {
+		cluster_ext_id = local.cluster
+
+		lifecycle {
+			postcondition {
+				condition     = self.total_storage_bytes > 0 && self.free_storage_bytes <= self.total_storage_bytes
+				error_message = "the cluster capacity is not consistent"
+			}
+		}
+	}
+	`
+}
@@ -46,7 +46,7 @@ func DatasourceNutanixClusterEntitiesV2() *schema.Resource {
 			"cluster_entities": {
 				Type:     schema.TypeList,
 				Computed: true,
-				Elem:     DatasourceNutanixClusterEntityV2(),
+				Elem:     clusterEntitiesElem(),
 			},
 		},
 	}
@@ -135,3 +135,18 @@ func flattenClusterEntities(pr []import1.Cluster) []interface{} {
 	}
 	return nil
 }
+
+// clusterEntitiesElem returns the cluster schema of nutanix_cluster_v2 for the
+// clusters of the list, without the lookup arguments: the clusters are not
+// looked up, so ext_id and name are only computed and filter does not apply.
+func clusterEntitiesElem() *schema.Resource {
+	elem := DatasourceNutanixClusterEntityV2()
+	delete(elem.Schema, "filter")
+	for _, attr := range []string{"ext_id", "name"} {
+		elem.Schema[attr] = &schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		}
+	}
+	return elem
+}
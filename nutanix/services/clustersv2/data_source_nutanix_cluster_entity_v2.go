@@ -2,6 +2,7 @@ package clustersv2
 
 import (
 	"context"
+	"fmt"
 	"sort"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -10,6 +11,7 @@ import (
 	import4 "github.com/nutanix/ntnx-api-golang-clients/clustermgmt-go-client/v4/models/common/v1/config"
 	import3 "github.com/nutanix/ntnx-api-golang-clients/clustermgmt-go-client/v4/models/common/v1/response"
 	conns "github.com/terraform-providers/terraform-provider-nutanix/nutanix"
+	"github.com/terraform-providers/terraform-provider-nutanix/nutanix/sdks/v4/clusters"
 	"github.com/terraform-providers/terraform-provider-nutanix/utils"
 )
 
@@ -20,8 +22,15 @@ func DatasourceNutanixClusterEntityV2() *schema.Resource {
 		ReadContext: DatasourceNutanixClusterEntityV2Read,
 		Schema: map[string]*schema.Schema{
 			"ext_id": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"ext_id", "name", "filter"},
+			},
+			"filter": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"ext_id", "name", "filter"},
 			},
 			"expand": {
 				Type:     schema.TypeString,
@@ -48,8 +57,10 @@ func DatasourceNutanixClusterEntityV2() *schema.Resource {
 				},
 			},
 			"name": {
-				Type:     schema.TypeString,
-				Computed: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"ext_id", "name", "filter"},
 			},
 			"nodes": {
 				Type:     schema.TypeList,
@@ -668,6 +679,21 @@ func DatasourceNutanixClusterEntityV2Read(ctx context.Context, d *schema.Resourc
 
 	extID := d.Get("ext_id")
 
+	if extID.(string) == "" {
+		var filter string
+		if name, ok := d.GetOk("name"); ok {
+			filter = fmt.Sprintf("name eq %s", utils.ODataString(name.(string)))
+		} else {
+			filter = d.Get("filter").(string)
+		}
+
+		clusterExtID, err := lookupClusterExtID(conn, filter)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		extID = clusterExtID
+	}
+
 	if expandf, ok := d.GetOk("expand"); ok {
 		expand = utils.StringPtr(expandf.(string))
 	} else {
@@ -724,6 +750,29 @@ func DatasourceNutanixClusterEntityV2Read(ctx context.Context, d *schema.Resourc
 	return nil
 }
 
+// lookupClusterExtID returns the ext_id of the single cluster matching the
+// given filter. It errors if no cluster or more than one cluster matches.
+func lookupClusterExtID(conn *clusters.Client, filter string) (string, error) {
+	resp, err := conn.ClusterEntityAPI.ListClusters(nil, nil, utils.StringPtr(filter), nil, nil, nil, nil)
+	if err != nil {
+		return "", fmt.Errorf("error while fetching clusters : %v", err)
+	}
+
+	if resp.Data == nil {
+		return "", fmt.Errorf("no cluster found matching filter %q", filter)
+	}
+
+	clusters := resp.Data.GetValue().([]import1.Cluster)
+	switch len(clusters) {
+	case 0:
+		return "", fmt.Errorf("no cluster found matching filter %q", filter)
+	case 1:
+		return utils.StringValue(clusters[0].ExtId), nil
+	default:
+		return "", fmt.Errorf("%d clusters found matching filter %q, please refine the lookup to match a single cluster", len(clusters), filter)
+	}
+}
+
 func SchemaForValuePrefixLength() *schema.Schema {
 	return &schema.Schema{
 		Type:     schema.TypeList,
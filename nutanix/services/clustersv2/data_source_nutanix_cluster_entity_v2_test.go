@@ -1,6 +1,7 @@
 package clustersv2_test
 
 import (
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -24,6 +25,56 @@ func TestAccV2NutanixClusterDataSource_basic(t *testing.T) {
 	})
 }
 
+func TestAccV2NutanixClusterDataSource_ByName(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterDataSourceByNameConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceNameCluster, "ext_id", "data.nutanix_cluster_v2.by_ext_id", "ext_id"),
+					resource.TestCheckResourceAttrPair(dataSourceNameCluster, "name", "data.nutanix_cluster_v2.by_ext_id", "name"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccV2NutanixClusterDataSource_ByNameNotFound(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "nutanix_cluster_v2" "test" {
+	name = "tf-test-cluster-does-not-exist"
+}`,
+				ExpectError: regexp.MustCompile("no cluster found matching filter"),
+			},
+		},
+	})
+}
+
+func testAccClusterDataSourceByNameConfig() string {
+	return `
+data "nutanix_clusters_v2" "test" {}
+
+locals {
+	      cluster = [for cluster in data.nutanix_clusters_v2.test.cluster_entities: 
+						cluster if cluster.config[0].cluster_function[0] != "PRISM_CENTRAL"][0]
+}
+
+data "nutanix_cluster_v2" "by_ext_id" {
+	ext_id = local.cluster.ext_id
+}
+
+data "nutanix_cluster_v2" "test" {
+	name = local.cluster.name
+}`
+}
+
 func testAccClusterDataSourceConfig() string {
 	return `
 data "nutanix_clusters_v2" "test" {}
@@ -0,0 +1,231 @@
+package clustersv2
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	import1 "github.com/nutanix/ntnx-api-golang-clients/clustermgmt-go-client/v4/models/clustermgmt/v4/config"
+	conns "github.com/terraform-providers/terraform-provider-nutanix/nutanix"
+	"github.com/terraform-providers/terraform-provider-nutanix/utils"
+)
+
+// pcStatusAPIs are the v4 namespaces probed by nutanix_pc_status_v2, each one
+// is an attribute of api_availability.
+var pcStatusAPIs = []string{"clustermgmt", "prism", "iam", "volumes", "vmm", "networking", "dataprotection"}
+
+// DatasourceNutanixPCStatusV2 reports whether the Prism Central endpoint is
+// reachable and not upgrading, so that an apply can be gated on it.
+func DatasourceNutanixPCStatusV2() *schema.Resource {
+	apiAvailability := make(map[string]*schema.Schema, len(pcStatusAPIs))
+	for _, api := range pcStatusAPIs {
+		apiAvailability[api] = &schema.Schema{
+			Type:     schema.TypeBool,
+			Computed: true,
+		}
+	}
+
+	return &schema.Resource{
+		Description: "Reports the version, upgrade status and API availability of the Prism Central endpoint.",
+		ReadContext: DatasourceNutanixPCStatusV2Read,
+		Schema: map[string]*schema.Schema{
+			"ext_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"full_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"upgrade_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"is_upgrade_in_progress": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"is_available": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"api_availability": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: apiAvailability,
+				},
+			},
+		},
+	}
+}
+
+func DatasourceNutanixPCStatusV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*conns.Client)
+
+	// an unreachable API is reported in api_availability instead of failing
+	// the read, so that the result can be used in a condition
+	availability := make(map[string]interface{}, len(pcStatusAPIs))
+	for _, api := range pcStatusAPIs {
+		err := probePCStatusAPI(client, api)
+		if err != nil {
+			log.Printf("[DEBUG] %s API of Prism Central is not available : %v", api, err)
+		}
+		availability[api] = err == nil
+	}
+
+	var pc *import1.Cluster
+	if availability["clustermgmt"].(bool) {
+		var err error
+		pc, err = findPrismCentralCluster(client)
+		if err != nil {
+			log.Printf("[DEBUG] could not read the Prism Central cluster : %v", err)
+		}
+	}
+
+	status := map[string]interface{}{
+		"ext_id":                 "",
+		"name":                   "",
+		"version":                "",
+		"full_version":           "",
+		"upgrade_status":         "",
+		"is_upgrade_in_progress": false,
+		"is_available":           false,
+	}
+	if pc != nil {
+		status["ext_id"] = utils.StringValue(pc.ExtId)
+		status["name"] = utils.StringValue(pc.Name)
+		status["upgrade_status"] = flattenUpgradeStatus(pc.UpgradeStatus)
+		status["is_upgrade_in_progress"] = isUpgradeInProgress(pc.UpgradeStatus)
+		if pc.Config != nil {
+			if pc.Config.BuildInfo != nil {
+				status["version"] = utils.StringValue(pc.Config.BuildInfo.Version)
+				status["full_version"] = utils.StringValue(pc.Config.BuildInfo.FullVersion)
+			}
+			// a cluster which does not report it answered the request above
+			status["is_available"] = pc.Config.IsAvailable == nil || *pc.Config.IsAvailable
+		}
+	}
+
+	for k, v := range status {
+		if err := d.Set(k, v); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	if err := d.Set("api_availability", []map[string]interface{}{availability}); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(resource.UniqueId())
+	return nil
+}
+
+// probePCStatusAPI sends the smallest list request of the api namespace.
+func probePCStatusAPI(client *conns.Client, api string) error {
+	limit := utils.IntPtr(1)
+	var err error
+	switch api {
+	case "clustermgmt":
+		if client.ClusterAPI == nil {
+			return fmt.Errorf("client is not configured")
+		}
+		_, err = client.ClusterAPI.ClusterEntityAPI.ListClusters(nil, limit, nil, nil, nil, nil, nil)
+	case "prism":
+		if client.PrismAPI == nil {
+			return fmt.Errorf("client is not configured")
+		}
+		_, err = client.PrismAPI.TaskRefAPI.ListTasks(nil, limit, nil, nil, nil)
+	case "iam":
+		if client.IamAPI == nil {
+			return fmt.Errorf("client is not configured")
+		}
+		_, err = client.IamAPI.UsersAPIInstance.ListUsers(nil, limit, nil, nil, nil)
+	case "volumes":
+		if client.VolumeAPI == nil {
+			return fmt.Errorf("client is not configured")
+		}
+		_, err = client.VolumeAPI.VolumeAPIInstance.ListVolumeGroups(nil, limit, nil, nil, nil, nil)
+	case "vmm":
+		if client.VmmAPI == nil {
+			return fmt.Errorf("client is not configured")
+		}
+		_, err = client.VmmAPI.VMAPIInstance.ListVms(nil, limit, nil, nil, nil)
+	case "networking":
+		if client.NetworkingAPI == nil {
+			return fmt.Errorf("client is not configured")
+		}
+		_, err = client.NetworkingAPI.SubnetAPIInstance.ListSubnets(nil, limit, nil, nil, nil, nil)
+	case "dataprotection":
+		if client.DataProtectionAPI == nil {
+			return fmt.Errorf("client is not configured")
+		}
+		_, err = client.DataProtectionAPI.RecoveryPoint.ListRecoveryPoints(nil, nil, limit, nil, nil, nil)
+	default:
+		return fmt.Errorf("unknown API %s", api)
+	}
+	return err
+}
+
+// findPrismCentralCluster returns the cluster entity of Prism Central itself,
+// the one with the PRISM_CENTRAL cluster function.
+func findPrismCentralCluster(client *conns.Client) (*import1.Cluster, error) {
+	clusters, err := utils.ListAllPages(nil, nil, func(page, limit *int) ([]interface{}, *int, error) {
+		resp, err := client.ClusterAPI.ClusterEntityAPI.ListClusters(page, limit, nil, nil, nil, nil, nil)
+		if err != nil {
+			return nil, nil, err
+		}
+		var total *int
+		if resp.Metadata != nil {
+			total = resp.Metadata.TotalAvailableResults
+		}
+		if resp.Data == nil {
+			return nil, total, nil
+		}
+		entities, _ := resp.Data.GetValue().([]import1.Cluster)
+		items := make([]interface{}, len(entities))
+		for k, v := range entities {
+			items[k] = v
+		}
+		return items, total, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, v := range clusters {
+		cluster := v.(import1.Cluster)
+		if cluster.Config == nil {
+			continue
+		}
+		for _, function := range flattenClusterFunctionRef(cluster.Config.ClusterFunction) {
+			if function == "PRISM_CENTRAL" {
+				return &cluster, nil
+			}
+		}
+	}
+	return nil, fmt.Errorf("no cluster with the PRISM_CENTRAL function found")
+}
+
+// isUpgradeInProgress reports whether an upgrade has started and not ended,
+// pending and scheduled upgrades have not started yet.
+func isUpgradeInProgress(upgradeStatus *import1.UpgradeStatus) bool {
+	if upgradeStatus == nil {
+		return false
+	}
+	switch *upgradeStatus {
+	case import1.UPGRADESTATUS_DOWNLOADING, import1.UPGRADESTATUS_QUEUED, import1.UPGRADESTATUS_PREUPGRADE, import1.UPGRADESTATUS_UPGRADING:
+		return true
+	}
+	return false
+}
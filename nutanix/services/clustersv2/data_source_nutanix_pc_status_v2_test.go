@@ -0,0 +1,37 @@
+package clustersv2_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	acc "github.com/terraform-providers/terraform-provider-nutanix/nutanix/acctest"
+)
+
+const datasourceNamePCStatus = "data.nutanix_pc_status_v2.test"
+
+func TestAccV2NutanixPCStatusDatasource_Basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testPCStatusDatasourceV4Config(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(datasourceNamePCStatus, "ext_id"),
+					resource.TestCheckResourceAttrSet(datasourceNamePCStatus, "version"),
+					resource.TestCheckResourceAttr(datasourceNamePCStatus, "is_available", "true"),
+					resource.TestCheckResourceAttr(datasourceNamePCStatus, "is_upgrade_in_progress", "false"),
+					resource.TestCheckResourceAttr(datasourceNamePCStatus, "api_availability.0.clustermgmt", "true"),
+					resource.TestCheckResourceAttr(datasourceNamePCStatus, "api_availability.0.prism", "true"),
+					resource.TestCheckResourceAttr(datasourceNamePCStatus, "api_availability.0.volumes", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testPCStatusDatasourceV4Config() string {
+	return `
+	data "nutanix_pc_status_v2" "test" {}
+	`
+}
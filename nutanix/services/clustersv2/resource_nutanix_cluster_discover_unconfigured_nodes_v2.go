@@ -276,8 +276,8 @@ func DatasourceNutanixClusterDiscoverUnconfiguredNodesV2Create(ctx context.Conte
 	taskconn := meta.(*conns.Client).PrismAPI
 	// Wait for the Nodes Trap to be available
 	stateConf := &resource.StateChangeConf{
-		Pending: []string{"PENDING", "RUNNING", "QUEUED"},
-		Target:  []string{"SUCCEEDED"},
+		Pending: utils.TaskPendingStates,
+		Target:  utils.TaskTargetStates,
 		Refresh: taskStateRefreshPrismTaskGroupFunc(ctx, taskconn, utils.StringValue(taskUUID)),
 		Timeout: d.Timeout(schema.TimeoutCreate),
 	}
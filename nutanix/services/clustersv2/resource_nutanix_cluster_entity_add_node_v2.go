@@ -521,8 +521,8 @@ func ResourceNutanixClusterAddNodeV2Create(ctx context.Context, d *schema.Resour
 	taskconn := meta.(*conns.Client).PrismAPI
 	// Wait for the  node to be available
 	stateConf := &resource.StateChangeConf{
-		Pending: []string{"PENDING", "RUNNING", "QUEUED"},
-		Target:  []string{"SUCCEEDED"},
+		Pending: utils.TaskPendingStates,
+		Target:  utils.TaskTargetStates,
 		Refresh: taskStateRefreshPrismTaskGroupFunc(ctx, taskconn, utils.StringValue(taskUUID)),
 		Timeout: d.Timeout(schema.TimeoutCreate),
 	}
@@ -604,8 +604,8 @@ func ResourceNutanixClusterAddNodeV2Delete(ctx context.Context, d *schema.Resour
 	taskconn := meta.(*conns.Client).PrismAPI
 	// Wait for the node to be available
 	stateConf := &resource.StateChangeConf{
-		Pending: []string{"QUEUED", "RUNNING"},
-		Target:  []string{"SUCCEEDED"},
+		Pending: utils.TaskPendingStates,
+		Target:  utils.TaskTargetStates,
 		Refresh: taskStateRefreshPrismTaskGroupFunc(ctx, taskconn, utils.StringValue(taskUUID)),
 		Timeout: d.Timeout(schema.TimeoutCreate),
 	}
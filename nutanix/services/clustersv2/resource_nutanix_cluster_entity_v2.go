@@ -800,8 +800,8 @@ func ResourceNutanixClusterV2Create(ctx context.Context, d *schema.ResourceData,
 	taskconn := meta.(*conns.Client).PrismAPI
 	// Wait for the cluster to be available
 	stateConf := &resource.StateChangeConf{
-		Pending: []string{"QUEUED", "RUNNING"},
-		Target:  []string{"SUCCEEDED"},
+		Pending: utils.TaskPendingStates,
+		Target:  utils.TaskTargetStates,
 		Refresh: taskStateRefreshPrismTaskGroupFunc(ctx, taskconn, utils.StringValue(taskUUID)),
 		Timeout: d.Timeout(schema.TimeoutCreate),
 	}
@@ -849,6 +849,11 @@ func ResourceNutanixClusterV2Read(ctx context.Context, d *schema.ResourceData, m
 	log.Printf("[DEBUG] ResourceNutanixClusterV2Read : Cluster found, extID : %s", d.Id())
 	resp, err := conn.ClusterEntityAPI.GetClusterById(utils.StringPtr(d.Id()), expand)
 	if err != nil {
+		if utils.IsV4NotFound(err) {
+			log.Printf("[DEBUG] cluster %s not found, removing it from state", d.Id())
+			d.SetId("")
+			return nil
+		}
 		log.Printf("[DEBUG] ResourceNutanixClusterV2Read : Cluster %s not found", d.Id())
 		return diag.Errorf("error while fetching cluster : %v", err)
 	}
@@ -975,8 +980,8 @@ func ResourceNutanixClusterV2Update(ctx context.Context, d *schema.ResourceData,
 	taskconn := meta.(*conns.Client).PrismAPI
 	// Wait for the cluster to be available
 	stateConf := &resource.StateChangeConf{
-		Pending: []string{"QUEUED", "RUNNING", "PENDING"},
-		Target:  []string{"SUCCEEDED"},
+		Pending: utils.TaskPendingStates,
+		Target:  utils.TaskTargetStates,
 		Refresh: taskStateRefreshPrismTaskGroupFunc(ctx, taskconn, utils.StringValue(taskUUID)),
 		Timeout: d.Timeout(schema.TimeoutCreate),
 	}
@@ -1045,8 +1050,8 @@ func ResourceNutanixClusterV2Delete(ctx context.Context, d *schema.ResourceData,
 	taskconn := meta.(*conns.Client).PrismAPI
 	// Wait for the cluster to be available
 	stateConf := &resource.StateChangeConf{
-		Pending: []string{"QUEUED", "RUNNING"},
-		Target:  []string{"SUCCEEDED"},
+		Pending: utils.TaskPendingStates,
+		Target:  utils.TaskTargetStates,
 		Refresh: taskStateRefreshPrismTaskGroupFunc(ctx, taskconn, utils.StringValue(taskUUID)),
 		Timeout: d.Timeout(schema.TimeoutCreate),
 	}
@@ -1058,46 +1063,31 @@ func ResourceNutanixClusterV2Delete(ctx context.Context, d *schema.ResourceData,
 }
 
 func taskStateRefreshPrismTaskGroupFunc(ctx context.Context, client *prism.Client, taskUUID string) resource.StateRefreshFunc {
+	poll := &utils.TaskStatusPoll{}
 	return func() (interface{}, string, error) {
 		// data := base64.StdEncoding.EncodeToString([]byte("ergon"))
 		// encodeUUID := data + ":" + taskUUID
-		vresp, err := client.TaskRefAPI.GetTaskById(utils.StringPtr(taskUUID), nil)
+		// batched with the other running tasks when task_poll_batch_interval is set
+		v, err := client.GetTask(ctx, taskUUID)
 		if err != nil {
-			return "", "", (fmt.Errorf("error while polling prism task: %v", err))
+			return "", "", &utils.TaskPollError{TaskExtID: taskUUID, Err: err}
 		}
 
-		// get the group results
-
-		v := vresp.Data.GetValue().(import2.Task)
+		status, err := poll.Status(taskUUID, v.Status)
+		utils.LogTaskPoll(client.LogFormat, v, status)
+		if err != nil {
+			return v, status, err
+		}
 
-		if getTaskStatus(v.Status) == "CANCELED" || getTaskStatus(v.Status) == "FAILED" {
-			return v, getTaskStatus(v.Status),
-				fmt.Errorf("error_detail: %s, progress_message: %d", utils.StringValue(v.ErrorMessages[0].Message), utils.IntValue(v.ProgressPercentage))
+		if utils.IsTaskFailed(status) {
+			return v, status, utils.NewTaskFailedError(taskUUID, status, v)
 		}
-		return v, getTaskStatus(v.Status), nil
+		return v, status, nil
 	}
 }
 
 func getTaskStatus(pr *import2.TaskStatus) string {
-	const two, three, five, six, seven = 2, 3, 5, 6, 7
-	if pr != nil {
-		if *pr == import2.TaskStatus(six) {
-			return "FAILED"
-		}
-		if *pr == import2.TaskStatus(seven) {
-			return "CANCELED"
-		}
-		if *pr == import2.TaskStatus(two) {
-			return "QUEUED"
-		}
-		if *pr == import2.TaskStatus(three) {
-			return "RUNNING"
-		}
-		if *pr == import2.TaskStatus(five) {
-			return "SUCCEEDED"
-		}
-	}
-	return "UNKNOWN"
+	return utils.TaskStatusName(pr)
 }
 
 func getClusterExtID(d *schema.ResourceData, conn *clusters.Client) error {
@@ -1105,9 +1095,9 @@ func getClusterExtID(d *schema.ResourceData, conn *clusters.Client) error {
 	if d.HasChange("name") {
 		// if name changed, get the old name to fetch the cluster, since the name will be updated after update request
 		oldName, _ := d.GetChange("name")
-		filter = fmt.Sprintf(`name eq '%s'`, oldName.(string))
+		filter = fmt.Sprintf("name eq %s", utils.ODataString(oldName.(string)))
 	} else {
-		filter = fmt.Sprintf(`name eq '%s'`, d.Get("name").(string))
+		filter = fmt.Sprintf("name eq %s", utils.ODataString(d.Get("name").(string)))
 	}
 
 	log.Printf("[DEBUG] getClusterExtID filter : %s", filter)
@@ -308,8 +308,8 @@ func ResourceNutanixClusterUnconfiguredNodeNetworkV2Create(ctx context.Context,
 	taskconn := meta.(*conns.Client).PrismAPI
 	// Wait for the  node to be available
 	stateConf := &resource.StateChangeConf{
-		Pending: []string{"QUEUED", "RUNNING", "QUEUED"},
-		Target:  []string{"SUCCEEDED"},
+		Pending: utils.TaskPendingStates,
+		Target:  utils.TaskTargetStates,
 		Refresh: taskStateRefreshPrismTaskGroupFunc(ctx, taskconn, utils.StringValue(taskUUID)),
 		Timeout: d.Timeout(schema.TimeoutCreate),
 	}
@@ -557,8 +557,8 @@ func ResourceNutanixClusterPCRegistrationV2Create(ctx context.Context, d *schema
 	taskconn := meta.(*conns.Client).PrismAPI
 	// Wait for the cluster to be available
 	stateConf := &resource.StateChangeConf{
-		Pending: []string{"PENDING", "RUNNING", "QUEUED"},
-		Target:  []string{"SUCCEEDED"},
+		Pending: utils.TaskPendingStates,
+		Target:  utils.TaskTargetStates,
 		Refresh: taskStateRefreshPrismTaskGroupFunc(ctx, taskconn, utils.StringValue(taskUUID)),
 		Timeout: d.Timeout(schema.TimeoutCreate),
 	}
@@ -626,6 +626,11 @@ func ResourceNutanixClusterPCRegistrationV2Read(ctx context.Context, d *schema.R
 
 	readResp, err := conn.DomainManagerAPIInstance.GetDomainManagerById(&pcExtID)
 	if err != nil {
+		if utils.IsV4NotFound(err) {
+			log.Printf("[DEBUG] domain manager %s not found, removing it from state", d.Id())
+			d.SetId("")
+			return nil
+		}
 		return diag.Errorf("error while fetching domain manager with id %s : %v", pcExtID, err)
 	}
 
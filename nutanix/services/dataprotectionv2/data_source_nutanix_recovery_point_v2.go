@@ -3,15 +3,19 @@ package dataprotectionv2
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"log"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/nutanix/ntnx-api-golang-clients/dataprotection-go-client/v4/models/common/v1/response"
 	"github.com/nutanix/ntnx-api-golang-clients/dataprotection-go-client/v4/models/dataprotection/v4/common"
 	"github.com/nutanix/ntnx-api-golang-clients/dataprotection-go-client/v4/models/dataprotection/v4/config"
 	conns "github.com/terraform-providers/terraform-provider-nutanix/nutanix"
+	"github.com/terraform-providers/terraform-provider-nutanix/nutanix/sdks/v4/dataprotection"
+	"github.com/terraform-providers/terraform-provider-nutanix/utils"
 )
 
 const (
@@ -19,14 +23,54 @@ const (
 	ApplicationConsistentPropertiesVss2 = "dataprotection.v4.r0.b1.common.VssProperties"
 )
 
+const (
+	recoveryPointAvailableTimeout = 10 * time.Minute
+	recoveryPointAvailableDelay   = 10 * time.Second
+)
+
+// waitForRecoveryPointAvailable polls the recovery point while it is not
+// found, e.g. a replicated recovery point not yet visible on the target
+// Prism Central. Any other error is returned right away.
+func waitForRecoveryPointAvailable(ctx context.Context, conn *dataprotection.Client, recoveryPointExtID string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"UNAVAILABLE"},
+		Target:  []string{"AVAILABLE"},
+		Refresh: func() (interface{}, string, error) {
+			resp, err := conn.RecoveryPoint.GetRecoveryPointById(utils.StringPtr(recoveryPointExtID))
+			if err != nil {
+				if utils.IsV4NotFound(err) {
+					return "", "UNAVAILABLE", nil
+				}
+				return nil, "", err
+			}
+			return resp, "AVAILABLE", nil
+		},
+		Timeout:    timeout,
+		MinTimeout: recoveryPointAvailableDelay,
+	}
+
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("recovery point %s did not become available within %s: %v", recoveryPointExtID, timeout, err)
+	}
+	return nil
+}
+
 func DatasourceNutanixRecoveryPointV2() *schema.Resource {
 	return &schema.Resource{
 		ReadContext: DatasourceNutanixRecoveryPointV2Read,
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(recoveryPointAvailableTimeout),
+		},
 		Schema: map[string]*schema.Schema{
 			"ext_id": {
 				Type:     schema.TypeString,
 				Required: true,
 			},
+			"wait_for_availability": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"tenant_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -40,6 +84,13 @@ func DatasourceNutanixRecoveryPointV2() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"labels": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
 			"creation_time": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -247,6 +298,12 @@ func DatasourceNutanixRecoveryPointV2Read(ctx context.Context, d *schema.Resourc
 
 	recoveryPointExtID := d.Get("ext_id").(string)
 
+	if d.Get("wait_for_availability").(bool) {
+		if err := waitForRecoveryPointAvailable(ctx, conn, recoveryPointExtID, d.Timeout(schema.TimeoutRead)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	resp, err := conn.RecoveryPoint.GetRecoveryPointById(&recoveryPointExtID)
 	if err != nil {
 		return diag.Errorf("error while fetching recovery point: %v", err)
@@ -275,6 +332,10 @@ func DatasourceNutanixRecoveryPointV2Read(ctx context.Context, d *schema.Resourc
 	if err := d.Set("name", getResp.Name); err != nil {
 		return diag.FromErr(err)
 	}
+	_, labels := splitRecoveryPointName(utils.StringValue(getResp.Name))
+	if err := d.Set("labels", labels); err != nil {
+		return diag.FromErr(err)
+	}
 	if err := d.Set("creation_time", flattenTime(getResp.CreationTime)); err != nil {
 		return diag.FromErr(err)
 	}
@@ -412,20 +473,24 @@ func flattenVMRecoveryPoints(vmRecoveryPoints []config.VmRecoveryPoint) []map[st
 }
 
 func flattenApplicationConsistentProperties(vmRecoveryProperties *config.OneOfVmRecoveryPointApplicationConsistentProperties) []map[string]interface{} {
-	if vmRecoveryProperties != nil {
-		vmRecProps := make(map[string]interface{})
-		if *vmRecoveryProperties.ObjectType_ == ApplicationConsistentPropertiesVss1 ||
-			*vmRecoveryProperties.ObjectType_ == ApplicationConsistentPropertiesVss2 {
-			properties := vmRecoveryProperties.GetValue().(common.VssProperties)
-			vmRecProps["backup_type"] = flattenBackupType(properties.BackupType)
-			vmRecProps["should_include_writers"] = properties.ShouldIncludeWriters
-			vmRecProps["writers"] = properties.Writers
-			vmRecProps["should_store_vss_metadata"] = properties.ShouldStoreVssMetadata
-			vmRecProps["object_type"] = properties.ObjectType_
-		}
-		return []map[string]interface{}{vmRecProps}
+	if vmRecoveryProperties == nil {
+		return nil
 	}
-	return nil
+	// the discriminator is checked by GetValue, the object type can be either
+	// of the VssProperties versions so the value type is used instead
+	properties, ok := vmRecoveryProperties.GetValue().(common.VssProperties)
+	if !ok {
+		return nil
+	}
+
+	vmRecProps := make(map[string]interface{})
+	vmRecProps["backup_type"] = flattenBackupType(properties.BackupType)
+	vmRecProps["should_include_writers"] = utils.BoolValue(properties.ShouldIncludeWriters)
+	vmRecProps["writers"] = properties.Writers
+	vmRecProps["should_store_vss_metadata"] = utils.BoolValue(properties.ShouldStoreVssMetadata)
+	vmRecProps["object_type"] = utils.StringValue(properties.ObjectType_)
+
+	return []map[string]interface{}{vmRecProps}
 }
 
 func flattenBackupType(backupType *common.BackupType) string {
@@ -0,0 +1,165 @@
+package dataprotectionv2
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nutanix/ntnx-api-golang-clients/dataprotection-go-client/v4/models/dataprotection/v4/config"
+	conns "github.com/terraform-providers/terraform-provider-nutanix/nutanix"
+	"github.com/terraform-providers/terraform-provider-nutanix/nutanix/sdks/v4/dataprotection"
+	"github.com/terraform-providers/terraform-provider-nutanix/utils"
+)
+
+// DatasourceNutanixRecoveryPointsByLocationAgnosticIDV2 lists the physical recovery
+// points sharing a location_agnostic_id, one entry per cluster they are present on.
+func DatasourceNutanixRecoveryPointsByLocationAgnosticIDV2() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: DatasourceNutanixRecoveryPointsByLocationAgnosticIDV2Read,
+		Schema: map[string]*schema.Schema{
+			"location_agnostic_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"cluster_ext_ids": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"recovery_points": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ext_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"cluster_ext_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"creation_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"expiration_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func DatasourceNutanixRecoveryPointsByLocationAgnosticIDV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.Client).DataProtectionAPI
+
+	locationAgnosticID := d.Get("location_agnostic_id").(string)
+
+	// recovery points are listed from Prism Central, then from every cluster
+	// asked for, since a cluster only reports the recovery points it holds
+	clusterIDs := []*string{nil}
+	for _, id := range d.Get("cluster_ext_ids").([]interface{}) {
+		clusterIDs = append(clusterIDs, utils.StringPtr(id.(string)))
+	}
+
+	recoveryPoints := make([]interface{}, 0)
+	seen := make(map[string]bool)
+	for _, clusterID := range clusterIDs {
+		entities, err := listRecoveryPointsByLocationAgnosticID(conn, locationAgnosticID, clusterID)
+		if err != nil {
+			if clusterID == nil {
+				return diag.Errorf("error while fetching recovery points with location agnostic id %s : %v", locationAgnosticID, err)
+			}
+			return diag.Errorf("error while fetching recovery points with location agnostic id %s on cluster %s : %v", locationAgnosticID, *clusterID, err)
+		}
+
+		for _, rp := range entities {
+			for _, clusterExtID := range recoveryPointClusterExtIDs(rp, clusterID) {
+				key := utils.StringValue(rp.ExtId) + "/" + clusterExtID
+				if seen[key] {
+					continue
+				}
+				seen[key] = true
+				recoveryPoints = append(recoveryPoints, map[string]interface{}{
+					"ext_id":          utils.StringValue(rp.ExtId),
+					"cluster_ext_id":  clusterExtID,
+					"name":            utils.StringValue(rp.Name),
+					"status":          flattenStatus(rp.Status),
+					"creation_time":   flattenTime(rp.CreationTime),
+					"expiration_time": flattenTime(rp.ExpirationTime),
+				})
+			}
+		}
+	}
+
+	if err := d.Set("recovery_points", recoveryPoints); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(locationAgnosticID)
+	return nil
+}
+
+// listRecoveryPointsByLocationAgnosticID lists the recovery points sharing a
+// location agnostic id, from Prism Central or from clusterID when it is set.
+func listRecoveryPointsByLocationAgnosticID(conn *dataprotection.Client, locationAgnosticID string, clusterID *string) ([]config.RecoveryPoint, error) {
+	filter := utils.StringPtr(fmt.Sprintf("locationAgnosticId eq %s", utils.ODataString(locationAgnosticID)))
+	entities, err := utils.ListAllPages(nil, nil, func(page, limit *int) ([]interface{}, *int, error) {
+		resp, err := conn.RecoveryPoint.ListRecoveryPoints(clusterID, page, limit, filter, nil, nil)
+		if err != nil {
+			return nil, nil, err
+		}
+		var total *int
+		if resp.Metadata != nil {
+			total = resp.Metadata.TotalAvailableResults
+		}
+		if resp.Data == nil {
+			return nil, total, nil
+		}
+		list := resp.Data.GetValue().([]config.RecoveryPoint)
+		items := make([]interface{}, len(list))
+		for k, v := range list {
+			items[k] = v
+		}
+		return items, total, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	recoveryPoints := make([]config.RecoveryPoint, len(entities))
+	for k, v := range entities {
+		recoveryPoints[k] = v.(config.RecoveryPoint)
+	}
+	return recoveryPoints, nil
+}
+
+// recoveryPointClusterExtIDs returns the clusters a recovery point is present on,
+// falling back to the cluster it was listed from.
+func recoveryPointClusterExtIDs(rp config.RecoveryPoint, listedFrom *string) []string {
+	ids := make([]string, 0, len(rp.LocationReferences))
+	for _, ref := range rp.LocationReferences {
+		if ref.LocationExtId != nil {
+			ids = append(ids, *ref.LocationExtId)
+		}
+	}
+	if len(ids) == 0 {
+		ids = append(ids, utils.StringValue(listedFrom))
+	}
+	return ids
+}
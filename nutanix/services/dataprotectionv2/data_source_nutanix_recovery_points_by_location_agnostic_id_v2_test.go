@@ -0,0 +1,43 @@
+package dataprotectionv2_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	acc "github.com/terraform-providers/terraform-provider-nutanix/nutanix/acctest"
+)
+
+const datasourceNameRecoveryPointsByLocationAgnosticID = "data.nutanix_recovery_points_by_location_agnostic_id_v2.test"
+
+func TestAccV2NutanixRecoveryPointsByLocationAgnosticIDDatasource_Basic(t *testing.T) {
+	r := acctest.RandInt()
+	name := fmt.Sprintf("tf-test-recovery-point-%d", r)
+	vmName := fmt.Sprintf("tf-test-rp-vm-%d", r)
+
+	// End time is two week later
+	expirationTime := time.Now().Add(14 * 24 * time.Hour)
+
+	expirationTimeFormatted := expirationTime.UTC().Format(time.RFC3339)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccFoundationPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testVMConfigRecovery(vmName) + testRecoveryPointsResourceConfigWithVMRecoveryPoints(name, expirationTimeFormatted) + `
+				data "nutanix_recovery_points_by_location_agnostic_id_v2" "test" {
+					location_agnostic_id = nutanix_recovery_points_v2.test.location_agnostic_id
+				}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(datasourceNameRecoveryPointsByLocationAgnosticID, "recovery_points.#", "1"),
+					resource.TestCheckResourceAttrPair(datasourceNameRecoveryPointsByLocationAgnosticID, "recovery_points.0.ext_id", resourceNameRecoveryPoints, "ext_id"),
+					resource.TestCheckResourceAttrSet(datasourceNameRecoveryPointsByLocationAgnosticID, "recovery_points.0.cluster_ext_id"),
+					resource.TestCheckResourceAttr(datasourceNameRecoveryPointsByLocationAgnosticID, "recovery_points.0.name", name),
+				),
+			},
+		},
+	})
+}
@@ -39,6 +39,13 @@ func DatasourceNutanixRecoveryPointsV2() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"labels": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
 			"cluster_id": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -88,6 +95,47 @@ func DatasourceNutanixRecoveryPointsV2Read(ctx context.Context, d *schema.Resour
 
 	clusterID := d.Get("cluster_id").(string)
 
+	// labels are part of the name, they are matched once listed. Every page is
+	// listed unless page or limit is set, so that matches beyond the first
+	// page are not missed.
+	if labels, ok := d.GetOk("labels"); ok {
+		items, err := utils.ListAllPages(page, limit, func(page, limit *int) ([]interface{}, *int, error) {
+			resp, err := conn.RecoveryPoint.ListRecoveryPoints(&clusterID, page, limit, filter, orderBy, selectQ)
+			if err != nil {
+				return nil, nil, err
+			}
+			var total *int
+			if resp.Metadata != nil {
+				total = resp.Metadata.TotalAvailableResults
+			}
+			if resp.Data == nil {
+				return nil, total, nil
+			}
+			recoveryPoints, _ := resp.Data.GetValue().([]config.RecoveryPoint)
+			items := make([]interface{}, len(recoveryPoints))
+			for k, v := range recoveryPoints {
+				items[k] = v
+			}
+			return items, total, nil
+		})
+		if err != nil {
+			return diag.Errorf("error while fetching Recovery Points : %v", err)
+		}
+
+		labelled := make([]config.RecoveryPoint, 0, len(items))
+		for _, item := range items {
+			rp := item.(config.RecoveryPoint)
+			if recoveryPointHasLabels(utils.StringValue(rp.Name), labels.(map[string]interface{})) {
+				labelled = append(labelled, rp)
+			}
+		}
+		if err := d.Set("recovery_points", flattenRecoveryPoints(labelled)); err != nil {
+			return diag.FromErr(err)
+		}
+		d.SetId(resource.UniqueId())
+		return nil
+	}
+
 	resp, err := conn.RecoveryPoint.ListRecoveryPoints(&clusterID, page, limit, filter, orderBy, selectQ)
 	if err != nil {
 		return diag.Errorf("error while fetching Recovery Points : %v", err)
@@ -117,12 +165,14 @@ func flattenRecoveryPoints(recoveryPoints []config.RecoveryPoint) []interface{}
 	result := make([]interface{}, len(recoveryPoints))
 
 	for i, recoveryPoint := range recoveryPoints {
+		_, labels := splitRecoveryPointName(utils.StringValue(recoveryPoint.Name))
 		result[i] = map[string]interface{}{
 			"ext_id":                       recoveryPoint.ExtId,
 			"tenant_id":                    recoveryPoint.TenantId,
 			"links":                        flattenLinks(recoveryPoint.Links),
 			"location_agnostic_id":         recoveryPoint.LocationAgnosticId,
 			"name":                         recoveryPoint.Name,
+			"labels":                       labels,
 			"creation_time":                flattenTime(recoveryPoint.CreationTime),
 			"expiration_time":              flattenTime(recoveryPoint.ExpirationTime),
 			"status":                       flattenStatus(recoveryPoint.Status),
@@ -3,12 +3,15 @@ package dataprotectionv2
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"log"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/nutanix/ntnx-api-golang-clients/dataprotection-go-client/v4/models/dataprotection/v4/config"
 	conns "github.com/terraform-providers/terraform-provider-nutanix/nutanix"
+	"github.com/terraform-providers/terraform-provider-nutanix/nutanix/sdks/v4/dataprotection"
+	"github.com/terraform-providers/terraform-provider-nutanix/utils"
 )
 
 func DatasourceNutanixVMRecoveryPointInfoV2() *schema.Resource {
@@ -20,8 +23,10 @@ func DatasourceNutanixVMRecoveryPointInfoV2() *schema.Resource {
 				Required: true,
 			},
 			"ext_id": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"ext_id", "vm_ext_id"},
 			},
 			"tenant_id": {
 				Type:     schema.TypeString,
@@ -38,8 +43,10 @@ func DatasourceNutanixVMRecoveryPointInfoV2() *schema.Resource {
 			},
 			"disk_recovery_points": SchemaForDiskRecoveryPoints(),
 			"vm_ext_id": {
-				Type:     schema.TypeString,
-				Computed: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"ext_id", "vm_ext_id"},
 			},
 			"vm_categories": {
 				Type:     schema.TypeList,
@@ -91,6 +98,16 @@ func DatasourceNutanixVMRecoveryPointInfoV2Read(ctx context.Context, d *schema.R
 	recoveryPointExtID := d.Get("recovery_point_ext_id").(string)
 	extID := d.Get("ext_id").(string)
 
+	// resolve the VM recovery point from the VM when only vm_ext_id is provided
+	if extID == "" {
+		vmExtID := d.Get("vm_ext_id").(string)
+		vmRecoveryPointExtID, err := lookupVMRecoveryPointExtID(conn, recoveryPointExtID, vmExtID)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		extID = vmRecoveryPointExtID
+	}
+
 	resp, err := conn.RecoveryPoint.GetVmRecoveryPointById(&recoveryPointExtID, &extID)
 	if err != nil {
 		return diag.Errorf("error while fetching vm recovery point: %v", err)
@@ -132,3 +149,20 @@ func DatasourceNutanixVMRecoveryPointInfoV2Read(ctx context.Context, d *schema.R
 	d.SetId(*getResp.ExtId)
 	return nil
 }
+
+// lookupVMRecoveryPointExtID returns the ext_id of the VM recovery point of the
+// given VM contained in the recovery point.
+func lookupVMRecoveryPointExtID(conn *dataprotection.Client, recoveryPointExtID, vmExtID string) (string, error) {
+	resp, err := conn.RecoveryPoint.GetRecoveryPointById(&recoveryPointExtID)
+	if err != nil {
+		return "", fmt.Errorf("error while fetching recovery point %s: %v", recoveryPointExtID, err)
+	}
+
+	recoveryPoint := resp.Data.GetValue().(config.RecoveryPoint)
+	for _, vmRecoveryPoint := range recoveryPoint.VmRecoveryPoints {
+		if utils.StringValue(vmRecoveryPoint.VmExtId) == vmExtID {
+			return utils.StringValue(vmRecoveryPoint.ExtId), nil
+		}
+	}
+	return "", fmt.Errorf("VM %s is not part of recovery point %s", vmExtID, recoveryPointExtID)
+}
@@ -2,6 +2,7 @@ package dataprotectionv2_test
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 	"time"
 
@@ -39,8 +40,36 @@ func TestAccNutanixVmRecoveryPointV2Datasource_VmRecoveryPoint(t *testing.T) {
 	})
 }
 
+func TestAccNutanixVmRecoveryPointV2Datasource_ByVmExtID(t *testing.T) {
+	r := acctest.RandInt()
+	name := fmt.Sprintf("tf-test-recovery-point-%d", r)
+	vmName := fmt.Sprintf("tf-test-rp-vm-%d", r)
+
+	// End time is two week later
+	expirationTime := time.Now().Add(14 * 24 * time.Hour)
+
+	expirationTimeFormatted := expirationTime.UTC().Format(time.RFC3339)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccFoundationPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testVMConfigRecovery(vmName) + testVMRecoveryPointDatasourceConfigByVMExtID(name, expirationTimeFormatted),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(datasourceNameVMRecoveryPoint, "ext_id", resourceNameRecoveryPoints, "vm_recovery_points.0.ext_id"),
+					resource.TestCheckResourceAttrPair(datasourceNameVMRecoveryPoint, "vm_ext_id", "nutanix_virtual_machine_v2.test-1", "id"),
+				),
+			},
+			{
+				Config:      testVMConfigRecovery(vmName) + testVMRecoveryPointDatasourceConfigByUnknownVMExtID(name, expirationTimeFormatted),
+				ExpectError: regexp.MustCompile("is not part of recovery point"),
+			},
+		},
+	})
+}
+
 func TestAccNutanixVmRecoveryPointV2Datasource_VmRecoveryPointWithAppConsProps(t *testing.T) {
-	t.Skip("Skipping this test case as it is failing due to missing app consistent properties in get request")
 	r := acctest.RandInt()
 	name := fmt.Sprintf("tf-test-recovery-point-%d", r)
 	vmName := fmt.Sprintf("tf-test-rp-vm-%d", r)
@@ -61,10 +90,10 @@ func TestAccNutanixVmRecoveryPointV2Datasource_VmRecoveryPointWithAppConsProps(t
 					resource.TestCheckResourceAttrSet(datasourceNameVMRecoveryPoint, "location_agnostic_id"),
 					resource.TestCheckResourceAttrSet(datasourceNameVMRecoveryPoint, "recovery_point_ext_id"),
 					resource.TestCheckResourceAttrSet(datasourceNameVMRecoveryPoint, "vm_ext_id"),
-					resource.TestCheckResourceAttr(resourceNameRecoveryPoints, "application_consistent_properties.0.backup_type", "FULL_BACKUP"),
-					resource.TestCheckResourceAttr(resourceNameRecoveryPoints, "application_consistent_properties.0.should_include_writers", "true"),
-					resource.TestCheckResourceAttr(resourceNameRecoveryPoints, "application_consistent_properties.0.should_store_vss_metadata", "true"),
-					resource.TestCheckResourceAttr(resourceNameRecoveryPoints, "application_consistent_properties.0.object_type", "dataprotection.v4.common.VssProperties"),
+					resource.TestCheckResourceAttr(datasourceNameVMRecoveryPoint, "application_consistent_properties.0.backup_type", "FULL_BACKUP"),
+					resource.TestCheckResourceAttr(datasourceNameVMRecoveryPoint, "application_consistent_properties.0.should_include_writers", "true"),
+					resource.TestCheckResourceAttr(datasourceNameVMRecoveryPoint, "application_consistent_properties.0.should_store_vss_metadata", "true"),
+					resource.TestCheckResourceAttr(datasourceNameVMRecoveryPoint, "application_consistent_properties.0.object_type", "dataprotection.v4.common.VssProperties"),
 				),
 			},
 		},
@@ -90,3 +119,23 @@ func testVMRecoveryPointsDatasourceConfigWithAppConsProps(name, expirationTime s
 		}
 	`
 }
+
+func testVMRecoveryPointDatasourceConfigByVMExtID(name, expirationTime string) string {
+	return testRecoveryPointsResourceConfigWithVMRecoveryPoints(name, expirationTime) + `
+	data "nutanix_vm_recovery_point_info_v2" "test" {
+	  recovery_point_ext_id = nutanix_recovery_points_v2.test.ext_id
+	  vm_ext_id             = nutanix_virtual_machine_v2.test-1.id
+	  depends_on            = [nutanix_recovery_points_v2.test]
+	}
+`
+}
+
+func testVMRecoveryPointDatasourceConfigByUnknownVMExtID(name, expirationTime string) string {
+	return testRecoveryPointsResourceConfigWithVMRecoveryPoints(name, expirationTime) + `
+	data "nutanix_vm_recovery_point_info_v2" "test" {
+	  recovery_point_ext_id = nutanix_recovery_points_v2.test.ext_id
+	  vm_ext_id             = "00000000-0000-0000-0000-000000000000"
+	  depends_on            = [nutanix_recovery_points_v2.test]
+	}
+`
+}
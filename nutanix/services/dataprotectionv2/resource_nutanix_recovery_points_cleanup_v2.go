@@ -0,0 +1,197 @@
+package dataprotectionv2
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nutanix/ntnx-api-golang-clients/dataprotection-go-client/v4/models/dataprotection/v4/config"
+	dataprtotectionPrismConfig "github.com/nutanix/ntnx-api-golang-clients/dataprotection-go-client/v4/models/prism/v4/config"
+	conns "github.com/terraform-providers/terraform-provider-nutanix/nutanix"
+	"github.com/terraform-providers/terraform-provider-nutanix/utils"
+)
+
+// ResourceNutanixRecoveryPointsCleanupV2 deletes the recovery points matching
+// its criteria when it is created. Like the restore and replicate resources it
+// is an action, destroying it does not restore anything.
+func ResourceNutanixRecoveryPointsCleanupV2() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: ResourceNutanixRecoveryPointsCleanupV2Create,
+		ReadContext:   ResourceNutanixRecoveryPointsCleanupV2Read,
+		DeleteContext: ResourceNutanixRecoveryPointsCleanupV2Delete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"filter": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"older_than": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validatePositiveDuration,
+			},
+			"expired_only": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+			"dry_run": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+			"candidate_ext_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"deleted_ext_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"deleted_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func ResourceNutanixRecoveryPointsCleanupV2Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.Client).DataProtectionAPI
+
+	now := time.Now()
+	expiredOnly := d.Get("expired_only").(bool)
+	filter := recoveryPointsCleanupFilter(d, now)
+	if filter == "" && !expiredOnly {
+		return diag.Errorf("one of filter, older_than or expired_only must be set")
+	}
+	log.Printf("[DEBUG] listing recovery points to clean up with filter %q, expired only: %v", filter, expiredOnly)
+
+	var filterParam *string
+	if filter != "" {
+		filterParam = utils.StringPtr(filter)
+	}
+	entities, err := utils.ListAllPages(nil, nil, func(page, limit *int) ([]interface{}, *int, error) {
+		resp, err := conn.RecoveryPoint.ListRecoveryPoints(nil, page, limit, filterParam, nil, nil)
+		if err != nil {
+			return nil, nil, err
+		}
+		var total *int
+		if resp.Metadata != nil {
+			total = resp.Metadata.TotalAvailableResults
+		}
+		if resp.Data == nil {
+			return nil, total, nil
+		}
+		recoveryPoints, _ := resp.Data.GetValue().([]config.RecoveryPoint)
+		items := make([]interface{}, len(recoveryPoints))
+		for k, v := range recoveryPoints {
+			items[k] = v
+		}
+		return items, total, nil
+	})
+	if err != nil {
+		return diag.Errorf("error while listing recovery points : %v", err)
+	}
+
+	// expirationTime can not be filtered on, the expired recovery points are
+	// selected here
+	candidates := make([]string, 0, len(entities))
+	for _, v := range entities {
+		recoveryPoint := v.(config.RecoveryPoint)
+		if expiredOnly && (recoveryPoint.ExpirationTime == nil || !recoveryPoint.ExpirationTime.Before(now)) {
+			continue
+		}
+		candidates = append(candidates, utils.StringValue(recoveryPoint.ExtId))
+	}
+
+	d.SetId(resource.UniqueId())
+	d.Set("candidate_ext_ids", candidates)
+	d.Set("deleted_ext_ids", []string{})
+	d.Set("deleted_count", 0)
+
+	if d.Get("dry_run").(bool) {
+		log.Printf("[INFO] dry run, %d recovery points would be deleted: %v", len(candidates), candidates)
+		return nil
+	}
+
+	// one at a time, so that a failure stops the cleanup and reports what
+	// was already deleted
+	deleted := make([]string, 0, len(candidates))
+	for _, extID := range candidates {
+		if err := deleteRecoveryPoint(ctx, meta, extID, d.Timeout(schema.TimeoutCreate)); err != nil {
+			if utils.IsV4NotFound(err) {
+				log.Printf("[DEBUG] recovery point %s is already gone", extID)
+				continue
+			}
+			d.SetId("")
+			return diag.Errorf("error while deleting recovery point %s, %d of %d recovery points deleted %v : %v", extID, len(deleted), len(candidates), deleted, err)
+		}
+		deleted = append(deleted, extID)
+	}
+
+	d.Set("deleted_ext_ids", deleted)
+	d.Set("deleted_count", len(deleted))
+	return nil
+}
+
+func ResourceNutanixRecoveryPointsCleanupV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return nil
+}
+
+func ResourceNutanixRecoveryPointsCleanupV2Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return nil
+}
+
+// recoveryPointsCleanupFilter returns the OData filter selecting the recovery
+// points to clean up by filter and age, or an empty string when neither is set.
+func recoveryPointsCleanupFilter(d *schema.ResourceData, now time.Time) string {
+	conditions := make([]string, 0)
+	if filter, ok := d.GetOk("filter"); ok {
+		conditions = append(conditions, fmt.Sprintf("(%s)", filter.(string)))
+	}
+	if olderThan, ok := d.GetOk("older_than"); ok {
+		duration, _ := time.ParseDuration(olderThan.(string))
+		conditions = append(conditions, fmt.Sprintf("creationTime lt %s", now.UTC().Add(-duration).Format(time.RFC3339)))
+	}
+	return strings.Join(conditions, " and ")
+}
+
+// deleteRecoveryPoint deletes a recovery point and waits for the delete task.
+func deleteRecoveryPoint(ctx context.Context, meta interface{}, extID string, timeout time.Duration) error {
+	conn := meta.(*conns.Client).DataProtectionAPI
+
+	resp, err := conn.RecoveryPoint.DeleteRecoveryPointById(utils.StringPtr(extID))
+	if err != nil {
+		return err
+	}
+	taskUUID := resp.Data.GetValue().(dataprtotectionPrismConfig.TaskReference).ExtId
+
+	stateConf := &resource.StateChangeConf{
+		Pending: utils.TaskPendingStates,
+		Target:  utils.TaskTargetStates,
+		Refresh: taskStateRefreshPrismTaskGroupFunc(ctx, meta.(*conns.Client).PrismAPI, utils.StringValue(taskUUID)),
+		Timeout: timeout,
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("error waiting for recovery point (%s) to delete: %w", utils.StringValue(taskUUID), err)
+	}
+	return nil
+}
@@ -0,0 +1,52 @@
+package dataprotectionv2_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	acc "github.com/terraform-providers/terraform-provider-nutanix/nutanix/acctest"
+)
+
+const resourceNameRecoveryPointsCleanup = "nutanix_recovery_points_cleanup_v2.test"
+
+func TestAccV2NutanixRecoveryPointsCleanupResource_DryRun(t *testing.T) {
+	r := acctest.RandInt()
+	name := fmt.Sprintf("tf-test-recovery-point-cleanup-%d", r)
+	vmName := fmt.Sprintf("tf-test-rp-vm-%d", r)
+
+	// End time is two week later
+	expirationTime := time.Now().Add(14 * 24 * time.Hour)
+
+	expirationTimeFormatted := expirationTime.UTC().Format(time.RFC3339)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccFoundationPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testVMConfigRecovery(vmName) + testRecoveryPointsResourceConfigWithVMRecoveryPoints(name, expirationTimeFormatted) +
+					testRecoveryPointsCleanupResourceConfigDryRun(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceNameRecoveryPointsCleanup, "candidate_ext_ids.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceNameRecoveryPointsCleanup, "candidate_ext_ids.0", resourceNameRecoveryPoints, "id"),
+					resource.TestCheckResourceAttr(resourceNameRecoveryPointsCleanup, "deleted_ext_ids.#", "0"),
+					resource.TestCheckResourceAttr(resourceNameRecoveryPointsCleanup, "deleted_count", "0"),
+					resource.TestCheckResourceAttrSet(resourceNameRecoveryPoints, "ext_id"),
+				),
+			},
+		},
+	})
+}
+
+func testRecoveryPointsCleanupResourceConfigDryRun(name string) string {
+	return fmt.Sprintf(`
+	resource "nutanix_recovery_points_cleanup_v2" "test" {
+		filter  = "name eq '%[1]s'"
+		dry_run = true
+
+		depends_on = [nutanix_recovery_points_v2.test]
+	}`, name)
+}
@@ -34,6 +34,15 @@ func ResourceNutanixRecoveryPointReplicateV2() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"wait_for_complete": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"task_ext_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"replicated_rp_ext_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -46,6 +55,25 @@ func ResourceNutanixRecoveryPointReplicateV2() *schema.Resource {
 func ResourceNutanixRecoveryPointReplicateV2Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	log.Printf("[DEBUG] ResourceNutanixRecoveryPointReplicateV2Create \n")
 
+	taskUUID, diags := replicateRecoveryPoint(ctx, d, meta)
+	if diags.HasError() {
+		return diags
+	}
+
+	// the replicated recovery point is filled in by a later refresh once the task succeeded
+	if !d.Get("wait_for_complete").(bool) {
+		d.SetId(taskUUID)
+	} else {
+		d.SetId(d.Get("replicated_rp_ext_id").(string))
+	}
+
+	return ResourceNutanixRecoveryPointReplicateV2Read(ctx, d, meta)
+}
+
+// replicateRecoveryPoint replicates the recovery point to the target of the
+// configuration and sets task_ext_id, then replicated_rp_ext_id when waiting
+// for the task.
+func replicateRecoveryPoint(ctx context.Context, d *schema.ResourceData, meta interface{}) (string, diag.Diagnostics) {
 	conn := meta.(*conns.Client).DataProtectionAPI
 
 	body := config.RecoveryPointReplicationSpec{}
@@ -60,30 +88,36 @@ func ResourceNutanixRecoveryPointReplicateV2Create(ctx context.Context, d *schem
 
 	resp, err := conn.RecoveryPoint.ReplicateRecoveryPoint(utils.StringPtr(rpExtID), &body)
 	if err != nil {
-		return diag.Errorf("error while replicating recovery point: %v", err)
+		return "", diag.Errorf("error while replicating recovery point: %v", err)
 	}
 
 	TaskRef := resp.Data.GetValue().(dataprtotectionPrismConfig.TaskReference)
 	taskUUID := TaskRef.ExtId
+	d.Set("task_ext_id", utils.StringValue(taskUUID))
+	d.Set("replicated_rp_ext_id", "")
+
+	if !d.Get("wait_for_complete").(bool) {
+		return utils.StringValue(taskUUID), nil
+	}
 
 	taskconn := meta.(*conns.Client).PrismAPI
 	// Wait for the cluster to be available
 	stateConf := &resource.StateChangeConf{
-		Pending: []string{"PENDING", "RUNNING", "QUEUED"},
-		Target:  []string{"SUCCEEDED"},
+		Pending: utils.TaskPendingStates,
+		Target:  utils.TaskTargetStates,
 		Refresh: taskStateRefreshPrismTaskGroupFunc(ctx, taskconn, utils.StringValue(taskUUID)),
 		Timeout: d.Timeout(schema.TimeoutCreate),
 	}
 
 	if _, errWaitTask := stateConf.WaitForStateContext(ctx); errWaitTask != nil {
-		return diag.Errorf("error waiting for recovery point: (%s) to replicate: %s", utils.StringValue(taskUUID), errWaitTask)
+		return "", diag.Errorf("error waiting for recovery point: (%s) to replicate: %s", utils.StringValue(taskUUID), errWaitTask)
 	}
 
 	// Get UUID from TASK API
 
 	resourceUUID, err := taskconn.TaskRefAPI.GetTaskById(taskUUID, nil)
 	if err != nil {
-		return diag.Errorf("error while fetching recovery point UUID : %v", err)
+		return "", diag.Errorf("error while fetching recovery point UUID : %v", err)
 	}
 	rUUID := resourceUUID.Data.GetValue().(prismConfig.Task)
 
@@ -92,20 +126,101 @@ func ResourceNutanixRecoveryPointReplicateV2Create(ctx context.Context, d *schem
 
 	// set the UUID of the replicated recovery point
 	uuid := rUUID.CompletionDetails[0].Value
-	d.SetId(uuid.GetValue().(string))
 	d.Set("replicated_rp_ext_id", uuid.GetValue().(string))
 
-	return ResourceNutanixRecoveryPointReplicateV2Read(ctx, d, meta)
+	return utils.StringValue(taskUUID), nil
 }
 
+// ResourceNutanixRecoveryPointReplicateV2Read sets replicated_rp_ext_id of a
+// replication submitted without waiting, once its task has succeeded.
 func ResourceNutanixRecoveryPointReplicateV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	taskUUID := d.Get("task_ext_id").(string)
+	if d.Get("replicated_rp_ext_id").(string) != "" || taskUUID == "" {
+		return nil
+	}
+
+	taskconn := meta.(*conns.Client).PrismAPI
+	task, err := taskconn.GetTask(ctx, taskUUID)
+	if err != nil {
+		return diag.Errorf("error while fetching recovery point replication task %s: %v", taskUUID, err)
+	}
+
+	status := getTaskStatus(task.Status)
+	log.Printf("[DEBUG] recovery point replication task %s is %s", taskUUID, status)
+	if status != "SUCCEEDED" || len(task.CompletionDetails) == 0 || task.CompletionDetails[0].Value == nil {
+		return nil
+	}
+	if uuid, ok := task.CompletionDetails[0].Value.GetValue().(string); ok {
+		d.Set("replicated_rp_ext_id", uuid)
+	}
 	return nil
 }
 
+// ResourceNutanixRecoveryPointReplicateV2Update moves the replication to a new
+// target. A copy of the recovery point already present on the new cluster is
+// adopted instead of being replicated again.
 func ResourceNutanixRecoveryPointReplicateV2Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if !d.HasChanges("pc_ext_id", "cluster_ext_id") {
+		return ResourceNutanixRecoveryPointReplicateV2Read(ctx, d, meta)
+	}
+
+	replicatedRPExtID, err := recoveryPointOnCluster(meta, d.Get("ext_id").(string), d.Get("cluster_ext_id").(string))
+	if err != nil {
+		return diag.Errorf("error while looking up recovery point %s on cluster %s : %v", d.Get("ext_id").(string), d.Get("cluster_ext_id").(string), err)
+	}
+	if replicatedRPExtID != "" {
+		log.Printf("[DEBUG] recovery point %s is already present on cluster %s as %s, adopting it", d.Get("ext_id").(string), d.Get("cluster_ext_id").(string), replicatedRPExtID)
+		d.Set("task_ext_id", "")
+		d.Set("replicated_rp_ext_id", replicatedRPExtID)
+		return ResourceNutanixRecoveryPointReplicateV2Read(ctx, d, meta)
+	}
+
+	if _, diags := replicateRecoveryPoint(ctx, d, meta); diags.HasError() {
+		return diags
+	}
 	return ResourceNutanixRecoveryPointReplicateV2Read(ctx, d, meta)
 }
 
+// recoveryPointOnCluster returns the external identifier of the copy of a
+// recovery point present on a cluster, found by its location agnostic id, or
+// an empty string when there is none. Without a cluster there is nothing to
+// look up, the data-protection service picks the cluster of the replica.
+func recoveryPointOnCluster(meta interface{}, rpExtID, clusterExtID string) (string, error) {
+	if clusterExtID == "" {
+		return "", nil
+	}
+	conn := meta.(*conns.Client).DataProtectionAPI
+
+	resp, err := conn.RecoveryPoint.GetRecoveryPointById(utils.StringPtr(rpExtID))
+	if err != nil {
+		return "", err
+	}
+	rp, ok := resp.Data.GetValue().(config.RecoveryPoint)
+	if !ok || rp.LocationAgnosticId == nil {
+		return "", nil
+	}
+
+	for _, clusterID := range []*string{nil, utils.StringPtr(clusterExtID)} {
+		recoveryPoints, err := listRecoveryPointsByLocationAgnosticID(conn, *rp.LocationAgnosticId, clusterID)
+		if err != nil {
+			if clusterID == nil {
+				return "", err
+			}
+			// the cluster may not be reachable from this Prism Central
+			log.Printf("[DEBUG] could not list recovery points on cluster %s : %v", clusterExtID, err)
+			continue
+		}
+		for _, candidate := range recoveryPoints {
+			for _, id := range recoveryPointClusterExtIDs(candidate, clusterID) {
+				if id == clusterExtID {
+					return utils.StringValue(candidate.ExtId), nil
+				}
+			}
+		}
+	}
+	return "", nil
+}
+
 func ResourceNutanixRecoveryPointReplicateV2Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	return nil
 }
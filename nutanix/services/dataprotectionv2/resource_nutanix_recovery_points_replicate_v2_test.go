@@ -7,6 +7,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	acc "github.com/terraform-providers/terraform-provider-nutanix/nutanix/acctest"
 )
 
@@ -40,6 +41,78 @@ func TestAccV2NutanixRecoveryPointReplicateResource_basic(t *testing.T) {
 	})
 }
 
+func TestAccV2NutanixRecoveryPointReplicateResource_NoWait(t *testing.T) {
+	r := acctest.RandInt()
+	name := fmt.Sprintf("tf-test-recovery-point-%d", r)
+	vmName := fmt.Sprintf("tf-test-vm-rp-%d", r)
+	expirationTime := time.Now().Add(14 * 24 * time.Hour)
+
+	expirationTimeFormatted := expirationTime.UTC().Format(time.RFC3339)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccFoundationPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testVMConfigRecovery(vmName) +
+					testRecoveryPointReplicateNoWaitResourceConfig(name, expirationTimeFormatted),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceNameRecoveryPointReplicate, "wait_for_complete", "false"),
+					resource.TestCheckResourceAttrSet(resourceNameRecoveryPointReplicate, "task_ext_id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccV2NutanixRecoveryPointReplicateResource_AdoptOnTargetChange(t *testing.T) {
+	r := acctest.RandInt()
+	name := fmt.Sprintf("tf-test-recovery-point-%d", r)
+	vmName := fmt.Sprintf("tf-test-vm-rp-%d", r)
+	expirationTime := time.Now().Add(14 * 24 * time.Hour)
+
+	expirationTimeFormatted := expirationTime.UTC().Format(time.RFC3339)
+
+	var replicatedRPExtID string
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccFoundationPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			// replicate to the remote Prism Central only, letting the
+			// data-protection service pick the cluster
+			{
+				Config: testVMConfigRecovery(vmName) +
+					testRecoveryPointReplicatePcOnlyResourceConfig(name, expirationTimeFormatted),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceNameRecoveryPointReplicate, "replicated_rp_ext_id"),
+					testAccCheckRecoveryPointReplicated(resourceNameRecoveryPointReplicate, &replicatedRPExtID),
+				),
+			},
+			// naming the cluster the replica landed on adopts it in place
+			{
+				Config: testVMConfigRecovery(vmName) +
+					testRecoveryPointReplicateResourceConfig(name, expirationTimeFormatted),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceNameRecoveryPointReplicate, "cluster_ext_id", testVars.DataProtection.ClusterExtID),
+					resource.TestCheckResourceAttr(resourceNameRecoveryPointReplicate, "task_ext_id", ""),
+					resource.TestCheckResourceAttrPtr(resourceNameRecoveryPointReplicate, "replicated_rp_ext_id", &replicatedRPExtID),
+				),
+			},
+		},
+	})
+}
+
+func testRecoveryPointReplicateNoWaitResourceConfig(name, expirationTime string) string {
+	return testRecoveryPointsResourceConfigWithVMRecoveryPoints(name, expirationTime) + `
+	resource "nutanix_recovery_point_replicate_v2" "test" {
+	  ext_id            = nutanix_recovery_points_v2.test.id
+	  cluster_ext_id    = local.data_protection.cluster_ext_id
+	  pc_ext_id         = local.data_protection.pc_ext_id
+	  wait_for_complete = false
+	  depends_on        = [nutanix_recovery_points_v2.test]
+	}`
+}
+
 func testRecoveryPointReplicateResourceConfig(name, expirationTime string) string {
 	return testRecoveryPointsResourceConfigWithVMRecoveryPoints(name, expirationTime) + `
 	resource "nutanix_recovery_point_replicate_v2" "test" {
@@ -49,3 +122,23 @@ func testRecoveryPointReplicateResourceConfig(name, expirationTime string) strin
 	  depends_on     = [nutanix_recovery_points_v2.test]
 	}`
 }
+
+func testRecoveryPointReplicatePcOnlyResourceConfig(name, expirationTime string) string {
+	return testRecoveryPointsResourceConfigWithVMRecoveryPoints(name, expirationTime) + `
+	resource "nutanix_recovery_point_replicate_v2" "test" {
+	  ext_id     = nutanix_recovery_points_v2.test.id
+	  pc_ext_id  = local.data_protection.pc_ext_id
+	  depends_on = [nutanix_recovery_points_v2.test]
+	}`
+}
+
+func testAccCheckRecoveryPointReplicated(resourceName string, replicatedRPExtID *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+		*replicatedRPExtID = rs.Primary.Attributes["replicated_rp_ext_id"]
+		return nil
+	}
+}
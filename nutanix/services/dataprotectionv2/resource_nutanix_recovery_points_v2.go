@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"log"
 	"sort"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -16,7 +17,10 @@ import (
 	"github.com/nutanix/ntnx-api-golang-clients/dataprotection-go-client/v4/models/dataprotection/v4/config"
 	dataprtotectionPrismConfig "github.com/nutanix/ntnx-api-golang-clients/dataprotection-go-client/v4/models/prism/v4/config"
 	prismConfig "github.com/nutanix/ntnx-api-golang-clients/prism-go-client/v4/models/prism/v4/config"
+	vmmConfig "github.com/nutanix/ntnx-api-golang-clients/vmm-go-client/v4/models/vmm/v4/ahv/config"
+	volumesConfig "github.com/nutanix/ntnx-api-golang-clients/volumes-go-client/v4/models/volumes/v4/config"
 	conns "github.com/terraform-providers/terraform-provider-nutanix/nutanix"
+	"github.com/terraform-providers/terraform-provider-nutanix/nutanix/sdks/v4/dataprotection"
 	"github.com/terraform-providers/terraform-provider-nutanix/nutanix/sdks/v4/prism"
 	"github.com/terraform-providers/terraform-provider-nutanix/utils"
 )
@@ -46,14 +50,31 @@ func ResourceNutanixRecoveryPointsV2() *schema.Resource {
 				Computed: true,
 				Optional: true,
 			},
+			"labels": {
+				Type:         schema.TypeMap,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validateRecoveryPointLabels,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
 			"creation_time": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
 			"expiration_time": {
-				Type:     schema.TypeString,
-				Computed: true,
-				Optional: true,
+				Type:          schema.TypeString,
+				Computed:      true,
+				Optional:      true,
+				ConflictsWith: []string{"expiration_duration"},
+			},
+			"expiration_duration": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"expiration_time"},
+				ValidateFunc:  validatePositiveDuration,
 			},
 			"status": {
 				Type:         schema.TypeString,
@@ -271,10 +292,218 @@ func ResourceNutanixRecoveryPointsV2() *schema.Resource {
 					return false
 				},
 			},
+			"dedupe_by_name": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"dedupe_window": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "24h",
+				ValidateFunc: validateDuration,
+			},
+			"consistency_group_ext_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"wait_for_task": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"task_ext_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 		},
 	}
 }
 
+// expandRecoveryPointExpirationTime returns the configured expiration time of
+// the recovery point, expiration_duration being counted from now, or nil when
+// neither expiration_time nor expiration_duration is set.
+func expandRecoveryPointExpirationTime(d *schema.ResourceData, now time.Time) (*time.Time, error) {
+	if expirationDuration, ok := d.GetOk("expiration_duration"); ok {
+		duration, err := time.ParseDuration(expirationDuration.(string))
+		if err != nil {
+			return nil, fmt.Errorf("error while parsing expiration_duration : %v", err)
+		}
+		expTime := now.Add(duration).UTC().Truncate(time.Second)
+		return &expTime, nil
+	}
+	if expirationTime, ok := d.GetOk("expiration_time"); ok {
+		expTime, err := time.Parse(time.RFC3339, expirationTime.(string))
+		if err != nil {
+			return nil, fmt.Errorf("error while parsing expiration Time : %v", err)
+		}
+		return &expTime, nil
+	}
+	return nil, nil
+}
+
+func validatePositiveDuration(v interface{}, k string) (ws []string, es []error) {
+	duration, err := time.ParseDuration(v.(string))
+	if err != nil {
+		es = append(es, fmt.Errorf("%q must be a duration such as 336h, got %q: %v", k, v, err))
+	} else if duration <= 0 {
+		es = append(es, fmt.Errorf("%q must be a positive duration, got %q", k, v))
+	}
+	return
+}
+
+func validateDuration(v interface{}, k string) (ws []string, es []error) {
+	if _, err := time.ParseDuration(v.(string)); err != nil {
+		es = append(es, fmt.Errorf("%q must be a duration such as 30m or 24h, got %q: %v", k, v, err))
+	}
+	return
+}
+
+// findRecentRecoveryPointByName returns the ext id of the recovery point named
+// name created within window whose VM and Volume Group members are the
+// vmExtIDs and volumeGroupExtIDs, or an empty string if there is none. A
+// recovery point of other members is never adopted, and more than one match
+// is an error, adopting either could pick the wrong one.
+func findRecentRecoveryPointByName(conn *dataprotection.Client, name string, window time.Duration, vmExtIDs, volumeGroupExtIDs []string) (string, error) {
+	since := time.Now().UTC().Add(-window).Format(time.RFC3339)
+	filter := fmt.Sprintf("name eq %s and creationTime gt %s", utils.ODataString(name), since)
+
+	resp, err := conn.RecoveryPoint.ListRecoveryPoints(nil, nil, nil, &filter, nil, nil)
+	if err != nil {
+		return "", err
+	}
+	if resp.Data == nil {
+		return "", nil
+	}
+	recoveryPoints, _ := resp.Data.GetValue().([]config.RecoveryPoint)
+
+	matches := make([]string, 0)
+	for _, recoveryPoint := range recoveryPoints {
+		// the list does not return the members of the recovery points
+		getResp, err := conn.RecoveryPoint.GetRecoveryPointById(recoveryPoint.ExtId)
+		if err != nil {
+			return "", err
+		}
+		rp := getResp.Data.GetValue().(config.RecoveryPoint)
+
+		vms := make([]string, 0, len(rp.VmRecoveryPoints))
+		for _, vm := range rp.VmRecoveryPoints {
+			vms = append(vms, utils.StringValue(vm.VmExtId))
+		}
+		volumeGroups := make([]string, 0, len(rp.VolumeGroupRecoveryPoints))
+		for _, vg := range rp.VolumeGroupRecoveryPoints {
+			volumeGroups = append(volumeGroups, utils.StringValue(vg.VolumeGroupExtId))
+		}
+		if sameExtIDs(vms, vmExtIDs) && sameExtIDs(volumeGroups, volumeGroupExtIDs) {
+			matches = append(matches, utils.StringValue(rp.ExtId))
+		}
+	}
+
+	if len(matches) > 1 {
+		return "", fmt.Errorf("%d recovery points named %s of the same VMs and Volume Groups were created within %s (%s), delete the duplicates or disable dedupe_by_name",
+			len(matches), name, window, strings.Join(matches, ", "))
+	}
+	if len(matches) == 0 {
+		return "", nil
+	}
+	return matches[0], nil
+}
+
+// recoveryPointMemberExtIDs returns the key ext id of each member of the
+// vm_recovery_points or volume_group_recovery_points list.
+func recoveryPointMemberExtIDs(members interface{}, key string) []string {
+	list, _ := members.([]interface{})
+	extIDs := make([]string, 0, len(list))
+	for _, member := range list {
+		if m, ok := member.(map[string]interface{}); ok {
+			extIDs = append(extIDs, m[key].(string))
+		}
+	}
+	return extIDs
+}
+
+// sameExtIDs reports whether a and b hold the same ext ids, in any order.
+func sameExtIDs(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	sortedA := append([]string(nil), a...)
+	sortedB := append([]string(nil), b...)
+	sort.Strings(sortedA)
+	sort.Strings(sortedB)
+	for i := range sortedA {
+		if sortedA[i] != sortedB[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// Recovery points have no metadata of their own, their labels are kept at the
+// end of their name as ` [key1=value1,key2=value2]`, keys sorted.
+const recoveryPointLabelSeparators = "[]=,"
+
+// recoveryPointNameWithLabels returns the name of a recovery point carrying
+// labels.
+func recoveryPointNameWithLabels(name string, labels map[string]interface{}) string {
+	if len(labels) == 0 {
+		return name
+	}
+	keys := make([]string, 0, len(labels))
+	for k := range labels {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	pairs := make([]string, len(keys))
+	for i, k := range keys {
+		pairs[i] = fmt.Sprintf("%s=%v", k, labels[k])
+	}
+	return strings.TrimSpace(fmt.Sprintf("%s [%s]", name, strings.Join(pairs, ",")))
+}
+
+// splitRecoveryPointName returns the name of a recovery point without its
+// labels, and the labels. A name not ending with labels is returned whole.
+func splitRecoveryPointName(name string) (string, map[string]interface{}) {
+	labels := make(map[string]interface{})
+	start := strings.LastIndex(name, "[")
+	if start < 0 || !strings.HasSuffix(name, "]") || (start > 0 && name[start-1] != ' ') {
+		return name, labels
+	}
+	for _, pair := range strings.Split(name[start+1:len(name)-1], ",") {
+		kv := strings.SplitN(pair, "=", 2)
+		if len(kv) != 2 || kv[0] == "" {
+			return name, make(map[string]interface{})
+		}
+		labels[kv[0]] = kv[1]
+	}
+	return strings.TrimSuffix(name[:start], " "), labels
+}
+
+// recoveryPointHasLabels reports whether a recovery point carries every label
+// of labels.
+func recoveryPointHasLabels(name string, labels map[string]interface{}) bool {
+	_, rpLabels := splitRecoveryPointName(name)
+	for k, v := range labels {
+		if rpLabel, ok := rpLabels[k]; !ok || rpLabel != v {
+			return false
+		}
+	}
+	return true
+}
+
+func validateRecoveryPointLabels(v interface{}, k string) (ws []string, es []error) {
+	for key, value := range v.(map[string]interface{}) {
+		if key == "" {
+			es = append(es, fmt.Errorf("%q keys must not be empty", k))
+		}
+		if strings.ContainsAny(key, recoveryPointLabelSeparators) || strings.ContainsAny(fmt.Sprint(value), recoveryPointLabelSeparators) {
+			es = append(es, fmt.Errorf("%q key %q and its value must not contain any of %q, they are stored in the recovery point name", k, key, recoveryPointLabelSeparators))
+		}
+	}
+	return
+}
+
 // Helper function to compare two lists of maps for equality
 func isListEqual(oldList, newList []interface{}, key string) bool {
 	if len(oldList) != len(newList) {
@@ -309,13 +538,34 @@ func ResourceNutanixRecoveryPointsV2Create(ctx context.Context, d *schema.Resour
 	if name, ok := d.GetOk("name"); ok {
 		body.Name = utils.StringPtr(name.(string))
 	}
-	if expirationTime, ok := d.GetOk("expiration_time"); ok {
-		expTime, err := time.Parse(time.RFC3339, expirationTime.(string))
+	if labels, ok := d.GetOk("labels"); ok {
+		body.Name = utils.StringPtr(recoveryPointNameWithLabels(d.Get("name").(string), labels.(map[string]interface{})))
+	}
+
+	// adopt a recovery point left behind by a previous, failed apply instead of
+	// creating a duplicate
+	if d.Get("dedupe_by_name").(bool) {
+		if body.Name == nil {
+			return diag.Errorf("name must be set when dedupe_by_name is enabled")
+		}
+		window, _ := time.ParseDuration(d.Get("dedupe_window").(string))
+		existingExtID, err := findRecentRecoveryPointByName(conn, *body.Name, window,
+			recoveryPointMemberExtIDs(d.Get("vm_recovery_points"), "vm_ext_id"), recoveryPointMemberExtIDs(d.Get("volume_group_recovery_points"), "volume_group_ext_id"))
 		if err != nil {
-			return diag.Errorf("error while parsing expiration Time : %v", err)
+			return diag.Errorf("error while looking up existing recovery point %s : %v", *body.Name, err)
+		}
+		if existingExtID != "" {
+			log.Printf("[INFO] adopting existing recovery point %s (%s) created within %s", *body.Name, existingExtID, window)
+			d.SetId(existingExtID)
+			return ResourceNutanixRecoveryPointsV2Read(ctx, d, meta)
 		}
-		body.ExpirationTime = &expTime
 	}
+
+	expirationTime, err := expandRecoveryPointExpirationTime(d, time.Now())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	body.ExpirationTime = expirationTime
 	if status, ok := d.GetOk("status"); ok {
 		const two = 2
 		statusMap := map[string]interface{}{
@@ -348,6 +598,19 @@ func ResourceNutanixRecoveryPointsV2Create(ctx context.Context, d *schema.Resour
 		body.VolumeGroupRecoveryPoints = expandVolumeGroupRecoveryPoints(volumeGroupRecoveryPoints.([]interface{}))
 	}
 
+	// group every member so the recovery point is crash consistent across them
+	if consistencyGroupExtID, ok := d.GetOk("consistency_group_ext_id"); ok {
+		if err := validateRecoveryPointMembersCluster(meta, body); err != nil {
+			return diag.FromErr(err)
+		}
+		for i := range body.VmRecoveryPoints {
+			body.VmRecoveryPoints[i].ConsistencyGroupExtId = utils.StringPtr(consistencyGroupExtID.(string))
+		}
+		for i := range body.VolumeGroupRecoveryPoints {
+			body.VolumeGroupRecoveryPoints[i].ConsistencyGroupExtId = utils.StringPtr(consistencyGroupExtID.(string))
+		}
+	}
+
 	aJSON, _ := json.MarshalIndent(body, "", "  ")
 	log.Printf("[DEBUG] RecoveryPoint Body: %v", string(aJSON))
 
@@ -359,11 +622,19 @@ func ResourceNutanixRecoveryPointsV2Create(ctx context.Context, d *schema.Resour
 	TaskRef := resp.Data.GetValue().(dataprtotectionPrismConfig.TaskReference)
 	taskUUID := TaskRef.ExtId
 
+	// the id stays the task one until the task reports the recovery point, see
+	// reconcileRecoveryPointCreateTask
+	if !d.Get("wait_for_task").(bool) {
+		d.SetId(utils.StringValue(taskUUID))
+		d.Set("task_ext_id", utils.StringValue(taskUUID))
+		return ResourceNutanixRecoveryPointsV2Read(ctx, d, meta)
+	}
+
 	taskconn := meta.(*conns.Client).PrismAPI
 	// Wait for the cluster to be available
 	stateConf := &resource.StateChangeConf{
-		Pending: []string{"PENDING", "RUNNING", "QUEUED"},
-		Target:  []string{"SUCCEEDED"},
+		Pending: utils.TaskPendingStates,
+		Target:  utils.TaskTargetStates,
 		Refresh: taskStateRefreshPrismTaskGroupFunc(ctx, taskconn, utils.StringValue(taskUUID)),
 		Timeout: d.Timeout(schema.TimeoutCreate),
 	}
@@ -395,8 +666,23 @@ func ResourceNutanixRecoveryPointsV2Read(ctx context.Context, d *schema.Resource
 
 	conn := meta.(*conns.Client).DataProtectionAPI
 
+	if d.Get("task_ext_id").(string) != "" {
+		pending, err := reconcileRecoveryPointCreateTask(ctx, d, meta)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if pending || d.Id() == "" {
+			return nil
+		}
+	}
+
 	resp, err := conn.RecoveryPoint.GetRecoveryPointById(utils.StringPtr(d.Id()))
 	if err != nil {
+		if utils.IsV4NotFound(err) {
+			log.Printf("[DEBUG] recovery point %s not found, removing it from state", d.Id())
+			d.SetId("")
+			return nil
+		}
 		return diag.Errorf("error while fetching recovery point: %v", err)
 	}
 
@@ -414,7 +700,11 @@ func ResourceNutanixRecoveryPointsV2Read(ctx context.Context, d *schema.Resource
 	if err := d.Set("location_agnostic_id", getResp.LocationAgnosticId); err != nil {
 		return diag.FromErr(err)
 	}
-	if err := d.Set("name", getResp.Name); err != nil {
+	name, labels := splitRecoveryPointName(utils.StringValue(getResp.Name))
+	if err := d.Set("name", name); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("labels", labels); err != nil {
 		return diag.FromErr(err)
 	}
 	if err := d.Set("creation_time", flattenTime(getResp.CreationTime)); err != nil {
@@ -455,7 +745,32 @@ func ResourceNutanixRecoveryPointsV2Read(ctx context.Context, d *schema.Resource
 
 	// If there are any VM Recovery Points left in the response, update the resource
 	if len(respRecoveryPoints) > 0 {
-		if err := d.Set("vm_recovery_points", flattenVMRecoveryPoints(getResp.VmRecoveryPoints)); err != nil {
+		vmRecoveryPoints := flattenVMRecoveryPoints(getResp.VmRecoveryPoints)
+		for _, vmRecoveryPoint := range vmRecoveryPoints {
+			for _, resourceVMRecoveryPoint := range resourceVMRecoveryPoints {
+				resourceVMRecoveryPointMap := resourceVMRecoveryPoint.(map[string]interface{})
+				if vmRecoveryPoint["vm_ext_id"] != nil && utils.StringValue(vmRecoveryPoint["vm_ext_id"].(*string)) == resourceVMRecoveryPointMap["vm_ext_id"] {
+					vmRecoveryPoint["application_consistent_properties"] = mergeApplicationConsistentProperties(
+						resourceVMRecoveryPointMap["application_consistent_properties"], vmRecoveryPoint["application_consistent_properties"])
+				}
+			}
+		}
+		if err := d.Set("vm_recovery_points", vmRecoveryPoints); err != nil {
+			return diag.FromErr(err)
+		}
+	} else {
+		// refresh the application consistent properties of the VM Recovery Points already in the resource
+		for _, vmRecoveryPoint := range resourceVMRecoveryPoints {
+			vmRecoveryPointMap := vmRecoveryPoint.(map[string]interface{})
+			for _, respRecoveryPoint := range getResp.VmRecoveryPoints {
+				if vmRecoveryPointMap["ext_id"] == utils.StringValue(respRecoveryPoint.ExtId) {
+					vmRecoveryPointMap["application_consistent_properties"] = mergeApplicationConsistentProperties(
+						vmRecoveryPointMap["application_consistent_properties"],
+						flattenApplicationConsistentProperties(respRecoveryPoint.ApplicationConsistentProperties))
+				}
+			}
+		}
+		if err := d.Set("vm_recovery_points", resourceVMRecoveryPoints); err != nil {
 			return diag.FromErr(err)
 		}
 	}
@@ -492,6 +807,13 @@ func ResourceNutanixRecoveryPointsV2Update(ctx context.Context, d *schema.Resour
 
 	conn := meta.(*conns.Client).DataProtectionAPI
 
+	if !d.HasChangesExcept("wait_for_task") {
+		return ResourceNutanixRecoveryPointsV2Read(ctx, d, meta)
+	}
+	if taskUUID := d.Get("task_ext_id").(string); taskUUID != "" {
+		return diag.Errorf("recovery point is still being created by task %s, apply again once it has completed", taskUUID)
+	}
+
 	readResp, err := conn.RecoveryPoint.GetRecoveryPointById(utils.StringPtr(d.Id()))
 	if err != nil {
 		return diag.Errorf("error while fetching recovery point: %v", err)
@@ -505,17 +827,21 @@ func ResourceNutanixRecoveryPointsV2Update(ctx context.Context, d *schema.Resour
 
 	body := config.ExpirationTimeSpec{}
 
-	if d.HasChange("expiration_time") {
-		expirationTime, ok := d.GetOk("expiration_time")
-		if ok {
-			expTime, errTime := time.Parse(time.RFC3339, expirationTime.(string))
-			if errTime != nil {
-				return diag.Errorf("error while parsing expiration Time : %v", errTime)
-			}
-			body.ExpirationTime = &expTime
+	if d.HasChanges("expiration_time", "expiration_duration") {
+		// a new expiration_duration counts from this apply
+		expirationTime, errTime := expandRecoveryPointExpirationTime(d, time.Now())
+		if errTime != nil {
+			return diag.FromErr(errTime)
+		}
+		if expirationTime == nil {
+			// both were removed from the configuration, keep the current expiration
+			return ResourceNutanixRecoveryPointsV2Read(ctx, d, meta)
 		}
+		body.ExpirationTime = expirationTime
+	} else if d.HasChangesExcept("dedupe_by_name", "dedupe_window", "wait_for_task") {
+		return diag.Errorf("expiration_time and expiration_duration are the only fields that can be updated")
 	} else {
-		return diag.Errorf("expiration_time is the only field that can be updated")
+		return ResourceNutanixRecoveryPointsV2Read(ctx, d, meta)
 	}
 
 	aJSON, _ := json.MarshalIndent(body, "", "  ")
@@ -533,8 +859,8 @@ func ResourceNutanixRecoveryPointsV2Update(ctx context.Context, d *schema.Resour
 	// Wait for the cluster to be available
 
 	stateConf := &resource.StateChangeConf{
-		Pending: []string{"PENDING", "RUNNING", "QUEUED"},
-		Target:  []string{"SUCCEEDED"},
+		Pending: utils.TaskPendingStates,
+		Target:  utils.TaskTargetStates,
 		Refresh: taskStateRefreshPrismTaskGroupFunc(ctx, taskconn, utils.StringValue(taskUUID)),
 		Timeout: d.Timeout(schema.TimeoutUpdate),
 	}
@@ -560,6 +886,19 @@ func ResourceNutanixRecoveryPointsV2Update(ctx context.Context, d *schema.Resour
 func ResourceNutanixRecoveryPointsV2Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.Client).DataProtectionAPI
 
+	if d.Get("task_ext_id").(string) != "" {
+		pending, err := reconcileRecoveryPointCreateTask(ctx, d, meta)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if d.Id() == "" {
+			return nil
+		}
+		if pending {
+			return diag.Errorf("recovery point is still being created by task %s, destroy again once it has completed", d.Get("task_ext_id").(string))
+		}
+	}
+
 	resp, err := conn.RecoveryPoint.DeleteRecoveryPointById(utils.StringPtr(d.Id()))
 	if err != nil {
 		return diag.Errorf("error while deleting recovery point: %v", err)
@@ -573,8 +912,8 @@ func ResourceNutanixRecoveryPointsV2Delete(ctx context.Context, d *schema.Resour
 
 	// Wait for the cluster to be available
 	stateConf := &resource.StateChangeConf{
-		Pending: []string{"PENDING", "RUNNING", "QUEUED"},
-		Target:  []string{"SUCCEEDED"},
+		Pending: utils.TaskPendingStates,
+		Target:  utils.TaskTargetStates,
 		Refresh: taskStateRefreshPrismTaskGroupFunc(ctx, taskconn, utils.StringValue(taskUUID)),
 		Timeout: d.Timeout(schema.TimeoutDelete),
 	}
@@ -675,6 +1014,25 @@ func expandVMRecoveryPoints(vmRecoveryPoints []interface{}) ([]config.VmRecovery
 	return vmRecoveryPointsList, nil
 }
 
+// mergeApplicationConsistentProperties returns the application consistent
+// properties reported by the API. The configured properties are kept when the
+// API does not report them, and the configured object_type is kept when both
+// refer to VssProperties since the API always answers with the latest version.
+func mergeApplicationConsistentProperties(configured interface{}, reported interface{}) interface{} {
+	reportedList, _ := reported.([]map[string]interface{})
+	configuredList, _ := configured.([]interface{})
+	if len(reportedList) == 0 {
+		return configured
+	}
+	if len(configuredList) > 0 && configuredList[0] != nil {
+		configuredObjectType := configuredList[0].(map[string]interface{})["object_type"]
+		if configuredObjectType == ApplicationConsistentPropertiesVss1 || configuredObjectType == ApplicationConsistentPropertiesVss2 {
+			reportedList[0]["object_type"] = configuredObjectType
+		}
+	}
+	return reportedList
+}
+
 func expandApplicationConsistentProperties(appConsistentProp interface{}) (*config.OneOfVmRecoveryPointApplicationConsistentProperties, error) {
 	if appConsistentProp == nil {
 		log.Printf("[DEBUG] application consistent properties is Empty")
@@ -728,46 +1086,66 @@ func expandWritersList(writers []interface{}) []string {
 }
 
 func taskStateRefreshPrismTaskGroupFunc(ctx context.Context, client *prism.Client, taskUUID string) resource.StateRefreshFunc {
+	poll := &utils.TaskStatusPoll{}
 	return func() (interface{}, string, error) {
 		// data := base64.StdEncoding.EncodeToString([]byte("ergon"))
 		// encodeUUID := data + ":" + taskUUID
-		vresp, err := client.TaskRefAPI.GetTaskById(utils.StringPtr(taskUUID), nil)
+		// batched with the other running tasks when task_poll_batch_interval is set
+		v, err := client.GetTask(ctx, taskUUID)
 		if err != nil {
-			return "", "", (fmt.Errorf("error while polling prism task: %v", err))
+			return "", "", &utils.TaskPollError{TaskExtID: taskUUID, Err: err}
 		}
 
-		// get the group results
-
-		v := vresp.Data.GetValue().(prismConfig.Task)
+		status, err := poll.Status(taskUUID, v.Status)
+		utils.LogTaskPoll(client.LogFormat, v, status)
+		if err != nil {
+			return v, status, err
+		}
 
-		if getTaskStatus(v.Status) == "CANCELED" || getTaskStatus(v.Status) == "FAILED" {
-			return v, getTaskStatus(v.Status),
-				fmt.Errorf("error_detail: %s, progress_message: %d", utils.StringValue(v.ErrorMessages[0].Message), utils.IntValue(v.ProgressPercentage))
+		if utils.IsTaskFailed(status) {
+			return v, status, utils.NewTaskFailedError(taskUUID, status, v)
 		}
-		return v, getTaskStatus(v.Status), nil
+		return v, status, nil
 	}
 }
 
-func getTaskStatus(pr *prismConfig.TaskStatus) string {
-	if pr != nil {
-		const two, three, five, six, seven = 2, 3, 5, 6, 7
-		if *pr == prismConfig.TaskStatus(six) {
-			return "FAILED"
-		}
-		if *pr == prismConfig.TaskStatus(seven) {
-			return "CANCELED"
-		}
-		if *pr == prismConfig.TaskStatus(two) {
-			return "QUEUED"
-		}
-		if *pr == prismConfig.TaskStatus(three) {
-			return "RUNNING"
-		}
-		if *pr == prismConfig.TaskStatus(five) {
-			return "SUCCEEDED"
-		}
+// reconcileRecoveryPointCreateTask checks the create task of a recovery point
+// submitted with wait_for_task = false. The task reports the recovery point in
+// its completion details, so the id is set and task_ext_id cleared once it
+// succeeded. A failed create is removed from the state so that it is created
+// again. pending is true while the task runs.
+func reconcileRecoveryPointCreateTask(ctx context.Context, d *schema.ResourceData, meta interface{}) (pending bool, err error) {
+	taskUUID := d.Get("task_ext_id").(string)
+	task, err := meta.(*conns.Client).PrismAPI.GetTask(ctx, taskUUID)
+	if err != nil {
+		return false, fmt.Errorf("error while fetching the create task %s of the recovery point : %v", taskUUID, err)
+	}
+
+	status := getTaskStatus(task.Status)
+	if utils.IsTaskFailed(status) {
+		log.Printf("[WARN] create task %s of recovery point %s is %s, removing it from state", taskUUID, d.Id(), status)
+		d.SetId("")
+		return false, nil
+	}
+	if status != "SUCCEEDED" {
+		log.Printf("[DEBUG] create task %s of recovery point is %s", taskUUID, status)
+		return true, nil
+	}
+
+	if len(task.CompletionDetails) == 0 || task.CompletionDetails[0].Value == nil {
+		return false, fmt.Errorf("create task %s of the recovery point succeeded without reporting the recovery point", taskUUID)
 	}
-	return "UNKNOWN"
+	extID, ok := task.CompletionDetails[0].Value.GetValue().(string)
+	if !ok {
+		return false, fmt.Errorf("create task %s of the recovery point reported an unexpected completion detail", taskUUID)
+	}
+	d.SetId(extID)
+	d.Set("task_ext_id", "")
+	return false, nil
+}
+
+func getTaskStatus(pr *prismConfig.TaskStatus) string {
+	return utils.TaskStatusName(pr)
 }
 
 // Function to remove a Vm recovery Point with a specific Ext Id from the slice
@@ -793,3 +1171,53 @@ func removeVolumeGroupRecoveryPointByExtID(recoveryPoints []config.VolumeGroupRe
 	}
 	return result
 }
+
+// validateRecoveryPointMembersCluster checks that the VMs and volume groups of a
+// recovery point all reside on the same cluster, a consistency group can not
+// span clusters.
+func validateRecoveryPointMembersCluster(meta interface{}, body config.RecoveryPoint) error {
+	vmmConn := meta.(*conns.Client).VmmAPI
+	volumesConn := meta.(*conns.Client).VolumeAPI
+
+	var clusterExtID, firstMember string
+	checkCluster := func(member, memberClusterExtID string) error {
+		if firstMember == "" {
+			clusterExtID, firstMember = memberClusterExtID, member
+			return nil
+		}
+		if memberClusterExtID != clusterExtID {
+			return fmt.Errorf("all members of a consistency group must reside on the same cluster, %s is on cluster %s but %s is on cluster %s",
+				firstMember, clusterExtID, member, memberClusterExtID)
+		}
+		return nil
+	}
+
+	for _, vmRecoveryPoint := range body.VmRecoveryPoints {
+		vmExtID := utils.StringValue(vmRecoveryPoint.VmExtId)
+		resp, err := vmmConn.VMAPIInstance.GetVmById(utils.StringPtr(vmExtID))
+		if err != nil {
+			return fmt.Errorf("error while fetching vm %s : %v", vmExtID, err)
+		}
+		vm := resp.Data.GetValue().(vmmConfig.Vm)
+		vmClusterExtID := ""
+		if vm.Cluster != nil {
+			vmClusterExtID = utils.StringValue(vm.Cluster.ExtId)
+		}
+		if err := checkCluster("vm "+vmExtID, vmClusterExtID); err != nil {
+			return err
+		}
+	}
+
+	for _, volumeGroupRecoveryPoint := range body.VolumeGroupRecoveryPoints {
+		volumeGroupExtID := utils.StringValue(volumeGroupRecoveryPoint.VolumeGroupExtId)
+		resp, err := volumesConn.VolumeAPIInstance.GetVolumeGroupById(utils.StringPtr(volumeGroupExtID))
+		if err != nil {
+			return fmt.Errorf("error while fetching volume group %s : %v", volumeGroupExtID, err)
+		}
+		volumeGroup := resp.Data.GetValue().(volumesConfig.VolumeGroup)
+		if err := checkCluster("volume group "+volumeGroupExtID, utils.StringValue(volumeGroup.ClusterReference)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
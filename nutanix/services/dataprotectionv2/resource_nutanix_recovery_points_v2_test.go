@@ -1,14 +1,23 @@
 package dataprotectionv2_test
 
 import (
+	"context"
 	"fmt"
 	"os"
+	"regexp"
 	"testing"
 	"time"
 
+	"github.com/hashicorp/go-uuid"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	dataprotectionCommon "github.com/nutanix/ntnx-api-golang-clients/dataprotection-go-client/v4/models/dataprotection/v4/common"
+	dataprotectionConfig "github.com/nutanix/ntnx-api-golang-clients/dataprotection-go-client/v4/models/dataprotection/v4/config"
+	dataprotectionPrism "github.com/nutanix/ntnx-api-golang-clients/dataprotection-go-client/v4/models/prism/v4/config"
+	conns "github.com/terraform-providers/terraform-provider-nutanix/nutanix"
 	acc "github.com/terraform-providers/terraform-provider-nutanix/nutanix/acctest"
+	"github.com/terraform-providers/terraform-provider-nutanix/utils"
 )
 
 const resourceNameRecoveryPoints = "nutanix_recovery_points_v2.test"
@@ -54,7 +63,6 @@ func TestAccV2NutanixRecoveryPointsResource_VmRecoveryPoints2(t *testing.T) {
 }
 
 func TestAccV2NutanixRecoveryPointsResource_VmRecoveryPointsWithAppConsProps(t *testing.T) {
-	t.Skip("Skipping this test case as it is failing due to missing app consistent properties in get request")
 	r := acctest.RandInt()
 	name := fmt.Sprintf("terraform-test-recovery-point-%d", r)
 	vmName := fmt.Sprintf("tf-test-rp-vm-%d", r)
@@ -79,6 +87,7 @@ func TestAccV2NutanixRecoveryPointsResource_VmRecoveryPointsWithAppConsProps(t *
 					resource.TestCheckResourceAttr(resourceNameRecoveryPoints, "vm_recovery_points.0.application_consistent_properties.0.backup_type", "FULL_BACKUP"),
 					resource.TestCheckResourceAttr(resourceNameRecoveryPoints, "vm_recovery_points.0.application_consistent_properties.0.should_include_writers", "true"),
 					resource.TestCheckResourceAttr(resourceNameRecoveryPoints, "vm_recovery_points.0.application_consistent_properties.0.should_store_vss_metadata", "true"),
+					resource.TestCheckResourceAttr(resourceNameRecoveryPoints, "vm_recovery_points.0.application_consistent_properties.0.object_type", "dataprotection.v4.common.VssProperties"),
 				),
 			},
 		},
@@ -249,6 +258,334 @@ func TestAccV2NutanixRecoveryPointsResource_UpdateExpirationTime(t *testing.T) {
 	})
 }
 
+func TestAccV2NutanixRecoveryPointsResource_ExpirationDuration(t *testing.T) {
+	r := acctest.RandInt()
+	name := fmt.Sprintf("terraform-test-recovery-point-%d", r)
+	vmName := fmt.Sprintf("tf-test-rp-vm-%d", r)
+
+	expirationTimeFormatted := time.Now().Add(14 * 24 * time.Hour).UTC().Format(time.RFC3339)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccFoundationPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			// expiration_time and expiration_duration are mutually exclusive
+			{
+				Config:      testVMConfigRecovery(vmName) + testRecoveryPointsResourceConfigWithExpirationDuration(name, "336h", expirationTimeFormatted),
+				ExpectError: regexp.MustCompile(`"expiration_duration": conflicts with expiration_time`),
+			},
+			{
+				Config: testVMConfigRecovery(vmName) + testRecoveryPointsResourceConfigWithExpirationDuration(name, "336h", ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceNameRecoveryPoints, "ext_id"),
+					resource.TestCheckResourceAttr(resourceNameRecoveryPoints, "expiration_duration", "336h"),
+					testAccCheckRecoveryPointExpiresIn(resourceNameRecoveryPoints, 336*time.Hour),
+				),
+			},
+			// the duration is resolved once, a later plan shows no change
+			{
+				Config:   testVMConfigRecovery(vmName) + testRecoveryPointsResourceConfigWithExpirationDuration(name, "336h", ""),
+				PlanOnly: true,
+			},
+			{
+				Config: testVMConfigRecovery(vmName) + testRecoveryPointsResourceConfigWithExpirationDuration(name, "720h", ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceNameRecoveryPoints, "expiration_duration", "720h"),
+					testAccCheckRecoveryPointExpiresIn(resourceNameRecoveryPoints, 720*time.Hour),
+				),
+			},
+		},
+	})
+}
+
+func TestAccV2NutanixRecoveryPointsResource_Labels(t *testing.T) {
+	r := acctest.RandInt()
+	name := fmt.Sprintf("terraform-test-recovery-point-%d", r)
+	vmName := fmt.Sprintf("tf-test-rp-vm-%d", r)
+
+	expirationTimeFormatted := time.Now().Add(14 * 24 * time.Hour).UTC().Format(time.RFC3339)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccFoundationPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testVMConfigRecovery(vmName) + testRecoveryPointsResourceConfigWithLabels(name, expirationTimeFormatted, "pre=upgrade"),
+				ExpectError: regexp.MustCompile("must not contain any of"),
+			},
+			{
+				Config: testVMConfigRecovery(vmName) + testRecoveryPointsResourceConfigWithLabels(name, expirationTimeFormatted, "pre-upgrade"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceNameRecoveryPoints, "name", name),
+					resource.TestCheckResourceAttr(resourceNameRecoveryPoints, "labels.%", "2"),
+					resource.TestCheckResourceAttr(resourceNameRecoveryPoints, "labels.purpose", "pre-upgrade"),
+					resource.TestCheckResourceAttr(resourceNameRecoveryPoints, "labels.team", "tf"),
+					resource.TestCheckResourceAttr("data.nutanix_recovery_point_v2.test", "name", fmt.Sprintf("%s [purpose=pre-upgrade,team=tf]", name)),
+					resource.TestCheckResourceAttr("data.nutanix_recovery_point_v2.test", "labels.purpose", "pre-upgrade"),
+					resource.TestCheckResourceAttr("data.nutanix_recovery_points_v2.test", "recovery_points.#", "1"),
+					resource.TestCheckResourceAttrPair("data.nutanix_recovery_points_v2.test", "recovery_points.0.ext_id", resourceNameRecoveryPoints, "id"),
+					resource.TestCheckResourceAttr("data.nutanix_recovery_points_v2.other", "recovery_points.#", "0"),
+				),
+			},
+			// the labels are read back from the name
+			{
+				Config:   testVMConfigRecovery(vmName) + testRecoveryPointsResourceConfigWithLabels(name, expirationTimeFormatted, "pre-upgrade"),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccV2NutanixRecoveryPointsResource_DedupeByName(t *testing.T) {
+	r := acctest.RandInt()
+	name := fmt.Sprintf("terraform-test-recovery-point-%d", r)
+	vmName := fmt.Sprintf("tf-test-rp-vm-%d", r)
+
+	expirationTime := time.Now().Add(14 * 24 * time.Hour)
+	expirationTimeFormatted := expirationTime.UTC().Format(time.RFC3339)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccFoundationPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			// dedupe_by_name requires a name
+			{
+				Config:      testVMConfigRecovery(vmName) + testRecoveryPointsResourceConfigWithDedupe("", expirationTimeFormatted),
+				ExpectError: regexp.MustCompile("name must be set when dedupe_by_name is enabled"),
+			},
+			// no recovery point with this name exists yet, a new one is created
+			{
+				Config: testVMConfigRecovery(vmName) + testRecoveryPointsResourceConfigWithDedupe(name, expirationTimeFormatted),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceNameRecoveryPoints, "ext_id"),
+					resource.TestCheckResourceAttr(resourceNameRecoveryPoints, "name", name),
+					resource.TestCheckResourceAttr(resourceNameRecoveryPoints, "dedupe_by_name", "true"),
+					resource.TestCheckResourceAttr(resourceNameRecoveryPoints, "dedupe_window", "1h"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccV2NutanixRecoveryPointsResource_DedupeByNameAdoptsExisting(t *testing.T) {
+	r := acctest.RandInt()
+	name := fmt.Sprintf("terraform-test-recovery-point-%d", r)
+	vmName := fmt.Sprintf("tf-test-rp-vm-%d", r)
+
+	expirationTime := time.Now().Add(14 * 24 * time.Hour)
+	expirationTimeFormatted := expirationTime.UTC().Format(time.RFC3339)
+
+	var vmExtID, existingExtID string
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccFoundationPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testVMConfigRecovery(vmName),
+				Check:  testAccCaptureResourceID("nutanix_virtual_machine_v2.test-1", &vmExtID),
+			},
+			// a recovery point of the same VM was left behind by a failed apply
+			{
+				PreConfig: testAccCreateRecoveryPoint(t, name, expirationTime, &vmExtID, &existingExtID),
+				Config:    testVMConfigRecovery(vmName) + testRecoveryPointsResourceConfigWithDedupe(name, expirationTimeFormatted),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPtr(resourceNameRecoveryPoints, "id", &existingExtID),
+					resource.TestCheckResourceAttr(resourceNameRecoveryPoints, "name", name),
+				),
+			},
+		},
+	})
+}
+
+func TestAccV2NutanixRecoveryPointsResource_DedupeByNameOtherMembers(t *testing.T) {
+	r := acctest.RandInt()
+	name := fmt.Sprintf("terraform-test-recovery-point-%d", r)
+	vmName := fmt.Sprintf("tf-test-rp-vm-%d", r)
+
+	expirationTime := time.Now().Add(14 * 24 * time.Hour)
+	expirationTimeFormatted := expirationTime.UTC().Format(time.RFC3339)
+
+	var otherVMExtID, existingExtID string
+	t.Cleanup(func() { testAccDeleteRecoveryPoint(t, existingExtID) })
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccFoundationPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testVMConfigRecovery(vmName) + testVMConfig(vmName),
+				Check:  testAccCaptureResourceID("nutanix_virtual_machine_v2.test-2", &otherVMExtID),
+			},
+			// a recovery point with the same name but of another VM is not adopted
+			{
+				PreConfig: testAccCreateRecoveryPoint(t, name, expirationTime, &otherVMExtID, &existingExtID),
+				Config:    testVMConfigRecovery(vmName) + testVMConfig(vmName) + testRecoveryPointsResourceConfigWithDedupe(name, expirationTimeFormatted),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceNameRecoveryPoints, "name", name),
+					testAccCheckResourceIDNot(resourceNameRecoveryPoints, &existingExtID),
+				),
+			},
+		},
+	})
+}
+
+func TestAccV2NutanixRecoveryPointsResource_ConsistencyGroup(t *testing.T) {
+	r := acctest.RandInt()
+	name := fmt.Sprintf("terraform-test-recovery-point-%d", r)
+	vmName := fmt.Sprintf("tf-test-rp-vm-%d", r)
+	consistencyGroupExtID, _ := uuid.GenerateUUID()
+
+	expirationTime := time.Now().Add(14 * 24 * time.Hour)
+	expirationTimeFormatted := expirationTime.UTC().Format(time.RFC3339)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccFoundationPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testVMConfigRecovery(vmName) + testVMConfig(vmName) +
+					testRecoveryPointsResourceConfigWithConsistencyGroup(name, expirationTimeFormatted, consistencyGroupExtID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceNameRecoveryPoints, "ext_id"),
+					resource.TestCheckResourceAttr(resourceNameRecoveryPoints, "consistency_group_ext_id", consistencyGroupExtID),
+					resource.TestCheckResourceAttr(resourceNameRecoveryPoints, "vm_recovery_points.0.consistency_group_ext_id", consistencyGroupExtID),
+					resource.TestCheckResourceAttr(resourceNameRecoveryPoints, "vm_recovery_points.1.consistency_group_ext_id", consistencyGroupExtID),
+					resource.TestCheckResourceAttr(resourceNameRecoveryPoints, "volume_group_recovery_points.0.consistency_group_ext_id", consistencyGroupExtID),
+				),
+			},
+		},
+	})
+}
+
+func TestAccV2NutanixRecoveryPointsResource_WithoutWaitForTask(t *testing.T) {
+	r := acctest.RandInt()
+	name := fmt.Sprintf("terraform-test-recovery-point-%d", r)
+	vmName := fmt.Sprintf("tf-test-rp-vm-%d", r)
+
+	expirationTime := time.Now().Add(14 * 24 * time.Hour)
+	expirationTimeFormatted := expirationTime.UTC().Format(time.RFC3339)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccFoundationPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testVMConfigRecovery(vmName) + testRecoveryPointsResourceConfigWithoutWaitForTask(name, expirationTimeFormatted),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceNameRecoveryPoints, "wait_for_task", "false"),
+					testAccCheckRecoveryPointCreateTaskDone(resourceNameRecoveryPoints),
+				),
+			},
+			// the refresh of the next apply reconciles the recovery point
+			{
+				Config: testVMConfigRecovery(vmName) + testRecoveryPointsResourceConfigWithoutWaitForTask(name, expirationTimeFormatted),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceNameRecoveryPoints, "task_ext_id", ""),
+					resource.TestCheckResourceAttr(resourceNameRecoveryPoints, "name", name),
+					resource.TestCheckResourceAttrPair(resourceNameRecoveryPoints, "id", resourceNameRecoveryPoints, "ext_id"),
+				),
+			},
+		},
+	})
+}
+
+func testRecoveryPointsResourceConfigWithDedupe(name, expirationTime string) string {
+	return fmt.Sprintf(`
+
+	resource "nutanix_recovery_points_v2" "test" {
+		name                = "%[1]s"
+		expiration_time     = "%[2]s"
+		status              = "COMPLETE"
+		recovery_point_type = "CRASH_CONSISTENT"
+		dedupe_by_name      = true
+		dedupe_window       = "1h"
+		vm_recovery_points {
+			vm_ext_id = nutanix_virtual_machine_v2.test-1.id
+		}
+	}`, name, expirationTime)
+}
+
+func testRecoveryPointsResourceConfigWithExpirationDuration(name, expirationDuration, expirationTime string) string {
+	expirationTimeConfig := ""
+	if expirationTime != "" {
+		expirationTimeConfig = fmt.Sprintf(`expiration_time = "%s"`, expirationTime)
+	}
+	return fmt.Sprintf(`
+
+	resource "nutanix_recovery_points_v2" "test" {
+		name                = "%[1]s"
+		expiration_duration = "%[2]s"
+		%[3]s
+		status              = "COMPLETE"
+		recovery_point_type = "CRASH_CONSISTENT"
+		vm_recovery_points {
+			vm_ext_id = nutanix_virtual_machine_v2.test-1.id
+		}
+	}`, name, expirationDuration, expirationTimeConfig)
+}
+
+func testRecoveryPointsResourceConfigWithoutWaitForTask(name, expirationTime string) string {
+	return fmt.Sprintf(`
+
+	resource "nutanix_recovery_points_v2" "test" {
+		name                = "%[1]s"
+		expiration_time     = "%[2]s"
+		status              = "COMPLETE"
+		recovery_point_type = "CRASH_CONSISTENT"
+		wait_for_task       = false
+		vm_recovery_points {
+			vm_ext_id = nutanix_virtual_machine_v2.test-1.id
+		}
+	}`, name, expirationTime)
+}
+
+// testAccCheckRecoveryPointCreateTaskDone waits for the create task of a
+// recovery point submitted with wait_for_task = false, if it was still running.
+func testAccCheckRecoveryPointCreateTaskDone(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource %s not found", resourceName)
+		}
+		taskUUID := rs.Primary.Attributes["task_ext_id"]
+		if taskUUID == "" {
+			return nil
+		}
+		conn := acc.TestAccProvider.Meta().(*conns.Client)
+
+		const attempts = 24
+		for i := 0; i < attempts; i++ {
+			task, err := conn.PrismAPI.GetTask(context.Background(), taskUUID)
+			if err != nil {
+				return err
+			}
+			if status := utils.TaskStatusName(task.Status); status == "SUCCEEDED" || utils.IsTaskFailed(status) {
+				return nil
+			}
+			time.Sleep(5 * time.Second)
+		}
+		return fmt.Errorf("create task %s of recovery point is still running", taskUUID)
+	}
+}
+
+// testAccCheckRecoveryPointExpiresIn checks that the recovery point expires
+// about duration from now, expiration_duration being resolved at apply.
+func testAccCheckRecoveryPointExpiresIn(resourceName string, duration time.Duration) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource %s not found", resourceName)
+		}
+		expirationTime, err := time.Parse(time.RFC3339, rs.Primary.Attributes["expiration_time"])
+		if err != nil {
+			return fmt.Errorf("error while parsing expiration_time : %v", err)
+		}
+		if remaining := time.Until(expirationTime); remaining > duration || remaining < duration-time.Hour {
+			return fmt.Errorf("recovery point expires in %s, expected about %s", remaining, duration)
+		}
+		return nil
+	}
+}
+
 func testRecoveryPointsResourceConfigWithVMRecoveryPoints(name, expirationTime string) string {
 	return fmt.Sprintf(`
 
@@ -429,3 +766,145 @@ func testAccVolumeGroup2ResourceConfig(name, desc string) string {
 	  }	  
 	`, name, desc)
 }
+
+func testRecoveryPointsResourceConfigWithConsistencyGroup(name, expirationTime, consistencyGroupExtID string) string {
+	vg1 := testAccVolumeGroup1ResourceConfig("vg-1-"+name, "test volume group description")
+	return vg1 + fmt.Sprintf(`
+
+	resource "nutanix_recovery_points_v2" "test" {
+		name                     = "%[1]s"
+		expiration_time          = "%[2]s"
+		status                   = "COMPLETE"
+		recovery_point_type      = "CRASH_CONSISTENT"
+		consistency_group_ext_id = "%[3]s"
+		vm_recovery_points {
+			vm_ext_id = nutanix_virtual_machine_v2.test-1.id
+		}
+		vm_recovery_points {
+			vm_ext_id = nutanix_virtual_machine_v2.test-2.id
+		}
+		volume_group_recovery_points {
+			volume_group_ext_id = nutanix_volume_group_v2.test-1.id
+		}
+	}`, name, expirationTime, consistencyGroupExtID)
+}
+
+func testRecoveryPointsResourceConfigWithLabels(name, expirationTime, purpose string) string {
+	return fmt.Sprintf(`
+
+	resource "nutanix_recovery_points_v2" "test" {
+		name                = "%[1]s"
+		expiration_time     = "%[2]s"
+		status              = "COMPLETE"
+		recovery_point_type = "CRASH_CONSISTENT"
+		labels = {
+			purpose = "%[3]s"
+			team    = "tf"
+		}
+		vm_recovery_points {
+			vm_ext_id = nutanix_virtual_machine_v2.test-1.id
+		}
+	}
+
+	data "nutanix_recovery_point_v2" "test" {
+		ext_id = nutanix_recovery_points_v2.test.id
+	}
+
+	data "nutanix_recovery_points_v2" "test" {
+		filter = "startswith(name, '%[1]s')"
+		labels = {
+			purpose = "%[3]s"
+		}
+		depends_on = [nutanix_recovery_points_v2.test]
+	}
+
+	data "nutanix_recovery_points_v2" "other" {
+		filter = "startswith(name, '%[1]s')"
+		labels = {
+			purpose = "nightly"
+		}
+		depends_on = [nutanix_recovery_points_v2.test]
+	}`, name, expirationTime, purpose)
+}
+
+// testAccCaptureResourceID stores the id of a resource in id, for the steps
+// that follow.
+func testAccCaptureResourceID(resourceName string, id *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource %s not found", resourceName)
+		}
+		*id = rs.Primary.ID
+		return nil
+	}
+}
+
+// testAccCheckResourceIDNot checks that a resource is not the entity id.
+func testAccCheckResourceIDNot(resourceName string, id *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource %s not found", resourceName)
+		}
+		if rs.Primary.ID == *id {
+			return fmt.Errorf("resource %s adopted recovery point %s", resourceName, *id)
+		}
+		return nil
+	}
+}
+
+// testAccCreateRecoveryPoint creates a crash consistent recovery point of the
+// VM vmExtID outside of Terraform, and stores its ext id in extID.
+func testAccCreateRecoveryPoint(t *testing.T, name string, expirationTime time.Time, vmExtID, extID *string) func() {
+	return func() {
+		conn := acc.TestAccProvider.Meta().(*conns.Client)
+
+		recoveryPointType := dataprotectionCommon.RECOVERYPOINTTYPE_CRASH_CONSISTENT
+		body := dataprotectionConfig.RecoveryPoint{
+			Name:              utils.StringPtr(name),
+			ExpirationTime:    &expirationTime,
+			RecoveryPointType: &recoveryPointType,
+			VmRecoveryPoints:  []dataprotectionConfig.VmRecoveryPoint{{VmExtId: utils.StringPtr(*vmExtID)}},
+		}
+		resp, err := conn.DataProtectionAPI.RecoveryPoint.CreateRecoveryPoint(&body)
+		if err != nil {
+			t.Fatalf("error while creating recovery point %s : %v", name, err)
+		}
+		taskRef := resp.Data.GetValue().(dataprotectionPrism.TaskReference)
+
+		const attempts = 24
+		for i := 0; i < attempts; i++ {
+			task, err := conn.PrismAPI.GetTask(context.Background(), utils.StringValue(taskRef.ExtId))
+			if err != nil {
+				t.Fatalf("error while polling the create task of recovery point %s : %v", name, err)
+			}
+			status := utils.TaskStatusName(task.Status)
+			if utils.IsTaskFailed(status) {
+				t.Fatalf("create task of recovery point %s failed", name)
+			}
+			if status == "SUCCEEDED" {
+				// the create task reports the recovery point in its completion details
+				if len(task.CompletionDetails) == 0 {
+					t.Fatalf("create task of recovery point %s does not report it", name)
+				}
+				*extID, _ = task.CompletionDetails[0].Value.GetValue().(string)
+				return
+			}
+			time.Sleep(5 * time.Second)
+		}
+		t.Fatalf("create task of recovery point %s is still running", name)
+	}
+}
+
+// testAccDeleteRecoveryPoint deletes a recovery point created outside of
+// Terraform, the delete task is not waited for.
+func testAccDeleteRecoveryPoint(t *testing.T, extID string) {
+	if extID == "" {
+		return
+	}
+	conn := acc.TestAccProvider.Meta().(*conns.Client)
+	if _, err := conn.DataProtectionAPI.RecoveryPoint.DeleteRecoveryPointById(utils.StringPtr(extID)); err != nil && !utils.IsV4NotFound(err) {
+		t.Errorf("error while deleting recovery point %s : %v", extID, err)
+	}
+}
@@ -0,0 +1,105 @@
+package iam
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	conns "github.com/terraform-providers/terraform-provider-nutanix/nutanix"
+	v3 "github.com/terraform-providers/terraform-provider-nutanix/nutanix/sdks/v3/prism"
+	"github.com/terraform-providers/terraform-provider-nutanix/utils"
+)
+
+const userPrincipalNameAttribute = "userPrincipalName"
+
+// DataSourceNutanixIamDirectoryServiceUserPrincipal searches a directory service
+// for users, so that a user principal name can be checked before a nutanix_user
+// is created with it.
+func DataSourceNutanixIamDirectoryServiceUserPrincipal() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceNutanixIamDirectoryServiceUserPrincipalRead,
+		Schema: map[string]*schema.Schema{
+			"directory_service_uuid": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"search_string": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"is_wildcard_search": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"domain_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"user_principal_names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func dataSourceNutanixIamDirectoryServiceUserPrincipalRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Get client connection
+	conn := meta.(*conns.Client).API
+
+	directoryServiceUUID := d.Get("directory_service_uuid").(string)
+	searchString := d.Get("search_string").(string)
+
+	log.Printf("[DEBUG] Searching directory service %s for user principal names matching %s", directoryServiceUUID, searchString)
+
+	input := &v3.DirectoryServiceSearchInput{
+		Query:                 utils.StringPtr(searchString),
+		SearchedAttributeList: []*string{utils.StringPtr(userPrincipalNameAttribute)},
+		ReturnedAttributeList: []*string{utils.StringPtr(userPrincipalNameAttribute)},
+		IsWildcardSearch:      utils.BoolPtr(d.Get("is_wildcard_search").(bool)),
+	}
+
+	resp, err := conn.V3.SearchDirectoryService(directoryServiceUUID, input)
+	if err != nil {
+		return diag.Errorf("error searching directory service %s: %+v", directoryServiceUUID, err)
+	}
+
+	if err := d.Set("domain_name", utils.StringValue(resp.DomainName)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("user_principal_names", flattenDirectoryServiceUserPrincipalNames(resp.SearchResultList)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", directoryServiceUUID, searchString))
+	return nil
+}
+
+// flattenDirectoryServiceUserPrincipalNames returns the user principal names of
+// the search results, groups and results without one are skipped.
+func flattenDirectoryServiceUserPrincipalNames(results []*v3.DirectoryServiceSearchResult) []string {
+	names := make([]string, 0, len(results))
+	for _, result := range results {
+		if result == nil {
+			continue
+		}
+		for _, attribute := range result.AttributeList {
+			if attribute == nil || !strings.EqualFold(utils.StringValue(attribute.Name), userPrincipalNameAttribute) {
+				continue
+			}
+			for _, value := range attribute.ValueList {
+				if value != nil && *value != "" {
+					names = append(names, *value)
+				}
+			}
+		}
+	}
+	return names
+}
@@ -0,0 +1,58 @@
+package iam_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	acc "github.com/terraform-providers/terraform-provider-nutanix/nutanix/acctest"
+)
+
+const dataSourceDirectoryServiceUserPrincipal = "data.nutanix_iam_directory_service_user_principal.test"
+
+func TestAccNutanixIamDirectoryServiceUserPrincipalDataSource_basic(t *testing.T) {
+	principalName := testVars.Users[0].PrincipalName
+	directoryServiceUUID := testVars.Users[0].DirectoryServiceUUID
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIamDirectoryServiceUserPrincipalDataSourceConfig(directoryServiceUUID, principalName, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceDirectoryServiceUserPrincipal, "user_principal_names.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceDirectoryServiceUserPrincipal, "user_principal_names.0", principalName),
+					resource.TestCheckResourceAttrSet(dataSourceDirectoryServiceUserPrincipal, "domain_name"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNutanixIamDirectoryServiceUserPrincipalDataSource_noMatch(t *testing.T) {
+	directoryServiceUUID := testVars.Users[0].DirectoryServiceUUID
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIamDirectoryServiceUserPrincipalDataSourceConfig(directoryServiceUUID, "tf-test-no-such-user", false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceDirectoryServiceUserPrincipal, "user_principal_names.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccIamDirectoryServiceUserPrincipalDataSourceConfig(dsuuid, search string, wildcard bool) string {
+	return fmt.Sprintf(`
+data "nutanix_iam_directory_service_user_principal" "test" {
+	directory_service_uuid = "%s"
+	search_string          = "%s"
+	is_wildcard_search     = %t
+}
+`, dsuuid, search, wildcard)
+}
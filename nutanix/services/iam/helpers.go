@@ -18,7 +18,7 @@ const (
 	DEFAULTWAITTIMEOUT = 60
 )
 
-var (
+const (
 	subnetDelay      = 10 * time.Second
 	subnetMinTimeout = 3 * time.Second
 )
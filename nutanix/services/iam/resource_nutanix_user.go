@@ -21,7 +21,7 @@ const (
 	UserKind = "user"
 )
 
-var (
+const (
 	userDelay      = 10 * time.Second
 	userMinTimeout = 3 * time.Second
 )
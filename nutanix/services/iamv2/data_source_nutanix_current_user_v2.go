@@ -0,0 +1,142 @@
+package iamv2
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	iamConfig "github.com/nutanix/ntnx-api-golang-clients/iam-go-client/v4/models/iam/v4/authn"
+	import1 "github.com/nutanix/ntnx-api-golang-clients/iam-go-client/v4/models/iam/v4/authz"
+	conns "github.com/terraform-providers/terraform-provider-nutanix/nutanix"
+	"github.com/terraform-providers/terraform-provider-nutanix/utils"
+)
+
+// DatasourceNutanixCurrentUserV2 returns the user the provider is
+// authenticated as, with the roles granted to it by the authorization policies
+// naming it.
+func DatasourceNutanixCurrentUserV2() *schema.Resource {
+	return &schema.Resource{
+		Description: "Returns the user the provider is authenticated as and its roles.",
+		ReadContext: DatasourceNutanixCurrentUserV2Read,
+		Schema: map[string]*schema.Schema{
+			"ext_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"username": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"display_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"user_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"roles": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ext_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"display_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func DatasourceNutanixCurrentUserV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.Client).IamAPI
+
+	// the v4 APIs have no session endpoint, the user is looked up by the
+	// username the provider authenticates with
+	username := conn.UsersAPIInstance.ApiClient.Username
+	if username == "" {
+		return diag.Errorf("the provider is not configured with a username")
+	}
+
+	filter := fmt.Sprintf("username eq %s", utils.ODataString(username))
+	resp, err := conn.UsersAPIInstance.ListUsers(nil, nil, &filter, nil, nil)
+	if err != nil {
+		return diag.Errorf("error while fetching user %s : %v", username, err)
+	}
+	var users []iamConfig.User
+	if resp.Data != nil {
+		users, _ = resp.Data.GetValue().([]iamConfig.User)
+	}
+	if len(users) == 0 {
+		return diag.Errorf("user %s the provider is authenticated as was not found", username)
+	}
+	user := users[0]
+
+	policies, err := listUserAuthorizationPolicies(conn, user)
+	if err != nil {
+		return diag.Errorf("error while fetching auth policies : %v", err)
+	}
+	roles := make(map[string]string)
+	for _, policy := range policies {
+		roleExtID := utils.StringValue(policy.Role)
+		if _, ok := roles[roleExtID]; ok || roleExtID == "" {
+			continue
+		}
+		roleResp, err := conn.RolesAPIInstance.GetRoleById(utils.StringPtr(roleExtID))
+		if err != nil {
+			return diag.Errorf("error while fetching role %s : %v", roleExtID, err)
+		}
+		roles[roleExtID] = utils.StringValue(roleResp.Data.GetValue().(import1.Role).DisplayName)
+	}
+
+	if err := d.Set("ext_id", utils.StringValue(user.ExtId)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("username", utils.StringValue(user.Username)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("display_name", utils.StringValue(user.DisplayName)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("user_type", flattenUserType(user.UserType)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("roles", flattenCurrentUserRoles(roles)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(utils.StringValue(user.ExtId))
+	return nil
+}
+
+// flattenCurrentUserRoles returns the roles sorted by display name.
+func flattenCurrentUserRoles(roles map[string]string) []interface{} {
+	extIDs := make([]string, 0, len(roles))
+	for extID := range roles {
+		extIDs = append(extIDs, extID)
+	}
+	sort.Slice(extIDs, func(i, j int) bool {
+		if roles[extIDs[i]] != roles[extIDs[j]] {
+			return roles[extIDs[i]] < roles[extIDs[j]]
+		}
+		return extIDs[i] < extIDs[j]
+	})
+
+	result := make([]interface{}, len(extIDs))
+	for k, extID := range extIDs {
+		result[k] = map[string]interface{}{
+			"ext_id":       extID,
+			"display_name": roles[extID],
+		}
+	}
+	return result
+}
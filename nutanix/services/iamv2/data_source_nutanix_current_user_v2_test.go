@@ -0,0 +1,35 @@
+package iamv2_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	acc "github.com/terraform-providers/terraform-provider-nutanix/nutanix/acctest"
+)
+
+const datasourceNameCurrentUser = "data.nutanix_current_user_v2.test"
+
+func TestAccV2NutanixCurrentUserDatasource_Basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testCurrentUserDatasourceConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(datasourceNameCurrentUser, "username", os.Getenv("NUTANIX_USERNAME")),
+					resource.TestCheckResourceAttrSet(datasourceNameCurrentUser, "ext_id"),
+					resource.TestCheckResourceAttrSet(datasourceNameCurrentUser, "user_type"),
+					resource.TestCheckResourceAttrSet(datasourceNameCurrentUser, "roles.#"),
+				),
+			},
+		},
+	})
+}
+
+func testCurrentUserDatasourceConfig() string {
+	return `
+	data "nutanix_current_user_v2" "test" {}
+`
+}
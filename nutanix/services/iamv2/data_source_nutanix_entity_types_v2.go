@@ -0,0 +1,201 @@
+package iamv2
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	import1 "github.com/nutanix/ntnx-api-golang-clients/iam-go-client/v4/models/iam/v4/authz"
+	conns "github.com/terraform-providers/terraform-provider-nutanix/nutanix"
+	"github.com/terraform-providers/terraform-provider-nutanix/utils"
+)
+
+// DatasourceNutanixEntityTypesV2 lists the entity types authorization policies
+// can scope, with the attributes their entity filters can match on and the
+// operations which apply to them.
+func DatasourceNutanixEntityTypesV2() *schema.Resource {
+	return &schema.Resource{
+		Description: "Lists the entity types an authorization policy can scope, with their filterable attributes and the operations which apply to them.",
+		ReadContext: DatasourceNutanixEntityTypesV2Read,
+		Schema: map[string]*schema.Schema{
+			"filter": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"entity_types": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ext_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"display_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"client_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"is_logical_and_supported_for_attributes": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"attributes": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"ext_id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"display_name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"attribute_values": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+										},
+									},
+									"supported_operators": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+										},
+									},
+								},
+							},
+						},
+						"operations": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"ext_id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"display_name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func DatasourceNutanixEntityTypesV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.Client).IamAPI
+
+	var filter *string
+	if v, ok := d.GetOk("filter"); ok {
+		filter = utils.StringPtr(v.(string))
+	}
+
+	entities, err := utils.ListAllPages(nil, nil, func(page, limit *int) ([]interface{}, *int, error) {
+		resp, err := conn.EntitiesAPIInstance.ListEntities(page, limit, filter, nil, nil)
+		if err != nil {
+			return nil, nil, err
+		}
+		var total *int
+		if resp.Metadata != nil {
+			total = resp.Metadata.TotalAvailableResults
+		}
+		if resp.Data == nil {
+			return nil, total, nil
+		}
+		entityTypes, _ := resp.Data.GetValue().([]import1.Entity)
+		items := make([]interface{}, len(entityTypes))
+		for k, v := range entityTypes {
+			items[k] = v
+		}
+		return items, total, nil
+	})
+	if err != nil {
+		return diag.Errorf("error while fetching entity types : %v", err)
+	}
+
+	// an operation references the entity type it applies to by name
+	operations, err := listOperations(conn, nil)
+	if err != nil {
+		return diag.Errorf("error while fetching operations : %v", err)
+	}
+	operationsByEntityType := groupOperationsByEntityType(operations)
+
+	entityTypes := make([]interface{}, len(entities))
+	for k, v := range entities {
+		entity := v.(import1.Entity)
+		entityTypes[k] = map[string]interface{}{
+			"ext_id":       utils.StringValue(entity.ExtId),
+			"name":         utils.StringValue(entity.Name),
+			"display_name": utils.StringValue(entity.DisplayName),
+			"description":  utils.StringValue(entity.Description),
+			"client_name":  utils.StringValue(entity.ClientName),
+			"is_logical_and_supported_for_attributes": utils.BoolValue(entity.IsLogicalAndSupportedForAttributes),
+			"attributes": flattenEntityTypeAttributes(entity.AttributeList),
+			"operations": flattenEntityTypeOperations(operationsByEntityType[utils.StringValue(entity.Name)]),
+		}
+	}
+
+	if err := d.Set("entity_types", entityTypes); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(resource.UniqueId())
+	return nil
+}
+
+func flattenEntityTypeOperations(operations []import1.Operation) []interface{} {
+	if len(operations) == 0 {
+		return nil
+	}
+	result := make([]interface{}, len(operations))
+	for k, v := range operations {
+		result[k] = map[string]interface{}{
+			"ext_id":       utils.StringValue(v.ExtId),
+			"display_name": utils.StringValue(v.DisplayName),
+		}
+	}
+	return result
+}
+
+func flattenEntityTypeAttributes(attributes []import1.AttributeEntity) []interface{} {
+	if len(attributes) == 0 {
+		return nil
+	}
+	result := make([]interface{}, len(attributes))
+	for k, v := range attributes {
+		operators := make([]string, len(v.SupportedOperators))
+		for i, operator := range v.SupportedOperators {
+			operators[i] = operator.GetName()
+		}
+		result[k] = map[string]interface{}{
+			"ext_id":              utils.StringValue(v.ExtId),
+			"display_name":        utils.StringValue(v.DisplayName),
+			"attribute_values":    v.AttributeValues,
+			"supported_operators": operators,
+		}
+	}
+	return result
+}
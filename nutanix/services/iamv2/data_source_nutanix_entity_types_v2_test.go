@@ -0,0 +1,57 @@
+package iamv2_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	acc "github.com/terraform-providers/terraform-provider-nutanix/nutanix/acctest"
+)
+
+const datasourceNameEntityTypes = "data.nutanix_entity_types_v2.test"
+
+func TestAccV2NutanixEntityTypesDatasource_Basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testEntityTypesV2DatasourceConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(datasourceNameEntityTypes, "entity_types.#"),
+					resource.TestCheckResourceAttrSet(datasourceNameEntityTypes, "entity_types.0.name"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccV2NutanixEntityTypesDatasource_WithFilter(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testEntityTypesV2DatasourceWithFilterConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(datasourceNameEntityTypes, "entity_types.#", "1"),
+					resource.TestCheckResourceAttr(datasourceNameEntityTypes, "entity_types.0.name", "vm"),
+					resource.TestCheckResourceAttrSet(datasourceNameEntityTypes, "entity_types.0.operations.#"),
+				),
+			},
+		},
+	})
+}
+
+func testEntityTypesV2DatasourceConfig() string {
+	return `
+		data "nutanix_entity_types_v2" "test" {}
+	`
+}
+
+func testEntityTypesV2DatasourceWithFilterConfig() string {
+	return `
+		data "nutanix_entity_types_v2" "test" {
+		  filter = "name eq 'vm'"
+		}
+	`
+}
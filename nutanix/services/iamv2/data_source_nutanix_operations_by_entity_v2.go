@@ -0,0 +1,188 @@
+package iamv2
+
+import (
+	"context"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	import1 "github.com/nutanix/ntnx-api-golang-clients/iam-go-client/v4/models/iam/v4/authz"
+	conns "github.com/terraform-providers/terraform-provider-nutanix/nutanix"
+	"github.com/terraform-providers/terraform-provider-nutanix/nutanix/sdks/v4/iam"
+	"github.com/terraform-providers/terraform-provider-nutanix/utils"
+)
+
+// DatasourceNutanixOperationsByEntityV2 lists the operations grouped by the
+// entity type they apply to. The groups are sorted by entity type and the
+// operations of a group by display name, so the output only changes when the
+// operations do.
+func DatasourceNutanixOperationsByEntityV2() *schema.Resource {
+	return &schema.Resource{
+		Description: "Lists the operations grouped by the entity type they apply to.",
+		ReadContext: DatasourceNutanixOperationsByEntityV2Read,
+		Schema: map[string]*schema.Schema{
+			"filter": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: utils.ValidateODataFilter,
+			},
+			"entity_types": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"entity_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"operation_ext_ids": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"operations": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"ext_id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"display_name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"description": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"operation_type": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"client_name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"related_operation_list": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func DatasourceNutanixOperationsByEntityV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.Client).IamAPI
+
+	filter, err := utils.NormalizeODataFilter(d.Get("filter").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	operations, err := listOperations(conn, filter)
+	if err != nil {
+		return diag.Errorf("error while fetching operations : %v", err)
+	}
+
+	if err := d.Set("entity_types", flattenOperationsByEntityType(operations)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(resource.UniqueId())
+	return nil
+}
+
+// listOperations returns every operation matching filter.
+func listOperations(conn *iam.Client, filter *string) ([]import1.Operation, error) {
+	items, err := utils.ListAllPages(nil, nil, func(page, limit *int) ([]interface{}, *int, error) {
+		resp, err := conn.OperationsAPIInstance.ListOperations(page, limit, filter, nil, nil)
+		if err != nil {
+			return nil, nil, err
+		}
+		var total *int
+		if resp.Metadata != nil {
+			total = resp.Metadata.TotalAvailableResults
+		}
+		if resp.Data == nil {
+			return nil, total, nil
+		}
+		ops, _ := resp.Data.GetValue().([]import1.Operation)
+		items := make([]interface{}, len(ops))
+		for k, v := range ops {
+			items[k] = v
+		}
+		return items, total, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	operations := make([]import1.Operation, len(items))
+	for k, v := range items {
+		operations[k] = v.(import1.Operation)
+	}
+	return operations, nil
+}
+
+// groupOperationsByEntityType returns the operations by the name of the entity
+// type they apply to, each group sorted by display name.
+func groupOperationsByEntityType(operations []import1.Operation) map[string][]import1.Operation {
+	groups := make(map[string][]import1.Operation)
+	for _, operation := range operations {
+		entityType := utils.StringValue(operation.EntityType)
+		groups[entityType] = append(groups[entityType], operation)
+	}
+	for _, ops := range groups {
+		sort.SliceStable(ops, func(i, j int) bool {
+			return utils.StringValue(ops[i].DisplayName) < utils.StringValue(ops[j].DisplayName)
+		})
+	}
+	return groups
+}
+
+func flattenOperationsByEntityType(operations []import1.Operation) []interface{} {
+	groups := groupOperationsByEntityType(operations)
+	entityTypes := make([]string, 0, len(groups))
+	for entityType := range groups {
+		entityTypes = append(entityTypes, entityType)
+	}
+	sort.Strings(entityTypes)
+
+	result := make([]interface{}, len(entityTypes))
+	for k, entityType := range entityTypes {
+		ops := groups[entityType]
+		extIDs := make([]string, len(ops))
+		flattened := make([]interface{}, len(ops))
+		for i, operation := range ops {
+			extIDs[i] = utils.StringValue(operation.ExtId)
+			flattened[i] = map[string]interface{}{
+				"ext_id":                 utils.StringValue(operation.ExtId),
+				"display_name":           utils.StringValue(operation.DisplayName),
+				"description":            utils.StringValue(operation.Description),
+				"operation_type":         flattenOperationType(operation.OperationType),
+				"client_name":            utils.StringValue(operation.ClientName),
+				"related_operation_list": operation.RelatedOperationList,
+			}
+		}
+		result[k] = map[string]interface{}{
+			"entity_type":       entityType,
+			"operation_ext_ids": extIDs,
+			"operations":        flattened,
+		}
+	}
+	return result
+}
@@ -0,0 +1,60 @@
+package iamv2_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	acc "github.com/terraform-providers/terraform-provider-nutanix/nutanix/acctest"
+)
+
+const datasourceNameOperationsByEntity = "data.nutanix_operations_by_entity_v2.test"
+
+func TestAccV2NutanixOperationsByEntityDatasource_Basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testOperationsByEntityV2DatasourceConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(datasourceNameOperationsByEntity, "entity_types.#"),
+					resource.TestCheckResourceAttrSet(datasourceNameOperationsByEntity, "entity_types.0.entity_type"),
+					resource.TestCheckResourceAttrSet(datasourceNameOperationsByEntity, "entity_types.0.operation_ext_ids.#"),
+					resource.TestCheckResourceAttrSet(datasourceNameOperationsByEntity, "entity_types.0.operations.0.ext_id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccV2NutanixOperationsByEntityDatasource_WithFilter(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testOperationsByEntityV2DatasourceWithFilterConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(datasourceNameOperationsByEntity, "entity_types.#", "1"),
+					resource.TestCheckResourceAttr(datasourceNameOperationsByEntity, "entity_types.0.entity_type", "vm"),
+					resource.TestCheckResourceAttrSet(datasourceNameOperationsByEntity, "entity_types.0.operations.0.display_name"),
+					resource.TestCheckResourceAttrPair(datasourceNameOperationsByEntity, "entity_types.0.operation_ext_ids.0", datasourceNameOperationsByEntity, "entity_types.0.operations.0.ext_id"),
+				),
+			},
+		},
+	})
+}
+
+func testOperationsByEntityV2DatasourceConfig() string {
+	return `
+		data "nutanix_operations_by_entity_v2" "test" {}
+	`
+}
+
+func testOperationsByEntityV2DatasourceWithFilterConfig() string {
+	return `
+		data "nutanix_operations_by_entity_v2" "test" {
+		  filter = "entityType eq 'vm'"
+		}
+	`
+}
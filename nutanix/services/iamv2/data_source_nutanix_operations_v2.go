@@ -24,8 +24,9 @@ func DatasourceNutanixOperationsV2() *schema.Resource {
 				Optional: true,
 			},
 			"filter": {
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: utils.ValidateODataFilter,
 			},
 			"order_by": {
 				Type:     schema.TypeString,
@@ -123,10 +124,9 @@ func DatasourceNutanixOperationsV4Read(ctx context.Context, d *schema.ResourceDa
 	} else {
 		limit = nil
 	}
-	if filterf, ok := d.GetOk("filter"); ok {
-		filter = utils.StringPtr(filterf.(string))
-	} else {
-		filter = nil
+	filter, err := utils.NormalizeODataFilter(d.Get("filter").(string))
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	if order, ok := d.GetOk("order_by"); ok {
 		orderBy = utils.StringPtr(order.(string))
@@ -139,18 +139,35 @@ func DatasourceNutanixOperationsV4Read(ctx context.Context, d *schema.ResourceDa
 		selects = nil
 	}
 
-	resp, err := conn.OperationsAPIInstance.ListOperations(page, limit, filter, orderBy, selects)
+	entities, err := utils.ListAllPages(page, limit, func(page, limit *int) ([]interface{}, *int, error) {
+		resp, err := conn.OperationsAPIInstance.ListOperations(page, limit, filter, orderBy, selects)
+		if err != nil {
+			return nil, nil, err
+		}
+		var total *int
+		if resp.Metadata != nil {
+			total = resp.Metadata.TotalAvailableResults
+		}
+		if resp.Data == nil {
+			return nil, total, nil
+		}
+		operations := resp.Data.GetValue().([]import1.Operation)
+		items := make([]interface{}, len(operations))
+		for k, v := range operations {
+			items[k] = v
+		}
+		return items, total, nil
+	})
 	if err != nil {
 		return diag.Errorf("error while fetching operations : %v", err)
 	}
 
-	getResp := resp.Data
-
-	if getResp != nil {
-		operations := getResp.GetValue().([]import1.Operation)
-		if err := d.Set("operations", flattenPermissionEntities(operations)); err != nil {
-			return diag.FromErr(err)
-		}
+	operations := make([]import1.Operation, len(entities))
+	for k, v := range entities {
+		operations[k] = v.(import1.Operation)
+	}
+	if err := d.Set("operations", flattenPermissionEntities(operations)); err != nil {
+		return diag.FromErr(err)
 	}
 
 	d.SetId(resource.UniqueId())
@@ -0,0 +1,299 @@
+package iamv2
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	iamConfig "github.com/nutanix/ntnx-api-golang-clients/iam-go-client/v4/models/iam/v4/authn"
+	import1 "github.com/nutanix/ntnx-api-golang-clients/iam-go-client/v4/models/iam/v4/authz"
+	conns "github.com/terraform-providers/terraform-provider-nutanix/nutanix"
+	"github.com/terraform-providers/terraform-provider-nutanix/nutanix/sdks/v4/iam"
+	"github.com/terraform-providers/terraform-provider-nutanix/utils"
+)
+
+// DatasourceNutanixUserEffectivePermissionsV2 lists the operations granted to
+// a user by the roles of the authorization policies whose identities match it.
+func DatasourceNutanixUserEffectivePermissionsV2() *schema.Resource {
+	return &schema.Resource{
+		Description: "Lists the operations a user is granted through the authorization policies naming it, for access reviews.",
+		ReadContext: DatasourceNutanixUserEffectivePermissionsV2Read,
+		Schema: map[string]*schema.Schema{
+			"user_ext_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"authorization_policies": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ext_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"display_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"role": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"operations": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ext_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"display_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"entity_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func DatasourceNutanixUserEffectivePermissionsV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.Client).IamAPI
+
+	userExtID := d.Get("user_ext_id").(string)
+	userResp, err := conn.UsersAPIInstance.GetUserById(utils.StringPtr(userExtID))
+	if err != nil {
+		return diag.Errorf("error while fetching user : %v", err)
+	}
+	user := userResp.Data.GetValue().(iamConfig.User)
+
+	policies, err := listUserAuthorizationPolicies(conn, user)
+	if err != nil {
+		return diag.Errorf("error while fetching auth policies : %v", err)
+	}
+
+	matchedPolicies := make([]interface{}, 0)
+	roleOperations := make(map[string][]string)
+	operationExtIDs := make(map[string]bool)
+	for _, policy := range policies {
+		roleExtID := utils.StringValue(policy.Role)
+		matchedPolicies = append(matchedPolicies, map[string]interface{}{
+			"ext_id":       utils.StringValue(policy.ExtId),
+			"display_name": utils.StringValue(policy.DisplayName),
+			"role":         roleExtID,
+		})
+		if roleExtID == "" {
+			continue
+		}
+
+		operations, ok := roleOperations[roleExtID]
+		if !ok {
+			roleResp, err := conn.RolesAPIInstance.GetRoleById(utils.StringPtr(roleExtID))
+			if err != nil {
+				return diag.Errorf("error while fetching role %s : %v", roleExtID, err)
+			}
+			operations = roleResp.Data.GetValue().(import1.Role).Operations
+			roleOperations[roleExtID] = operations
+		}
+		for _, operation := range operations {
+			operationExtIDs[operation] = true
+		}
+	}
+
+	// a role only references its operations, their names come from the
+	// operations list which is read once instead of once per operation
+	operationsByExtID := make(map[string]import1.Operation)
+	if len(operationExtIDs) > 0 {
+		entities, err := utils.ListAllPages(nil, nil, func(page, limit *int) ([]interface{}, *int, error) {
+			resp, err := conn.OperationsAPIInstance.ListOperations(page, limit, nil, nil, nil)
+			if err != nil {
+				return nil, nil, err
+			}
+			var total *int
+			if resp.Metadata != nil {
+				total = resp.Metadata.TotalAvailableResults
+			}
+			if resp.Data == nil {
+				return nil, total, nil
+			}
+			operations := resp.Data.GetValue().([]import1.Operation)
+			items := make([]interface{}, len(operations))
+			for k, v := range operations {
+				items[k] = v
+			}
+			return items, total, nil
+		})
+		if err != nil {
+			return diag.Errorf("error while fetching operations : %v", err)
+		}
+		for _, v := range entities {
+			operation := v.(import1.Operation)
+			operationsByExtID[utils.StringValue(operation.ExtId)] = operation
+		}
+	}
+
+	if err := d.Set("authorization_policies", matchedPolicies); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("operations", flattenEffectiveOperations(operationExtIDs, operationsByExtID)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(userExtID)
+	return nil
+}
+
+// flattenEffectiveOperations returns the operations sorted by display name, an
+// operation missing from the operations list is returned with its ext_id only.
+func flattenEffectiveOperations(extIDs map[string]bool, operationsByExtID map[string]import1.Operation) []interface{} {
+	operations := make([]map[string]interface{}, 0, len(extIDs))
+	for extID := range extIDs {
+		operation := map[string]interface{}{
+			"ext_id":       extID,
+			"display_name": "",
+			"entity_type":  "",
+		}
+		if v, ok := operationsByExtID[extID]; ok {
+			operation["display_name"] = utils.StringValue(v.DisplayName)
+			operation["entity_type"] = utils.StringValue(v.EntityType)
+		}
+		operations = append(operations, operation)
+	}
+
+	sort.Slice(operations, func(i, j int) bool {
+		if operations[i]["display_name"] != operations[j]["display_name"] {
+			return operations[i]["display_name"].(string) < operations[j]["display_name"].(string)
+		}
+		return operations[i]["ext_id"].(string) < operations[j]["ext_id"].(string)
+	})
+
+	result := make([]interface{}, len(operations))
+	for k, v := range operations {
+		result[k] = v
+	}
+	return result
+}
+
+// listUserAuthorizationPolicies returns the authorization policies whose
+// identities select the user, see authPolicyIdentitiesMatchUser.
+func listUserAuthorizationPolicies(conn *iam.Client, user iamConfig.User) ([]import1.AuthorizationPolicyProjection, error) {
+	items, err := utils.ListAllPages(nil, nil, func(page, limit *int) ([]interface{}, *int, error) {
+		resp, err := conn.AuthAPIInstance.ListAuthorizationPolicies(page, limit, nil, nil, nil, nil)
+		if err != nil {
+			return nil, nil, err
+		}
+		var total *int
+		if resp.Metadata != nil {
+			total = resp.Metadata.TotalAvailableResults
+		}
+		if resp.Data == nil {
+			return nil, total, nil
+		}
+		entities := resp.Data.GetValue().([]import1.AuthorizationPolicyProjection)
+		items := make([]interface{}, len(entities))
+		for k, v := range entities {
+			items[k] = v
+		}
+		return items, total, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	policies := make([]import1.AuthorizationPolicyProjection, 0)
+	for _, v := range items {
+		policy := v.(import1.AuthorizationPolicyProjection)
+		if authPolicyIdentitiesMatchUser(policy.Identities, user) {
+			policies = append(policies, policy)
+		}
+	}
+	return policies, nil
+}
+
+// authPolicyIdentitiesMatchUser reports whether one of the identity filters of
+// an authorization policy selects the user. A filter selects it when, for the
+// user or * entity type, every attribute condition holds. Only the uuid and
+// username attributes are known for a user, group based filters are not
+// resolved.
+func authPolicyIdentitiesMatchUser(identities []import1.IdentityFilter, user iamConfig.User) bool {
+	attributes := map[string]string{
+		"uuid":     utils.StringValue(user.ExtId),
+		"username": utils.StringValue(user.Username),
+	}
+
+	for _, identity := range identities {
+		for entityType, conditions := range identity.Reserved_ {
+			if entityType != "user" && entityType != "*" {
+				continue
+			}
+			conditionMap, ok := conditions.(map[string]interface{})
+			if ok && len(conditionMap) > 0 && authPolicyConditionsMatch(conditionMap, attributes) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func authPolicyConditionsMatch(conditions map[string]interface{}, attributes map[string]string) bool {
+	for attribute, operators := range conditions {
+		operatorMap, ok := operators.(map[string]interface{})
+		if !ok || len(operatorMap) == 0 {
+			return false
+		}
+		for operator, value := range operatorMap {
+			if attribute == "*" {
+				if !authPolicyValueContains(value, "*") {
+					return false
+				}
+				continue
+			}
+			actual, known := attributes[attribute]
+			if !known {
+				return false
+			}
+			contains := authPolicyValueContains(value, actual) || authPolicyValueContains(value, "*")
+			switch strings.ToLower(operator) {
+			case "eq", "anyof":
+				if !contains {
+					return false
+				}
+			case "noteq", "noneof":
+				if contains {
+					return false
+				}
+			default:
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// authPolicyValueContains reports whether the value of a filter operator, a
+// single value or a list of values, contains s.
+func authPolicyValueContains(value interface{}, s string) bool {
+	switch v := value.(type) {
+	case string:
+		return v == s
+	case []interface{}:
+		for _, item := range v {
+			if str, ok := item.(string); ok && str == s {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,93 @@
+package iamv2_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	acc "github.com/terraform-providers/terraform-provider-nutanix/nutanix/acctest"
+)
+
+const datasourceNameUserEffectivePermissions = "data.nutanix_user_effective_permissions_v2.test"
+
+func TestAccV2NutanixUserEffectivePermissionsDatasource_Basic(t *testing.T) {
+	r := acctest.RandInt()
+	name := fmt.Sprintf("test-user-%d", r)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testUserEffectivePermissionsDatasourceConfig(filepath, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(datasourceNameUserEffectivePermissions, "authorization_policies.#", "1"),
+					resource.TestCheckResourceAttrPair(datasourceNameUserEffectivePermissions, "authorization_policies.0.ext_id", "nutanix_authorization_policy_v2.test", "id"),
+					resource.TestCheckResourceAttrPair(datasourceNameUserEffectivePermissions, "authorization_policies.0.role", "nutanix_roles_v2.test", "id"),
+					resource.TestCheckResourceAttr(datasourceNameUserEffectivePermissions, "operations.#", "2"),
+					resource.TestCheckResourceAttrSet(datasourceNameUserEffectivePermissions, "operations.0.display_name"),
+					resource.TestCheckResourceAttrSet(datasourceNameUserEffectivePermissions, "operations.1.display_name"),
+				),
+			},
+		},
+	})
+}
+
+func testUserEffectivePermissionsDatasourceConfig(filepath, name string) string {
+	return fmt.Sprintf(`
+	locals{
+		config = (jsondecode(file("%[1]s")))
+		users = local.config.iam.users
+	}
+
+	resource "nutanix_users_v2" "test" {
+		username = "%[2]s"
+		first_name = "first-name-%[2]s"
+		last_name = "last-name-%[2]s"
+		email_id = local.users.email_id
+		display_name = "display-name-%[2]s"
+		password = local.users.password
+		user_type = "LOCAL"
+		status = "ACTIVE"
+	}
+
+	data "nutanix_operations_v2" "test" {
+		filter = "startswith(displayName, 'Create_')"
+	}
+
+	resource "nutanix_roles_v2" "test" {
+		display_name = "role-%[2]s"
+		operations = [
+			data.nutanix_operations_v2.test.operations[0].ext_id,
+			data.nutanix_operations_v2.test.operations[1].ext_id,
+		]
+	}
+
+	resource "nutanix_authorization_policy_v2" "test" {
+		role         = nutanix_roles_v2.test.id
+		display_name = "acp-%[2]s"
+		authorization_policy_type = "USER_DEFINED"
+		identities {
+			expression {
+				entity_type = "user"
+				attribute   = "uuid"
+				operator    = "ANYOF"
+				values      = [nutanix_users_v2.test.id]
+			}
+		}
+		entities {
+			expression {
+				entity_type = "images"
+				attribute   = "*"
+				operator    = "EQ"
+				values      = ["*"]
+			}
+		}
+	}
+
+	data "nutanix_user_effective_permissions_v2" "test" {
+		user_ext_id = nutanix_users_v2.test.id
+		depends_on  = [nutanix_authorization_policy_v2.test]
+	}`, filepath, name)
+}
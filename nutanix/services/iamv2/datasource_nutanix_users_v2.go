@@ -24,6 +24,11 @@ func DatasourceNutanixUsersV2() *schema.Resource {
 				Optional: true,
 			},
 			"filter": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: utils.ValidateODataFilter,
+			},
+			"tenant_id": {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
@@ -216,10 +221,12 @@ func datasourceNutanixUsersV2Read(ctx context.Context, d *schema.ResourceData, m
 	} else {
 		limit = nil
 	}
-	if filterf, ok := d.GetOk("filter"); ok {
-		filter = utils.StringPtr(filterf.(string))
-	} else {
-		filter = nil
+	filter, err := utils.NormalizeODataFilter(d.Get("filter").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if tenantID, ok := d.GetOk("tenant_id"); ok {
+		filter = utils.AndFilter(filter, utils.TenantFilter(tenantID.(string)))
 	}
 	if order, ok := d.GetOk("order_by"); ok {
 		orderBy = utils.StringPtr(order.(string))
@@ -232,12 +239,33 @@ func datasourceNutanixUsersV2Read(ctx context.Context, d *schema.ResourceData, m
 		selects = nil
 	}
 
-	resp, err := conn.UsersAPIInstance.ListUsers(page, limit, filter, orderBy, selects)
+	entities, err := utils.ListAllPages(page, limit, func(page, limit *int) ([]interface{}, *int, error) {
+		resp, err := conn.UsersAPIInstance.ListUsers(page, limit, filter, orderBy, selects)
+		if err != nil {
+			return nil, nil, err
+		}
+		var total *int
+		if resp.Metadata != nil {
+			total = resp.Metadata.TotalAvailableResults
+		}
+		if resp.Data == nil {
+			return nil, total, nil
+		}
+		users := resp.Data.GetValue().([]iamConfig.User)
+		items := make([]interface{}, len(users))
+		for k, v := range users {
+			items[k] = v
+		}
+		return items, total, nil
+	})
 	if err != nil {
 		return diag.Errorf("error while fetching users : %v", err)
 	}
 
-	getResp := resp.Data.GetValue().([]iamConfig.User)
+	getResp := make([]iamConfig.User, len(entities))
+	for k, v := range entities {
+		getResp[k] = v.(iamConfig.User)
+	}
 
 	if err := d.Set("users", flattenUsersEntities(getResp)); err != nil {
 		return diag.FromErr(err)
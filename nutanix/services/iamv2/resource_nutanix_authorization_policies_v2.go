@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/nutanix/ntnx-api-golang-clients/iam-go-client/v4/api"
 	"github.com/nutanix/ntnx-api-golang-clients/iam-go-client/v4/models/common/v1/config"
 	import1 "github.com/nutanix/ntnx-api-golang-clients/iam-go-client/v4/models/iam/v4/authz"
 	conns "github.com/terraform-providers/terraform-provider-nutanix/nutanix"
@@ -70,6 +71,7 @@ func ResourceNutanixAuthPoliciesV2() *schema.Resource {
 								return json
 							},
 						},
+						"expression": authPolicyFilterExpressionSchema(),
 					},
 				},
 			},
@@ -92,6 +94,7 @@ func ResourceNutanixAuthPoliciesV2() *schema.Resource {
 								return json
 							},
 						},
+						"expression": authPolicyFilterExpressionSchema(),
 					},
 				},
 			},
@@ -100,6 +103,12 @@ func ResourceNutanixAuthPoliciesV2() *schema.Resource {
 				Type:     schema.TypeString,
 				Required: true,
 			},
+			"validate_role": {
+				Description: "Fetch the role before creating or updating the Authorization Policy and fail early if it does not exist.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+			},
 			"created_time": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -158,6 +167,12 @@ func ResourceNutanixAuthPoliciesV2Create(ctx context.Context, d *schema.Resource
 	}
 	if role, ok := d.GetOk("role"); ok {
 		input.Role = utils.StringPtr(role.(string))
+
+		if d.Get("validate_role").(bool) {
+			if err := validateAuthPolicyRole(conn.RolesAPIInstance, role.(string)); err != nil {
+				return diag.FromErr(err)
+			}
+		}
 	}
 	if authPolicyType, ok := d.GetOk("authorization_policy_type"); ok {
 		const two, three, four, five, six = 2, 3, 4, 5, 6
@@ -175,7 +190,7 @@ func ResourceNutanixAuthPoliciesV2Create(ctx context.Context, d *schema.Resource
 
 	resp, err := conn.AuthAPIInstance.CreateAuthorizationPolicy(input)
 	if err != nil {
-		return diag.Errorf("error while creating authorization policies : %v", err)
+		return utils.WithRemediationHint(diag.Errorf("error while creating authorization policies : %v", err), err)
 	}
 
 	getResp := resp.Data.GetValue().(import1.AuthorizationPolicy)
@@ -193,6 +208,11 @@ func ResourceNutanixAuthPoliciesV2Read(ctx context.Context, d *schema.ResourceDa
 
 	resp, err := conn.AuthAPIInstance.GetAuthorizationPolicyById(utils.StringPtr(d.Id()))
 	if err != nil {
+		if utils.IsV4NotFound(err) {
+			log.Printf("[DEBUG] authorization policy %s not found, removing it from state", d.Id())
+			d.SetId("")
+			return nil
+		}
 		return diag.Errorf("error while fetching authorization polices: %v", err)
 	}
 	getResp := resp.Data.GetValue().(import1.AuthorizationPolicy)
@@ -206,10 +226,20 @@ func ResourceNutanixAuthPoliciesV2Read(ctx context.Context, d *schema.ResourceDa
 	if err := d.Set("client_name", getResp.ClientName); err != nil {
 		return diag.FromErr(err)
 	}
-	if err := d.Set("identities", flattenIdentityFilters(getResp.Identities)); err != nil {
+	identityFilters := make([]map[string]interface{}, len(getResp.Identities))
+	for i, v := range getResp.Identities {
+		identityFilters[i] = v.Reserved_
+	}
+	identities := applyAuthPolicyFilterExpressions(d, "identities", flattenIdentityFilters(getResp.Identities), identityFilters)
+	if err := d.Set("identities", identities); err != nil {
 		return diag.FromErr(err)
 	}
-	if err := d.Set("entities", flattenEntityFilters(getResp.Entities)); err != nil {
+	entityFilters := make([]map[string]interface{}, len(getResp.Entities))
+	for i, v := range getResp.Entities {
+		entityFilters[i] = v.Reserved_
+	}
+	entities := applyAuthPolicyFilterExpressions(d, "entities", flattenEntityFilters(getResp.Entities), entityFilters)
+	if err := d.Set("entities", entities); err != nil {
 		return diag.FromErr(err)
 	}
 	if err := d.Set("role", getResp.Role); err != nil {
@@ -244,6 +274,11 @@ func ResourceNutanixAuthPoliciesV2Update(ctx context.Context, d *schema.Resource
 	conn := meta.(*conns.Client).IamAPI
 	updatedSpec := import1.AuthorizationPolicy{}
 
+	// validate_role only drives the checks done here, there is nothing to send
+	if !d.HasChangesExcept("validate_role") {
+		return ResourceNutanixAuthPoliciesV2Read(ctx, d, meta)
+	}
+
 	resp, err := conn.AuthAPIInstance.GetAuthorizationPolicyById(utils.StringPtr(d.Id()))
 	if err != nil {
 		return diag.Errorf("error while fetching  Authorization Policy: %v", err)
@@ -277,6 +312,12 @@ func ResourceNutanixAuthPoliciesV2Update(ctx context.Context, d *schema.Resource
 	}
 	if d.HasChange("role") {
 		updatedSpec.Role = utils.StringPtr(d.Get("role").(string))
+
+		if d.Get("validate_role").(bool) {
+			if err := validateAuthPolicyRole(conn.RolesAPIInstance, d.Get("role").(string)); err != nil {
+				return diag.FromErr(err)
+			}
+		}
 	}
 	if d.HasChange("authorization_policy_type") {
 		const two, three, four, five, six = 2, 3, 4, 5, 6
@@ -294,7 +335,7 @@ func ResourceNutanixAuthPoliciesV2Update(ctx context.Context, d *schema.Resource
 
 	updatedResp, err := conn.AuthAPIInstance.UpdateAuthorizationPolicyById(utils.StringPtr(d.Id()), &updatedSpec, headers)
 	if err != nil {
-		return diag.Errorf("error while updating  Authorization Policy: %v", err)
+		return utils.WithRemediationHint(diag.Errorf("error while updating  Authorization Policy: %v", err), err)
 	}
 
 	updatedResponse := updatedResp.Data.GetValue().(config.Message)
@@ -320,7 +361,7 @@ func ResourceNutanixAuthPoliciesV2Delete(ctx context.Context, d *schema.Resource
 	headers["If-Match"] = utils.StringPtr(etagValue)
 	resp, err := conn.AuthAPIInstance.DeleteAuthorizationPolicyById(utils.StringPtr(d.Id()), headers)
 	if err != nil {
-		return diag.Errorf("error while deleting auth policy : %v", err)
+		return utils.WithRemediationHint(diag.Errorf("error while deleting auth policy : %v", err), err)
 	}
 
 	if resp == nil {
@@ -329,6 +370,18 @@ func ResourceNutanixAuthPoliciesV2Delete(ctx context.Context, d *schema.Resource
 	return nil
 }
 
+// validateAuthPolicyRole fetches the role of an Authorization Policy, so that
+// an unknown role fails before the policy is submitted.
+func validateAuthPolicyRole(rolesAPI *api.RolesApi, roleExtID string) error {
+	if _, err := rolesAPI.GetRoleById(utils.StringPtr(roleExtID)); err != nil {
+		if utils.IsV4NotFound(err) {
+			return fmt.Errorf("role %s not found, check the role ext_id of the Authorization Policy", roleExtID)
+		}
+		return fmt.Errorf("error while fetching role %s : %v", roleExtID, err)
+	}
+	return nil
+}
+
 func expandIdentityFilter(identities []interface{}) ([]import1.IdentityFilter, error) {
 	if len(identities) > 0 {
 		filters := make([]import1.IdentityFilter, len(identities))
@@ -346,17 +399,12 @@ func expandIdentityFilter(identities []interface{}) ([]import1.IdentityFilter, e
 			log.Printf("[DEBUG] expandIdentityFilter item type : %v\n", reflect.TypeOf(item))
 			filter := import1.IdentityFilter{}
 
-			if val, exists := item["reserved"]; exists {
-				// Assuming the field is of type string, adjust the type assertion accordingly
-				log.Printf("[DEBUG] expandIdentityFilter val : %v\n", val.(string))
-				log.Printf("[DEBUG] expandIdentityFilter val type : %v\n", reflect.TypeOf(val))
-				reserved, err := deserializeJSONStringToMap(val.(string))
-				if err != nil {
-					return nil, fmt.Errorf(err.Error())
-				}
-				log.Printf("[DEBUG] expandIdentityFilter reserved : %v\n", reserved)
-				filter.Reserved_ = reserved
+			reserved, err := expandAuthPolicyFilterItem(item)
+			if err != nil {
+				return nil, err
 			}
+			log.Printf("[DEBUG] expandIdentityFilter reserved : %v\n", reserved)
+			filter.Reserved_ = reserved
 			// Repeat for other fields as necessary
 			log.Printf("[DEBUG] expandIdentityFilter key:%v  filter.Reserved_ : %v\n", key, filter.Reserved_)
 			filters[key] = filter
@@ -383,17 +431,12 @@ func expandEntityFilter(entities []interface{}) ([]import1.EntityFilter, error)
 			log.Printf("[DEBUG] expandEntityFilter item type : %v\n", reflect.TypeOf(item))
 			filter := import1.EntityFilter{}
 
-			if val, exists := item["reserved"]; exists {
-				// Assuming the field is of type string, adjust the type assertion accordingly
-				log.Printf("[DEBUG] expandEntityFilter val : %v\n", val.(string))
-				log.Printf("[DEBUG] expandEntityFilter val type : %v\n", reflect.TypeOf(val))
-				reserved, err := deserializeJSONStringToMap(val.(string))
-				if err != nil {
-					return nil, fmt.Errorf(err.Error())
-				}
-				log.Printf("[DEBUG] expandEntityFilter reserved : %v\n", reserved)
-				filter.Reserved_ = reserved
+			reserved, err := expandAuthPolicyFilterItem(item)
+			if err != nil {
+				return nil, err
 			}
+			log.Printf("[DEBUG] expandEntityFilter reserved : %v\n", reserved)
+			filter.Reserved_ = reserved
 			// Repeat for other fields as necessary
 			log.Printf("[DEBUG] expandEntityFilter key:%v  filter.Reserved_ : %v\n", key, filter.Reserved_)
 			filters[key] = filter
@@ -403,6 +446,150 @@ func expandEntityFilter(entities []interface{}) ([]import1.EntityFilter, error)
 	return nil, nil
 }
 
+func authPolicyFilterExpressionSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"entity_type": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				"attribute": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				"operator": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringInSlice([]string{"ALLOF", "ANYOF", "EQ", "NONEOF", "NOTEQ"}, false),
+				},
+				"values": {
+					Type:     schema.TypeList,
+					Required: true,
+					MinItems: 1,
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+				},
+			},
+		},
+	}
+}
+
+// expandAuthPolicyFilterItem builds the filter map of an identity or entity
+// block, either from the raw reserved JSON or from the expression block, ie.
+// {"<entity_type>":{"<attribute>":{"<operator>":<values>}}}
+func expandAuthPolicyFilterItem(item map[string]interface{}) (map[string]interface{}, error) {
+	reserved, _ := item["reserved"].(string)
+	expressions, _ := item["expression"].([]interface{})
+
+	if reserved != "" && len(expressions) > 0 {
+		return nil, fmt.Errorf("only one of reserved or expression can be set in a filter block")
+	}
+	if reserved != "" {
+		return deserializeJSONStringToMap(reserved)
+	}
+	if len(expressions) == 0 || expressions[0] == nil {
+		return nil, fmt.Errorf("one of reserved or expression must be set in a filter block")
+	}
+
+	expression := expressions[0].(map[string]interface{})
+	operator := strings.ToLower(expression["operator"].(string))
+
+	rawValues := expression["values"].([]interface{})
+	values := make([]interface{}, len(rawValues))
+	for i, v := range rawValues {
+		values[i] = v.(string)
+	}
+
+	var value interface{} = values
+	// eq and noteq compare with a single value
+	if operator == "eq" || operator == "noteq" {
+		if len(values) != 1 {
+			return nil, fmt.Errorf("operator %s expects exactly one value, got %d", expression["operator"], len(values))
+		}
+		value = values[0]
+	}
+
+	return map[string]interface{}{
+		expression["entity_type"].(string): map[string]interface{}{
+			expression["attribute"].(string): map[string]interface{}{
+				operator: value,
+			},
+		},
+	}, nil
+}
+
+// flattenAuthPolicyFilterExpression returns the expression block for a filter
+// map with a single entity type, attribute and operator, ok is false for any
+// other shape which can only be represented as reserved JSON.
+func flattenAuthPolicyFilterExpression(filter map[string]interface{}) ([]interface{}, bool) {
+	if len(filter) != 1 {
+		return nil, false
+	}
+	for entityType, attributes := range filter {
+		attributeMap, ok := attributes.(map[string]interface{})
+		if !ok || len(attributeMap) != 1 {
+			return nil, false
+		}
+		for attribute, operators := range attributeMap {
+			operatorMap, ok := operators.(map[string]interface{})
+			if !ok || len(operatorMap) != 1 {
+				return nil, false
+			}
+			for operator, value := range operatorMap {
+				var values []interface{}
+				switch v := value.(type) {
+				case string:
+					values = []interface{}{v}
+				case []interface{}:
+					for _, item := range v {
+						str, ok := item.(string)
+						if !ok {
+							return nil, false
+						}
+						values = append(values, str)
+					}
+				default:
+					return nil, false
+				}
+				return []interface{}{map[string]interface{}{
+					"entity_type": entityType,
+					"attribute":   attribute,
+					"operator":    strings.ToUpper(operator),
+					"values":      values,
+				}}, true
+			}
+		}
+	}
+	return nil, false
+}
+
+// applyAuthPolicyFilterExpressions keeps the expression form for the filter
+// blocks that are configured with an expression, so that Read does not turn
+// them into reserved JSON and show a diff.
+func applyAuthPolicyFilterExpressions(d *schema.ResourceData, key string, flattened []interface{}, filters []map[string]interface{}) []interface{} {
+	for i := range flattened {
+		if i >= len(filters) {
+			break
+		}
+		configured, _ := d.Get(fmt.Sprintf("%s.%d.expression", key, i)).([]interface{})
+		if len(configured) == 0 {
+			continue
+		}
+		if expression, ok := flattenAuthPolicyFilterExpression(filters[i]); ok {
+			flattened[i] = map[string]interface{}{
+				"reserved":   "",
+				"expression": expression,
+			}
+		}
+	}
+	return flattened
+}
+
 func deserializeJSONStringToMap(jsonString string) (map[string]interface{}, error) {
 	var m map[string]interface{}
 	err := json.Unmarshal([]byte(jsonString), &m)
@@ -21,6 +21,9 @@ func TestAccV2NutanixAuthorizationPolicyResource_CreateACP(t *testing.T) {
 				Config: testAuthorizationPolicyResourceConfig(),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttrSet(resourceNameAuthorizationPolicy, "ext_id"),
+					resource.TestCheckResourceAttrSet(resourceNameAuthorizationPolicy, "created_time"),
+					resource.TestCheckResourceAttrSet(resourceNameAuthorizationPolicy, "last_updated_time"),
+					resource.TestCheckResourceAttrSet(resourceNameAuthorizationPolicy, "created_by"),
 					resource.TestCheckResourceAttr(resourceNameAuthorizationPolicy, "display_name", testVars.Iam.AuthPolicies.DisplayName),
 					resource.TestCheckResourceAttr(resourceNameAuthorizationPolicy, "description", testVars.Iam.AuthPolicies.Description),
 					resource.TestCheckResourceAttr(resourceNameAuthorizationPolicy, "authorization_policy_type", testVars.Iam.AuthPolicies.AuthPolicyType),
@@ -50,6 +53,30 @@ func TestAccV2NutanixAuthorizationPolicyResource_CreateACP(t *testing.T) {
 	})
 }
 
+func TestAccV2NutanixAuthorizationPolicyResource_WithExpressions(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccFoundationPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAuthorizationPolicyResourceWithExpressionsConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceNameAuthorizationPolicy, "ext_id"),
+					resource.TestCheckResourceAttr(resourceNameAuthorizationPolicy, "identities.0.reserved", testVars.Iam.AuthPolicies.Identities[0]),
+					resource.TestCheckResourceAttr(resourceNameAuthorizationPolicy, "entities.#", "2"),
+					resource.TestCheckResourceAttr(resourceNameAuthorizationPolicy, "entities.0.expression.0.entity_type", "images"),
+					resource.TestCheckResourceAttr(resourceNameAuthorizationPolicy, "entities.0.expression.0.attribute", "*"),
+					resource.TestCheckResourceAttr(resourceNameAuthorizationPolicy, "entities.0.expression.0.operator", "EQ"),
+					resource.TestCheckResourceAttr(resourceNameAuthorizationPolicy, "entities.0.expression.0.values.0", "*"),
+					resource.TestCheckResourceAttr(resourceNameAuthorizationPolicy, "entities.1.expression.0.entity_type", "marketplace_item"),
+					resource.TestCheckResourceAttr(resourceNameAuthorizationPolicy, "entities.1.expression.0.attribute", "owner_uuid"),
+					resource.TestCheckResourceAttr(resourceNameAuthorizationPolicy, "entities.1.expression.0.values.0", "SELF_OWNED"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccV2NutanixAuthorizationPolicyResource_WithNoDisplayName(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		PreCheck:  func() { acc.TestAccPreCheck(t) },
@@ -102,6 +129,19 @@ func TestAccV2NutanixAuthorizationPolicyResource_WithNoRole(t *testing.T) {
 	})
 }
 
+func TestAccV2NutanixAuthorizationPolicyResource_WithUnknownRole(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAuthorizationPolicyResourceWithUnknownRoleConfig(),
+				ExpectError: regexp.MustCompile("role 00000000-0000-0000-0000-000000000000 not found"),
+			},
+		},
+	})
+}
+
 func testAuthorizationPolicyResourceConfig() string {
 	return fmt.Sprintf(`
 
@@ -279,3 +319,79 @@ func testAuthorizationPolicyResourceWithoutRoleConfig() string {
 		
 	}`, filepath)
 }
+
+func testAuthorizationPolicyResourceWithExpressionsConfig() string {
+	return fmt.Sprintf(`
+
+	locals{
+		config = (jsondecode(file("%s")))
+		auth_policies = local.config.iam.auth_policies
+		roles = local.config.iam.roles
+	}
+
+	data "nutanix_operations_v2" "test" {
+		filter = "startswith(displayName, 'Create_')"
+	}
+
+	resource "nutanix_roles_v2" "test" {
+		display_name = local.roles.display_name
+		description  = local.roles.description
+		operations = [
+			data.nutanix_operations_v2.test.operations[0].ext_id,
+			data.nutanix_operations_v2.test.operations[1].ext_id
+		]
+		depends_on = [data.nutanix_operations_v2.test]
+	}
+
+	resource "nutanix_authorization_policy_v2" "test" {
+		role         = nutanix_roles_v2.test.id
+		display_name = local.auth_policies.display_name
+		description  = local.auth_policies.description
+		authorization_policy_type = local.auth_policies.authorization_policy_type
+		identities {
+			reserved = local.auth_policies.identities[0]
+		}
+		entities {
+			expression {
+				entity_type = "images"
+				attribute   = "*"
+				operator    = "EQ"
+				values      = ["*"]
+			}
+		}
+		entities {
+			expression {
+				entity_type = "marketplace_item"
+				attribute   = "owner_uuid"
+				operator    = "EQ"
+				values      = ["SELF_OWNED"]
+			}
+		}
+		depends_on = [nutanix_roles_v2.test]
+	}`, filepath)
+}
+
+func testAuthorizationPolicyResourceWithUnknownRoleConfig() string {
+	return `
+	resource "nutanix_authorization_policy_v2" "test" {
+		role         = "00000000-0000-0000-0000-000000000000"
+		display_name = "acp-unknown-role"
+		authorization_policy_type = "USER_DEFINED"
+		identities {
+			expression {
+				entity_type = "user"
+				attribute   = "uuid"
+				operator    = "ANYOF"
+				values      = ["*"]
+			}
+		}
+		entities {
+			expression {
+				entity_type = "images"
+				attribute   = "*"
+				operator    = "EQ"
+				values      = ["*"]
+			}
+		}
+	}`
+}
@@ -232,6 +232,11 @@ func ResourceNutanixDirectoryServicesV2Read(ctx context.Context, d *schema.Resou
 
 	resp, err := conn.DirectoryServiceAPIInstance.GetDirectoryServiceById(utils.StringPtr(d.Id()))
 	if err != nil {
+		if utils.IsV4NotFound(err) {
+			log.Printf("[DEBUG] directory service %s not found, removing it from state", d.Id())
+			d.SetId("")
+			return nil
+		}
 		var errordata map[string]interface{}
 		e := json.Unmarshal([]byte(err.Error()), &errordata)
 		if e != nil {
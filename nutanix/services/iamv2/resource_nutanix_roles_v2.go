@@ -2,10 +2,13 @@ package iamv2
 
 import (
 	"context"
+	"fmt"
 	"log"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nutanix/ntnx-api-golang-clients/iam-go-client/v4/api"
 	"github.com/nutanix/ntnx-api-golang-clients/iam-go-client/v4/models/common/v1/config"
 	iamConfig "github.com/nutanix/ntnx-api-golang-clients/iam-go-client/v4/models/iam/v4/authz"
 	conns "github.com/terraform-providers/terraform-provider-nutanix/nutanix"
@@ -50,6 +53,12 @@ func ResourceNutanixRolesV2() *schema.Resource {
 					Type:        schema.TypeString,
 				},
 			},
+			"validate_operations": {
+				Description: "Resolve every operation ext_id before creating or updating the Role and fail early if any of them does not exist.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+			},
 			"tenant_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -147,9 +156,15 @@ func ResourceNutanixRolesV4Create(ctx context.Context, d *schema.ResourceData, m
 		body.Operations = operationsListStr
 	}
 
+	if d.Get("validate_operations").(bool) {
+		if err := validateRoleOperations(conn.OperationsAPIInstance, body.Operations); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	resp, err := conn.RolesAPIInstance.CreateRole(body)
 	if err != nil {
-		return diag.Errorf("error while creating role: %v", err)
+		return utils.WithRemediationHint(diag.Errorf("error while creating role: %v", err), err)
 	}
 
 	getResp := resp.Data.GetValue().(iamConfig.Role)
@@ -162,6 +177,11 @@ func ResourceNutanixRolesV4Read(ctx context.Context, d *schema.ResourceData, met
 
 	resp, err := conn.RolesAPIInstance.GetRoleById(utils.StringPtr(d.Id()))
 	if err != nil {
+		if utils.IsV4NotFound(err) {
+			log.Printf("[DEBUG] role %s not found, removing it from state", d.Id())
+			d.SetId("")
+			return nil
+		}
 		return diag.Errorf("error while Reading role: %v", err)
 	}
 
@@ -271,6 +291,9 @@ func ResourceNutanixRolesV4Update(ctx context.Context, d *schema.ResourceData, m
 	headers["If-Match"] = utils.StringPtr(etagValue)
 
 	updatedSpec = readResp.Data.GetValue().(iamConfig.Role)
+	if err := checkRoleNotSystemDefined(updatedSpec, "updated"); err != nil {
+		return diag.FromErr(err)
+	}
 
 	if d.HasChange("display_name") {
 		updatedSpec.DisplayName = utils.StringPtr(d.Get("display_name").(string))
@@ -288,11 +311,17 @@ func ResourceNutanixRolesV4Update(ctx context.Context, d *schema.ResourceData, m
 			operationsListStr[i] = v.(string)
 		}
 		updatedSpec.Operations = operationsListStr
+
+		if d.Get("validate_operations").(bool) {
+			if err := validateRoleOperations(conn.OperationsAPIInstance, updatedSpec.Operations); err != nil {
+				return diag.FromErr(err)
+			}
+		}
 	}
 
 	updateResp, err := conn.RolesAPIInstance.UpdateRoleById(extID, &updatedSpec, headers)
 	if err != nil {
-		return diag.Errorf("error while updating role: %v", err)
+		return utils.WithRemediationHint(diag.Errorf("error while updating role: %v", err), err)
 	}
 	log.Printf("[DEBUG] Role updated. Response: %v", *updateResp)
 
@@ -311,6 +340,9 @@ func ResourceNutanixRolesV4Delete(ctx context.Context, d *schema.ResourceData, m
 	if err != nil {
 		return diag.Errorf("error while fetching role: %v", err)
 	}
+	if err := checkRoleNotSystemDefined(readResp.Data.GetValue().(iamConfig.Role), "deleted"); err != nil {
+		return diag.FromErr(err)
+	}
 
 	etagValue := conn.RolesAPIInstance.ApiClient.GetEtag(readResp)
 	headers := make(map[string]interface{})
@@ -318,7 +350,7 @@ func ResourceNutanixRolesV4Delete(ctx context.Context, d *schema.ResourceData, m
 
 	resp, err := conn.RolesAPIInstance.DeleteRoleById(utils.StringPtr(d.Id()), headers)
 	if err != nil {
-		return diag.Errorf("error while Deleting role: %v", err)
+		return utils.WithRemediationHint(diag.Errorf("error while Deleting role: %v", err), err)
 	}
 
 	if resp == nil {
@@ -327,6 +359,32 @@ func ResourceNutanixRolesV4Delete(ctx context.Context, d *schema.ResourceData, m
 	return nil
 }
 
+// checkRoleNotSystemDefined returns an error for a system-defined role, which
+// Prism Central does not allow to be updated or deleted.
+func checkRoleNotSystemDefined(role iamConfig.Role, action string) error {
+	if role.IsSystemDefined != nil && *role.IsSystemDefined {
+		return fmt.Errorf("role %s (%s) is system-defined and can not be %s, remove it from the configuration or the state instead",
+			utils.StringValue(role.DisplayName), utils.StringValue(role.ExtId), action)
+	}
+	return nil
+}
+
+// validateRoleOperations resolves each operation ext_id and returns a single
+// error listing all the ones that could not be found.
+func validateRoleOperations(operationsAPI *api.OperationsApi, operations []string) error {
+	invalid := make([]string, 0)
+	for _, operation := range operations {
+		if _, err := operationsAPI.GetOperationById(utils.StringPtr(operation)); err != nil {
+			log.Printf("[DEBUG] operation %s could not be resolved: %v", operation, err)
+			invalid = append(invalid, operation)
+		}
+	}
+	if len(invalid) > 0 {
+		return fmt.Errorf("the following operations do not exist or could not be fetched: %s", strings.Join(invalid, ", "))
+	}
+	return nil
+}
+
 func indexOf(slice []string, target string) int {
 	for i, v := range slice {
 		if v == target {
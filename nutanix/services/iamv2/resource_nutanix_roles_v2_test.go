@@ -20,6 +20,9 @@ func TestAccV2NutanixRolesResource_Basic(t *testing.T) {
 				Config: testRoleResourceConfig(filepath),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttrSet(resourceNameRoles, "client_name"),
+					resource.TestCheckResourceAttrSet(resourceNameRoles, "created_time"),
+					resource.TestCheckResourceAttrSet(resourceNameRoles, "last_updated_time"),
+					resource.TestCheckResourceAttrSet(resourceNameRoles, "created_by"),
 					resource.TestCheckResourceAttr(resourceNameRoles, "display_name", testVars.Iam.Roles.DisplayName),
 					resource.TestCheckResourceAttr(resourceNameRoles, "description", testVars.Iam.Roles.Description),
 				),
@@ -76,6 +79,19 @@ func TestAccV2NutanixRolesResource_WithNoOperations(t *testing.T) {
 	})
 }
 
+func TestAccV2NutanixRolesResource_WithUnknownOperation(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testRoleResourceWithUnknownOperationConfig(filepath),
+				ExpectError: regexp.MustCompile("the following operations do not exist or could not be fetched: 00000000-0000-0000-0000-000000000000"),
+			},
+		},
+	})
+}
+
 func testRoleResourceConfig(filepath string) string {
 	return fmt.Sprintf(`
 
@@ -203,3 +219,26 @@ func testRoleResourceWithoutOperationsConfig(filepath string) string {
 		description  = local.roles.description
 	}`, filepath)
 }
+
+func testRoleResourceWithUnknownOperationConfig(filepath string) string {
+	return fmt.Sprintf(`
+
+	locals{
+		config = (jsondecode(file("%s")))
+		roles = local.config.iam.roles
+	}
+
+	data "nutanix_operations_v2" "test" {
+	  filter = "startswith(displayName, 'Create_')"
+	}
+
+	resource "nutanix_roles_v2" "test" {
+		display_name = local.roles.display_name
+		description  = local.roles.description
+		operations = [
+			data.nutanix_operations_v2.test.operations[0].ext_id,
+			"00000000-0000-0000-0000-000000000000"
+	  	]
+		depends_on = [data.nutanix_operations_v2.test]
+	}`, filepath)
+}
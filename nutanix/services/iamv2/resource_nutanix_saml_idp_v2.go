@@ -183,6 +183,11 @@ func ResourceNutanixSamlIdpV2Read(ctx context.Context, d *schema.ResourceData, m
 
 	resp, err := conn.SamlIdentityAPIInstance.GetSamlIdentityProviderById(utils.StringPtr(d.Id()))
 	if err != nil {
+		if utils.IsV4NotFound(err) {
+			log.Printf("[DEBUG] saml identity provider %s not found, removing it from state", d.Id())
+			d.SetId("")
+			return nil
+		}
 		return diag.Errorf("error while fetching saml identity providers: %v", err)
 	}
 
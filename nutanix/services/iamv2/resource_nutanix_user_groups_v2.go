@@ -84,7 +84,7 @@ func ResourceNutanixUserGroupsV4Create(ctx context.Context, d *schema.ResourceDa
 
 	resp, err := conn.UserGroupsAPIInstance.CreateUserGroup(input)
 	if err != nil {
-		return diag.Errorf("error while creating user groups: %v", err)
+		return utils.WithRemediationHint(diag.Errorf("error while creating user groups: %v", err), err)
 	}
 
 	getResp := resp.Data.GetValue().(import1.UserGroup)
@@ -97,6 +97,11 @@ func ResourceNutanixUserGroupsV4Read(ctx context.Context, d *schema.ResourceData
 
 	resp, err := conn.UserGroupsAPIInstance.GetUserGroupById(utils.StringPtr(d.Id()))
 	if err != nil {
+		if utils.IsV4NotFound(err) {
+			log.Printf("[DEBUG] user group %s not found, removing it from state", d.Id())
+			d.SetId("")
+			return nil
+		}
 		return diag.Errorf("error while fetching user groups: %v", err)
 	}
 
@@ -150,7 +155,7 @@ func ResourceNutanixUserGroupsV4Delete(ctx context.Context, d *schema.ResourceDa
 
 	resp, err := conn.UserGroupsAPIInstance.DeleteUserGroupById(utils.StringPtr(d.Id()), headers)
 	if err != nil {
-		return diag.Errorf("error while deleting user group : %v", err)
+		return utils.WithRemediationHint(diag.Errorf("error while deleting user group : %v", err), err)
 	}
 
 	if resp == nil {
@@ -0,0 +1,92 @@
+package iamv2
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	import1 "github.com/nutanix/ntnx-api-golang-clients/iam-go-client/v4/models/iam/v4/authn"
+	conns "github.com/terraform-providers/terraform-provider-nutanix/nutanix"
+	"github.com/terraform-providers/terraform-provider-nutanix/utils"
+)
+
+// ResourceNutanixUserPasswordResetV2 resets the password of a LOCAL user. The reset
+// is an action, it runs on create and again every time trigger changes.
+func ResourceNutanixUserPasswordResetV2() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNutanixUserPasswordResetV2Create,
+		ReadContext:   resourceNutanixUserPasswordResetV2Read,
+		DeleteContext: resourceNutanixUserPasswordResetV2Delete,
+		Schema: map[string]*schema.Schema{
+			"user_ext_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"new_password": {
+				Type:      schema.TypeString,
+				Required:  true,
+				ForceNew:  true,
+				Sensitive: true,
+			},
+			"force_reset_password": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  true,
+			},
+			"trigger": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func resourceNutanixUserPasswordResetV2Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.Client).IamAPI
+
+	userExtID := d.Get("user_ext_id").(string)
+
+	body := &import1.PasswordResetRequest{
+		NewPassword: utils.StringPtr(d.Get("new_password").(string)),
+	}
+
+	_, err := conn.UsersAPIInstance.ResetUserPassword(utils.StringPtr(userExtID), body)
+	if err != nil {
+		return diag.Errorf("error while resetting password for user %s : %v", userExtID, err)
+	}
+
+	if d.Get("force_reset_password").(bool) {
+		getResp, err := conn.UsersAPIInstance.GetUserById(utils.StringPtr(userExtID))
+		if err != nil {
+			return diag.Errorf("error while fetching user %s : %v", userExtID, err)
+		}
+		user := getResp.Data.GetValue().(import1.User)
+		user.IsForceResetPasswordEnabled = utils.BoolPtr(true)
+		// password is already reset above, do not send it again with the update
+		user.Password = nil
+
+		args := make(map[string]interface{})
+		args["If-Match"] = utils.StringPtr(conn.APIClientInstance.GetEtag(getResp))
+
+		if _, err := conn.UsersAPIInstance.UpdateUserById(utils.StringPtr(userExtID), &user, args); err != nil {
+			return diag.Errorf("error while setting force_reset_password for user %s : %v", userExtID, err)
+		}
+	}
+
+	d.SetId(utils.GenUUID())
+	return resourceNutanixUserPasswordResetV2Read(ctx, d, meta)
+}
+
+func resourceNutanixUserPasswordResetV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return nil
+}
+
+func resourceNutanixUserPasswordResetV2Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return nil
+}
@@ -2,14 +2,18 @@ package iamv2
 
 import (
 	"context"
+	"fmt"
+	"log"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/nutanix/ntnx-api-golang-clients/iam-go-client/v4/models/common/v1/config"
 	import1 "github.com/nutanix/ntnx-api-golang-clients/iam-go-client/v4/models/iam/v4/authn"
 	conns "github.com/terraform-providers/terraform-provider-nutanix/nutanix"
+	"github.com/terraform-providers/terraform-provider-nutanix/nutanix/sdks/v4/iam"
 	"github.com/terraform-providers/terraform-provider-nutanix/utils"
 )
 
@@ -19,6 +23,9 @@ func ResourceNutanixUserV2() *schema.Resource {
 		ReadContext:   resourceNutanixUserV2Read,
 		UpdateContext: resourceNutanixUserV2Update,
 		DeleteContext: resourceNutanixUserV2Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
 		Schema: map[string]*schema.Schema{
 			"ext_id": {
 				Type:     schema.TypeString,
@@ -54,6 +61,30 @@ func ResourceNutanixUserV2() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
+			"identity": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				Computed:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"idp_id"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"idp_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"login_name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"distinguished_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"display_name": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -119,9 +150,10 @@ func ResourceNutanixUserV2() *schema.Resource {
 				},
 			},
 			"status": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Computed: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{"ACTIVE", "INACTIVE"}, false),
 			},
 			"buckets_access_keys": {
 				Type:     schema.TypeList,
@@ -187,6 +219,10 @@ func ResourceNutanixUserV2() *schema.Resource {
 				// Optional: true,
 				Computed: true,
 			},
+			"last_updated_by": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -194,6 +230,14 @@ func ResourceNutanixUserV2() *schema.Resource {
 func resourceNutanixUserV2Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.Client).IamAPI
 
+	// users are often created in bulk with for_each, bound the number of
+	// concurrent create calls to the provider max_parallel_tasks
+	release, err := meta.(*conns.Client).TaskLimiter.Acquire(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defer release()
+
 	spec := &import1.User{}
 
 	if un, ok := d.GetOk("username"); ok {
@@ -271,15 +315,31 @@ func resourceNutanixUserV2Create(ctx context.Context, d *schema.ResourceData, me
 	if addAttr, ok := d.GetOk("additional_attributes"); ok {
 		spec.AdditionalAttributes = expandKVPair(addAttr.([]interface{}))
 	}
+	if identity, ok := d.GetOk("identity"); ok {
+		if userType := d.Get("user_type").(string); !isFederatedUserType(userType) {
+			return diag.Errorf("identity can only be set for SAML or LDAP users, user_type is %s", userType)
+		}
+		expandUserIdentity(identity.([]interface{}), spec)
+	}
 
 	resp, err := conn.UsersAPIInstance.CreateUser(spec)
 	if err != nil {
-		return diag.Errorf("error while creating User : %v", err)
+		return utils.WithRemediationHint(diag.Errorf("error while creating User : %v", err), err)
 	}
 
 	getResp := resp.Data.GetValue().(import1.User)
 
 	d.SetId(*getResp.ExtId)
+
+	// a busy Prism Central may not return the user right after it is created,
+	// the read would then drop it from state
+	err = utils.CreateNotFoundRetry.Do(ctx, func() error {
+		_, err := conn.UsersAPIInstance.GetUserById(getResp.ExtId)
+		return err
+	})
+	if utils.IsV4NotFound(err) {
+		return diag.Errorf("user %s is still not found %s after its creation : %v", d.Id(), utils.CreateNotFoundRetry.Timeout, err)
+	}
 	return resourceNutanixUserV2Read(ctx, d, meta)
 }
 
@@ -288,6 +348,11 @@ func resourceNutanixUserV2Read(ctx context.Context, d *schema.ResourceData, meta
 
 	resp, err := conn.UsersAPIInstance.GetUserById(utils.StringPtr(d.Id()))
 	if err != nil {
+		if utils.IsV4NotFound(err) {
+			log.Printf("[DEBUG] user %s not found, removing it from state", d.Id())
+			d.SetId("")
+			return nil
+		}
 		return diag.Errorf("error while fetching user : %v", err)
 	}
 
@@ -308,6 +373,9 @@ func resourceNutanixUserV2Read(ctx context.Context, d *schema.ResourceData, meta
 	if err = d.Set("idp_id", getResp.IdpId); err != nil {
 		return diag.Errorf("error setting idp_id for user %s: %s", d.Id(), err)
 	}
+	if err = d.Set("identity", flattenUserIdentity(getResp)); err != nil {
+		return diag.Errorf("error setting identity for user %s: %s", d.Id(), err)
+	}
 	if err = d.Set("display_name", getResp.DisplayName); err != nil {
 		return diag.Errorf("error setting display_name for user %s: %s", d.Id(), err)
 	}
@@ -332,7 +400,7 @@ func resourceNutanixUserV2Read(ctx context.Context, d *schema.ResourceData, meta
 	if err = d.Set("force_reset_password", getResp.IsForceResetPasswordEnabled); err != nil {
 		return diag.Errorf("error setting force_reset_password for user %s: %s", d.Id(), err)
 	}
-	if err = d.Set("additional_attributes", flattenAdditionalAttributes(getResp)); err != nil {
+	if err = d.Set("additional_attributes", flattenAdditionalAttributes(userWithoutIdentityAttributes(getResp))); err != nil {
 		return diag.Errorf("error setting additional_attributes for user %s: %s", d.Id(), err)
 	}
 	if err = d.Set("status", flattenUserStatusType(getResp.Status)); err != nil {
@@ -342,24 +410,40 @@ func resourceNutanixUserV2Read(ctx context.Context, d *schema.ResourceData, meta
 		return diag.Errorf("error setting buckets_access_keys for user %s: %s", d.Id(), err)
 	}
 
-	if err = d.Set("last_login_time", getResp.LastLoginTime.Format("2006-01-02T15:04:05Z07:00")); err != nil {
-		return diag.Errorf("error setting last_login_time for user %s: %s", d.Id(), err)
+	// a user that never logged in has no last login time
+	if getResp.LastLoginTime != nil {
+		if err = d.Set("last_login_time", getResp.LastLoginTime.Format("2006-01-02T15:04:05Z07:00")); err != nil {
+			return diag.Errorf("error setting last_login_time for user %s: %s", d.Id(), err)
+		}
 	}
-	if err = d.Set("created_time", getResp.CreatedTime.Format("2006-01-02T15:04:05Z07:00")); err != nil {
-		return diag.Errorf("error setting created_time for user %s: %s", d.Id(), err)
+	if getResp.CreatedTime != nil {
+		if err = d.Set("created_time", getResp.CreatedTime.Format("2006-01-02T15:04:05Z07:00")); err != nil {
+			return diag.Errorf("error setting created_time for user %s: %s", d.Id(), err)
+		}
 	}
-	if err = d.Set("last_updated_time", getResp.LastUpdatedTime.Format("2006-01-02T15:04:05Z07:00")); err != nil {
-		return diag.Errorf("error setting last_updated_time for user %s: %s", d.Id(), err)
+	if getResp.LastUpdatedTime != nil {
+		if err = d.Set("last_updated_time", getResp.LastUpdatedTime.Format("2006-01-02T15:04:05Z07:00")); err != nil {
+			return diag.Errorf("error setting last_updated_time for user %s: %s", d.Id(), err)
+		}
 	}
 	if err = d.Set("created_by", getResp.CreatedBy); err != nil {
 		return diag.Errorf("error setting created_by for user %s: %s", d.Id(), err)
 	}
+	if err = d.Set("last_updated_by", getResp.LastUpdatedBy); err != nil {
+		return diag.Errorf("error setting last_updated_by for user %s: %s", d.Id(), err)
+	}
 	return nil
 }
 
 func resourceNutanixUserV2Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.Client).IamAPI
 
+	release, errLimit := meta.(*conns.Client).TaskLimiter.Acquire(ctx)
+	if errLimit != nil {
+		return diag.FromErr(errLimit)
+	}
+	defer release()
+
 	updateSpec := &import1.User{}
 
 	// get Resp
@@ -416,42 +500,178 @@ func resourceNutanixUserV2Update(ctx context.Context, d *schema.ResourceData, me
 	if d.HasChange("force_reset_password") {
 		updateSpec.IsForceResetPasswordEnabled = utils.BoolPtr(d.Get("force_reset_password").(bool))
 	}
-	if d.HasChange("status") {
-		const two, three = 2, 3
-		statusMap := map[string]interface{}{
-			"ACTIVE":   two,
-			"INACTIVE": three,
-		}
-		pInt := statusMap[d.Get("status").(string)]
-		p := import1.UserStatusType(pInt.(int))
-		updateSpec.Status = &p
-	}
 	if d.HasChange("additional_attributes") {
 		updateSpec.AdditionalAttributes = expandKVPair(d.Get("additional_attributes").([]interface{}))
 	}
+	// the identity is kept in the additional attributes, it is set again
+	// after they are replaced
+	if identity, ok := d.GetOk("identity"); ok && d.HasChanges("identity", "additional_attributes") {
+		if userType := d.Get("user_type").(string); !isFederatedUserType(userType) {
+			return diag.Errorf("identity can only be set for SAML or LDAP users, user_type is %s", userType)
+		}
+		expandUserIdentity(identity.([]interface{}), updateSpec)
+	}
 
-	// Extract E-Tag Header
-	etagValue := conn.APIClientInstance.GetEtag(getResp)
+	// status has its own change-state endpoint, below
+	if d.HasChangesExcept("status") {
+		// Extract E-Tag Header
+		etagValue := conn.APIClientInstance.GetEtag(getResp)
 
-	args := make(map[string]interface{})
-	args["If-Match"] = utils.StringPtr(etagValue)
+		args := make(map[string]interface{})
+		args["If-Match"] = utils.StringPtr(etagValue)
 
-	updateresp, err := conn.UsersAPIInstance.UpdateUserById(utils.StringPtr(d.Id()), updateSpec, args)
-	if err != nil {
-		return diag.FromErr(err)
+		updateresp, err := conn.UsersAPIInstance.UpdateUserById(utils.StringPtr(d.Id()), updateSpec, args)
+		if err != nil {
+			return utils.WithRemediationHint(diag.Errorf("error while updating user : %v", err), err)
+		}
+		updateResp := updateresp.Data.GetValue().(import1.User)
+
+		if d.Id() != *updateResp.ExtId {
+			return diag.Errorf("ext_id is different in update user")
+		}
 	}
-	updateResp := updateresp.Data.GetValue().(import1.User)
 
-	if d.Id() != *updateResp.ExtId {
-		return diag.Errorf("ext_id is different in update user")
+	if d.HasChange("status") {
+		if err := updateUserStatus(ctx, conn, d.Id(), d.Get("status").(string), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return utils.WithRemediationHint(diag.FromErr(err), err)
+		}
 	}
 	return resourceNutanixUserV2Read(ctx, d, meta)
 }
 
+// updateUserStatus activates or deactivates the user, then waits for the user
+// to report the new status.
+func updateUserStatus(ctx context.Context, conn *iam.Client, userExtID, status string, timeout time.Duration) error {
+	const two, three = 2, 3
+	statusMap := map[string]int{
+		"ACTIVE":   two,
+		"INACTIVE": three,
+	}
+	p := import1.UserStatusType(statusMap[status])
+
+	log.Printf("[DEBUG] changing the status of user %s to %s", userExtID, status)
+	body := &import1.UserStateUpdate{Status: &p}
+	if _, err := conn.UsersAPIInstance.UpdateUserState(utils.StringPtr(userExtID), body); err != nil {
+		return fmt.Errorf("error while changing the status of user %s to %s : %v", userExtID, status, err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"ACTIVE", "INACTIVE", "UNKNOWN"},
+		Target:  []string{status},
+		Refresh: func() (interface{}, string, error) {
+			resp, err := conn.UsersAPIInstance.GetUserById(utils.StringPtr(userExtID))
+			if err != nil {
+				return nil, "", err
+			}
+			user := resp.Data.GetValue().(import1.User)
+			return user, flattenUserStatusType(user.Status), nil
+		},
+		Timeout:    timeout,
+		Delay:      time.Second,
+		MinTimeout: time.Second,
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("error waiting for user %s to be %s : %v", userExtID, status, err)
+	}
+	return nil
+}
+
 func resourceNutanixUserV2Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	return nil
 }
 
+// The v4 user only records the identity provider of a federated user, the
+// login name and distinguished name it maps from are kept in its additional
+// attributes under these names.
+const (
+	userLoginNameAttribute         = "loginName"
+	userDistinguishedNameAttribute = "distinguishedName"
+)
+
+// expandUserIdentity sets the identity provider of the user and records the
+// external login name and distinguished name in its additional attributes.
+func expandUserIdentity(pr []interface{}, user *import1.User) {
+	if len(pr) == 0 || pr[0] == nil {
+		return
+	}
+	identity := pr[0].(map[string]interface{})
+
+	user.IdpId = utils.StringPtr(identity["idp_id"].(string))
+
+	attributes := userWithoutIdentityAttributes(*user).AdditionalAttributes
+	attributes = append(attributes, newStringKVPair(userLoginNameAttribute, identity["login_name"].(string)))
+	if dn := identity["distinguished_name"].(string); dn != "" {
+		attributes = append(attributes, newStringKVPair(userDistinguishedNameAttribute, dn))
+	}
+	user.AdditionalAttributes = attributes
+}
+
+// isFederatedUserType reports whether users of the type sign in through an
+// identity provider, only their identity is managed by the identity block.
+func isFederatedUserType(userType string) bool {
+	return userType == "SAML" || userType == "LDAP"
+}
+
+// flattenUserIdentity returns the identity of a SAML or LDAP user, nil for
+// other users and for a user without identity provider.
+func flattenUserIdentity(user import1.User) []interface{} {
+	if !isFederatedUserType(flattenUserType(user.UserType)) || user.IdpId == nil || *user.IdpId == "" {
+		return nil
+	}
+	identity := map[string]interface{}{
+		"idp_id": utils.StringValue(user.IdpId),
+		// users created without identity sign in with their username
+		"login_name":         utils.StringValue(user.Username),
+		"distinguished_name": "",
+	}
+	for _, attr := range user.AdditionalAttributes {
+		if attr.Name == nil || attr.Value == nil {
+			continue
+		}
+		value, ok := attr.Value.GetValue().(string)
+		if !ok {
+			continue
+		}
+		switch *attr.Name {
+		case userLoginNameAttribute:
+			identity["login_name"] = value
+		case userDistinguishedNameAttribute:
+			identity["distinguished_name"] = value
+		}
+	}
+	return []interface{}{identity}
+}
+
+// userWithoutIdentityAttributes returns a SAML or LDAP user without the
+// additional attributes holding its identity, which are managed by the
+// identity block. Other users are returned as is.
+func userWithoutIdentityAttributes(user import1.User) import1.User {
+	if !isFederatedUserType(flattenUserType(user.UserType)) {
+		return user
+	}
+	attributes := make([]config.KVPair, 0, len(user.AdditionalAttributes))
+	for _, attr := range user.AdditionalAttributes {
+		name := utils.StringValue(attr.Name)
+		if name == userLoginNameAttribute || name == userDistinguishedNameAttribute {
+			continue
+		}
+		attributes = append(attributes, attr)
+	}
+	if len(attributes) == 0 {
+		attributes = nil
+	}
+	user.AdditionalAttributes = attributes
+	return user
+}
+
+func newStringKVPair(name, value string) config.KVPair {
+	pair := config.NewKVPair()
+	pair.Name = utils.StringPtr(name)
+	pair.Value = config.NewOneOfKVPairValue()
+	_ = pair.Value.SetValue(value)
+	return *pair
+}
+
 func expandKVPair(pr []interface{}) []config.KVPair {
 	if len(pr) > 0 {
 		kvPairs := make([]config.KVPair, len(pr))
@@ -33,6 +33,9 @@ func TestAccV2NutanixUsersResource_LocalActiveUser(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceNameUsers, "last_name", "last-name-"+name),
 					resource.TestCheckResourceAttr(resourceNameUsers, "email_id", testVars.Iam.Users.EmailID),
 					resource.TestCheckResourceAttr(resourceNameUsers, "status", "ACTIVE"),
+					resource.TestCheckResourceAttrSet(resourceNameUsers, "created_time"),
+					resource.TestCheckResourceAttrSet(resourceNameUsers, "last_updated_time"),
+					resource.TestCheckResourceAttrSet(resourceNameUsers, "created_by"),
 				),
 			},
 			// test update
@@ -49,6 +52,13 @@ func TestAccV2NutanixUsersResource_LocalActiveUser(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceNameUsers, "email_id", fmt.Sprintf("updated_%s", testVars.Iam.Users.EmailID)),
 				),
 			},
+			// test import
+			{
+				ResourceName:            resourceNameUsers,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password"},
+			},
 		},
 	})
 }
@@ -128,6 +138,39 @@ func TestAccV2NutanixUsersResource_SAMLUser(t *testing.T) {
 	})
 }
 
+// create SAML user with its external login
+func TestAccV2NutanixUsersResource_SAMLUserWithIdentity(t *testing.T) {
+	r := acctest.RandInt()
+	name := fmt.Sprintf("tf-test-user-%d", r)
+	loginName := fmt.Sprintf("tf-test-user-%d@example.com", r)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccFoundationPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testSAMLUserWithIdentityResourceConfig(filepath, name, loginName, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceNameUsers, "ext_id"),
+					resource.TestCheckResourceAttr(resourceNameUsers, "user_type", "SAML"),
+					resource.TestCheckResourceAttr(resourceNameUsers, "idp_id", testVars.Iam.Users.IdpID),
+					resource.TestCheckResourceAttr(resourceNameUsers, "identity.#", "1"),
+					resource.TestCheckResourceAttr(resourceNameUsers, "identity.0.idp_id", testVars.Iam.Users.IdpID),
+					resource.TestCheckResourceAttr(resourceNameUsers, "identity.0.login_name", loginName),
+					resource.TestCheckResourceAttr(resourceNameUsers, "identity.0.distinguished_name", ""),
+				),
+			},
+			{
+				Config: testSAMLUserWithIdentityResourceConfig(filepath, name, loginName, "CN="+name+",OU=users,DC=example,DC=com"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceNameUsers, "identity.0.login_name", loginName),
+					resource.TestCheckResourceAttr(resourceNameUsers, "identity.0.distinguished_name", "CN="+name+",OU=users,DC=example,DC=com"),
+				),
+			},
+		},
+	})
+}
+
 // create LDAP user
 func TestAccV2NutanixUsersResource_LDAPUser(t *testing.T) {
 	resource.Test(t, resource.TestCase{
@@ -151,7 +194,6 @@ func TestAccV2NutanixUsersResource_LDAPUser(t *testing.T) {
 
 // create local Active user, and test update the username and display name
 func TestAccV2NutanixUsersResource_DeactivateLocalUser(t *testing.T) {
-	t.Skip("these test were commented since they are using different APIs")
 	r := acctest.RandInt()
 	name := fmt.Sprintf("tf-test-user-%d", r)
 
@@ -171,9 +213,14 @@ func TestAccV2NutanixUsersResource_DeactivateLocalUser(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceNameUsers, "status", "ACTIVE"),
 				),
 			},
+			// only ACTIVE and INACTIVE are accepted
+			{
+				Config:      testDeactivateLocalUserResourceConfig(filepath, name, "DISABLED"),
+				ExpectError: regexp.MustCompile(`expected status to be one of \[ACTIVE INACTIVE\]`),
+			},
 			// test Deactivate User
 			{
-				Config: testDeactivateLocalUserResourceConfig(filepath, name),
+				Config: testDeactivateLocalUserResourceConfig(filepath, name, "INACTIVE"),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttrSet(resourceNameUsers, "ext_id"),
 					resource.TestCheckResourceAttr(resourceNameUsers, "username", name),
@@ -184,6 +231,14 @@ func TestAccV2NutanixUsersResource_DeactivateLocalUser(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceNameUsers, "status", "INACTIVE"),
 				),
 			},
+			// the user is reactivated in place
+			{
+				Config: testDeactivateLocalUserResourceConfig(filepath, name, "ACTIVE"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceNameUsers, "username", name),
+					resource.TestCheckResourceAttr(resourceNameUsers, "status", "ACTIVE"),
+				),
+			},
 		},
 	})
 }
@@ -330,6 +385,25 @@ func testSAMLUserResourceConfig(filepath, name string) string {
 	}`, filepath, name)
 }
 
+func testSAMLUserWithIdentityResourceConfig(filepath, name, loginName, distinguishedName string) string {
+	return fmt.Sprintf(`
+
+	locals{
+		config = (jsondecode(file("%[1]s")))
+		users = local.config.iam.users
+	}
+
+	resource "nutanix_users_v2" "test" {
+		username = "%[2]s"
+		user_type = "SAML"
+		identity {
+			idp_id = local.users.idp_id
+			login_name = "%[3]s"
+			distinguished_name = "%[4]s"
+		}
+	}`, filepath, name, loginName, distinguishedName)
+}
+
 func testLDAPUserWithMinimalConfigResourceConfig(filepath string) string {
 	return fmt.Sprintf(`
 
@@ -346,7 +420,7 @@ func testLDAPUserWithMinimalConfigResourceConfig(filepath string) string {
 	}`, filepath)
 }
 
-func testDeactivateLocalUserResourceConfig(filepath, name string) string {
+func testDeactivateLocalUserResourceConfig(filepath, name, status string) string {
 	return fmt.Sprintf(`
 
 	locals{
@@ -356,15 +430,18 @@ func testDeactivateLocalUserResourceConfig(filepath, name string) string {
 
 	resource "nutanix_users_v2" "test" {
 		username = "%[2]s"
-		user_type = "LOCAL"
-		idp_id = local.users.idp_id
-		display_name = "display-name-%[2]s"
+		first_name = "first-name-%[2]s"
+		middle_initial = "middle-initial-%[2]s"
+		last_name = "last-name-%[2]s"
+		email_id = local.users.email_id
 		locale = local.users.locale
 		region = local.users.region
+		display_name = "display-name-%[2]s"
 		password = local.users.password
+		user_type = "LOCAL"
+		status = "%[3]s"
 		force_reset_password = local.users.force_reset_password
-		status = INACTIVE  
-	}`, filepath, name)
+	}`, filepath, name, status)
 }
 
 func testUsersResourceWithoutUserNameConfig(filepath string) string {
@@ -414,3 +491,44 @@ func testUsersResourceWithoutUserTypeConfig(filepath, name string) string {
 
 	}`, filepath, name)
 }
+
+// reset the password of a local user, and reset it again when trigger changes
+func TestAccV2NutanixUsersResource_PasswordReset(t *testing.T) {
+	r := acctest.RandInt()
+	name := fmt.Sprintf("tf-test-user-%d", r)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccFoundationPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testLocalActiveUserResourceConfig(filepath, name) + testUserPasswordResetConfig("1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("nutanix_user_password_reset_v2.test", "id"),
+					resource.TestCheckResourceAttrSet("nutanix_user_password_reset_v2.test", "force_reset_password"),
+				),
+			},
+			// changing trigger resets the password again
+			{
+				Config: testLocalActiveUserResourceConfig(filepath, name) + testUserPasswordResetConfig("2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("nutanix_user_password_reset_v2.test", "trigger.rotation", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testUserPasswordResetConfig(rotation string) string {
+	return fmt.Sprintf(`
+
+	resource "nutanix_user_password_reset_v2" "test" {
+		user_ext_id  = nutanix_users_v2.test.id
+		new_password = "%[1]s-${local.users.password}"
+		# keep the flag in line with the user resource so the plan stays empty
+		force_reset_password = local.users.force_reset_password
+		trigger = {
+			rotation = "%[1]s"
+		}
+	}`, rotation)
+}
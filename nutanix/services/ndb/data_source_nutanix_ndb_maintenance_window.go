@@ -2,6 +2,8 @@ package ndb
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -14,12 +16,16 @@ func DataSourceNutanixNDBMaintenanceWindow() *schema.Resource {
 		ReadContext: dataSourceNutanixNDBMaintenanceWindowRead,
 		Schema: map[string]*schema.Schema{
 			"id": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"id", "name"},
 			},
 			"name": {
-				Type:     schema.TypeString,
-				Computed: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"id", "name"},
 			},
 			"description": {
 				Type:     schema.TypeString,
@@ -113,6 +119,10 @@ func DataSourceNutanixNDBMaintenanceWindow() *schema.Resource {
 				Computed: true,
 			},
 			"entity_task_assoc": EntityTaskAssocSchema(),
+			"task_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
 			"timezone": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -124,9 +134,17 @@ func DataSourceNutanixNDBMaintenanceWindow() *schema.Resource {
 func dataSourceNutanixNDBMaintenanceWindowRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.Client).Era
 
-	maintainenanceWindowID := d.Get("id")
+	maintainenanceWindowID := d.Get("id").(string)
 
-	resp, err := conn.Service.ReadMaintenanceWindow(ctx, maintainenanceWindowID.(string))
+	if name, ok := d.GetOk("name"); ok {
+		windowID, err := findMaintenanceWindowIDByName(ctx, conn, name.(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		maintainenanceWindowID = windowID
+	}
+
+	resp, err := conn.Service.ReadMaintenanceWindow(ctx, maintainenanceWindowID)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -162,6 +180,9 @@ func dataSourceNutanixNDBMaintenanceWindowRead(ctx context.Context, d *schema.Re
 	if err := d.Set("entity_task_assoc", flattenEntityTaskAssoc(resp.EntityTaskAssoc)); err != nil {
 		return diag.FromErr(err)
 	}
+	if err := d.Set("task_count", len(resp.EntityTaskAssoc)); err != nil {
+		return diag.FromErr(err)
+	}
 
 	if err := d.Set("timezone", resp.Timezone); err != nil {
 		return diag.FromErr(err)
@@ -186,10 +207,37 @@ func dataSourceNutanixNDBMaintenanceWindowRead(ctx context.Context, d *schema.Re
 		return diag.FromErr(err)
 	}
 
-	d.SetId(maintainenanceWindowID.(string))
+	d.SetId(maintainenanceWindowID)
 	return nil
 }
 
+// findMaintenanceWindowIDByName returns the id of the maintenance window named name,
+// names are not unique in NDB so more than one match is an error.
+func findMaintenanceWindowIDByName(ctx context.Context, conn *era.Client, name string) (string, error) {
+	resp, err := conn.Service.ListMaintenanceWindow(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	ids := make([]string, 0)
+	if resp != nil {
+		for _, v := range *resp {
+			if v.Name != nil && *v.Name == name && v.ID != nil {
+				ids = append(ids, *v.ID)
+			}
+		}
+	}
+
+	switch len(ids) {
+	case 0:
+		return "", fmt.Errorf("no maintenance window found with name %s", name)
+	case 1:
+		return ids[0], nil
+	default:
+		return "", fmt.Errorf("found %d maintenance windows with name %s, use id instead: %s", len(ids), name, strings.Join(ids, ", "))
+	}
+}
+
 func flattenEntityTaskAssoc(pr []*era.MaintenanceTasksResponse) []interface{} {
 	if len(pr) > 0 {
 		tasks := make([]interface{}, 0)
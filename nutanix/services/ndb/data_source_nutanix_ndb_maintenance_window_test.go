@@ -26,6 +26,30 @@ func TestAccEraMaintenanceWindowDataSource_basic(t *testing.T) {
 	})
 }
 
+func TestAccEraMaintenanceWindowDataSource_ByName(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccEraPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					data "nutanix_ndb_maintenance_windows" "window"{ }
+
+					data "nutanix_ndb_maintenance_window" "test"{
+						name = data.nutanix_ndb_maintenance_windows.window.maintenance_windows.0.name
+					}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.nutanix_ndb_maintenance_window.test", "id",
+						"data.nutanix_ndb_maintenance_windows.window", "maintenance_windows.0.id"),
+					resource.TestCheckResourceAttrPair("data.nutanix_ndb_maintenance_window.test", "task_count",
+						"data.nutanix_ndb_maintenance_windows.window", "maintenance_windows.0.task_count"),
+				),
+			},
+		},
+	})
+}
+
 func testAccEraMaintenanceWindowDataSourceConfig() string {
 	return `
 		data "nutanix_ndb_maintenance_windows" "window"{ }
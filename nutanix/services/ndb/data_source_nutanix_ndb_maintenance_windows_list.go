@@ -119,6 +119,10 @@ func DataSourceNutanixNDBMaintenanceWindows() *schema.Resource {
 							Computed: true,
 						},
 						"entity_task_assoc": EntityTaskAssocSchema(),
+						"task_count": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
 						"timezone": {
 							Type:     schema.TypeString,
 							Computed: true,
@@ -168,6 +172,7 @@ func flattenMaintenanceWindowsResponse(pr *era.ListMaintenanceWindowResponse) []
 			window["status"] = v.Status
 			window["next_run_time"] = v.NextRunTime
 			window["entity_task_assoc"] = flattenEntityTaskAssoc(v.EntityTaskAssoc)
+			window["task_count"] = len(v.EntityTaskAssoc)
 			window["timezone"] = v.Timezone
 			if v.Properties != nil {
 				props := []interface{}{}
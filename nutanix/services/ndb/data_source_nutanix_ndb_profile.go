@@ -30,7 +30,7 @@ func DataSourceNutanixEraProfile() *schema.Resource {
 				ValidateFunc: validation.StringInSlice([]string{
 					"Software", "Compute",
 					"Network", "Database_Parameter",
-				}, false),
+				}, true),
 			},
 			"profile_id": {
 				Type:     schema.TypeString,
@@ -304,7 +304,7 @@ func dataSourceNutanixEraProfileRead(ctx context.Context, d *schema.ResourceData
 	}
 
 	if ptype, ok := d.GetOk("profile_type"); ok {
-		profileType = ptype.(string)
+		profileType = normalizeNDBProfileType(ptype.(string))
 		profileFilters.ProfileType = profileType
 	}
 
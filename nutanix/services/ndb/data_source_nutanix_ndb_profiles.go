@@ -3,6 +3,7 @@ package ndb
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/hashicorp/go-uuid"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -32,7 +33,7 @@ func DataSourceNutanixEraProfiles() *schema.Resource {
 				ValidateFunc: validation.StringInSlice([]string{
 					"Software", "Compute",
 					"Network", "Database_Parameter",
-				}, false),
+				}, true),
 			},
 			"profiles": {
 				Type:     schema.TypeList,
@@ -302,7 +303,7 @@ func dataSourceNutanixEraProfilesRead(ctx context.Context, d *schema.ResourceDat
 	}
 
 	if ptype, ok := d.GetOk("profile_type"); ok {
-		profileType = ptype.(string)
+		profileType = normalizeNDBProfileType(ptype.(string))
 	}
 
 	//check for profile type and engine
@@ -438,7 +439,7 @@ func flattenClusterAssociation(erc []*Era.VersionClusterAssociation) []map[strin
 func dataSourceEraProfileEngineDiff(ctx context.Context, d *schema.ResourceData, m interface{}) (bool, error) {
 	// check for profile type
 	if ptype, vok := d.GetOk("profile_type"); vok {
-		if ptype == "Compute" {
+		if normalizeNDBProfileType(ptype.(string)) == "Compute" {
 			if _, sok := d.GetOk("engine"); sok {
 				return false, fmt.Errorf("compute profile type should not be used if engine is given")
 			}
@@ -446,3 +447,14 @@ func dataSourceEraProfileEngineDiff(ctx context.Context, d *schema.ResourceData,
 	}
 	return true, nil
 }
+
+// normalizeNDBProfileType maps a profile_type given in any case, such as SOFTWARE,
+// to the form expected by the NDB API.
+func normalizeNDBProfileType(profileType string) string {
+	for _, v := range []string{"Software", "Compute", "Network", "Database_Parameter"} {
+		if strings.EqualFold(v, profileType) {
+			return v
+		}
+	}
+	return profileType
+}
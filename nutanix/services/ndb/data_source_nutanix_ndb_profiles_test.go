@@ -64,6 +64,26 @@ func TestAccEraProfilesDataSource_ByProfileType(t *testing.T) {
 	})
 }
 
+func TestAccEraProfilesDataSource_ByUpperCaseProfileType(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccEraPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					data "nutanix_ndb_profiles" "test" {
+						profile_type = "COMPUTE"
+					}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.nutanix_ndb_profiles.test", "profiles.0.id"),
+					resource.TestCheckResourceAttr("data.nutanix_ndb_profiles.test", "profiles.0.type", "Compute"),
+				),
+			},
+		},
+	})
+}
+
 func testAccEraProfilesDataSourceConfig() string {
 	return `
 		data "nutanix_ndb_profiles" "test" { }
@@ -20,6 +20,7 @@ func ResourceNutanixNDBClone() *schema.Resource {
 		DeleteContext: resourceNutanixNDBCloneDelete,
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(EraProvisionTimeout),
+			Update: schema.DefaultTimeout(EraRefreshCloneTimeout),
 			Delete: schema.DefaultTimeout(EraProvisionTimeout),
 		},
 		Importer: &schema.ResourceImporter{
@@ -51,6 +52,17 @@ func ResourceNutanixNDBClone() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
+			// refresh arguments for clone resource, changing them refreshes the clone in place.
+			"refresh_to_snapshot_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"refresh_to_timestamp"},
+			},
+			"refresh_to_timestamp": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"refresh_to_snapshot_id"},
+			},
 			"node_count": {
 				Type:     schema.TypeInt,
 				Optional: true,
@@ -545,6 +557,16 @@ func resourceNutanixNDBCloneUpdate(ctx context.Context, d *schema.ResourceData,
 	conn := meta.(*conns.Client).Era
 	dbID := d.Id()
 
+	if d.HasChanges("refresh_to_snapshot_id", "refresh_to_timestamp") {
+		if err := refreshNDBClone(ctx, d, conn); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if !d.HasChanges("name", "description", "tags") {
+		return resourceNutanixNDBCloneRead(ctx, d, meta)
+	}
+
 	name := ""
 	description := ""
 
@@ -582,6 +604,31 @@ func resourceNutanixNDBCloneUpdate(ctx context.Context, d *schema.ResourceData,
 	return resourceNutanixNDBCloneRead(ctx, d, meta)
 }
 
+// refreshNDBClone refreshes the clone to the snapshot or point in time given
+// by refresh_to_snapshot_id or refresh_to_timestamp and waits for it.
+func refreshNDBClone(ctx context.Context, d *schema.ResourceData, conn *era.Client) error {
+	snapshotID := d.Get("refresh_to_snapshot_id").(string)
+	userPitrTimestamp := d.Get("refresh_to_timestamp").(string)
+	if snapshotID == "" && userPitrTimestamp == "" {
+		// the refresh arguments were removed, nothing to refresh to
+		return nil
+	}
+
+	req := &era.CloneRefreshInput{}
+	if snapshotID != "" {
+		req.SnapshotID = utils.StringPtr(snapshotID)
+	}
+	if userPitrTimestamp != "" {
+		req.UserPitrTimestamp = utils.StringPtr(userPitrTimestamp)
+	}
+	if timezone, ok := d.GetOk("time_zone"); ok {
+		req.Timezone = utils.StringPtr(timezone.(string))
+	}
+
+	_, err := refreshCloneAndWait(ctx, conn, d.Id(), req, d.Timeout(schema.TimeoutUpdate))
+	return err
+}
+
 func resourceNutanixNDBCloneDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.Client).Era
 	if conn == nil {
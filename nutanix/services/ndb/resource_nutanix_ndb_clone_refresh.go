@@ -2,6 +2,7 @@ package ndb
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"time"
 
@@ -69,15 +70,27 @@ func resourceNutanixNDBCloneRefreshCreate(ctx context.Context, d *schema.Resourc
 		req.Timezone = utils.StringPtr(timezone.(string))
 	}
 
-	resp, err := conn.Service.RefreshClone(ctx, req, cloneID)
+	opID, err := refreshCloneAndWait(ctx, conn, cloneID, req, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return diag.FromErr(err)
 	}
+	d.SetId(opID)
+	return nil
+}
+
+// refreshCloneAndWait refreshes a clone as described by req and waits for the
+// refresh operation, whose id it returns. It is shared by this resource and
+// the refresh_to_* arguments of nutanix_ndb_clone.
+func refreshCloneAndWait(ctx context.Context, conn *era.Client, cloneID string, req *era.CloneRefreshInput, timeout time.Duration) (string, error) {
+	resp, err := conn.Service.RefreshClone(ctx, req, cloneID)
+	if err != nil {
+		return "", err
+	}
 
 	// Get Operation ID from response of clone refresh and poll for the operation to get completed.
 	opID := resp.Operationid
 	if opID == "" {
-		return diag.Errorf("error: operation ID is an empty string")
+		return "", fmt.Errorf("error: operation ID is an empty string")
 	}
 	opReq := era.GetOperationRequest{
 		OperationID: opID,
@@ -88,16 +101,15 @@ func resourceNutanixNDBCloneRefreshCreate(ctx context.Context, d *schema.Resourc
 		Pending: []string{"PENDING"},
 		Target:  []string{"COMPLETED", "FAILED"},
 		Refresh: eraRefresh(ctx, conn, opReq),
-		Timeout: d.Timeout(schema.TimeoutCreate),
+		Timeout: timeout,
 		Delay:   eraDelay,
 	}
 
 	if _, errWaitTask := stateConf.WaitForStateContext(ctx); errWaitTask != nil {
-		return diag.Errorf("error waiting for db refresh clone (%s) to create: %s", resp.Entityid, errWaitTask)
+		return "", fmt.Errorf("error waiting for db refresh clone (%s) to complete: %s", cloneID, errWaitTask)
 	}
-	log.Printf("NDB clone Refresh with %s id is completed successfully", d.Id())
-	d.SetId(resp.Operationid)
-	return nil
+	log.Printf("NDB clone with %s id is refreshed successfully", cloneID)
+	return opID, nil
 }
 
 func resourceNutanixNDBCloneRefreshRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
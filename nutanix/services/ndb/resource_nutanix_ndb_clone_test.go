@@ -21,7 +21,7 @@ func TestAccEra_Clonebasic(t *testing.T) {
 		Providers: acc.TestAccProviders,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccEraCloneConfig(name, desc, vmName, sshKey),
+				Config: testAccEraCloneConfig(name, desc, vmName, sshKey, ""),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr(resourceClone, "name", name),
 					resource.TestCheckResourceAttr(resourceClone, "description", desc),
@@ -37,7 +37,36 @@ func TestAccEra_Clonebasic(t *testing.T) {
 	})
 }
 
-func testAccEraCloneConfig(name, desc, vmName, sshKey string) string {
+func TestAccEra_CloneRefresh(t *testing.T) {
+	r := acc.RandIntBetween(36, 45)
+	name := fmt.Sprintf("test-pg-inst-tf-clone-%d", r)
+	desc := "this is desc"
+	vmName := fmt.Sprintf("testvm-%d", r)
+	sshKey := testVars.SSHKey
+	refresh := "refresh_to_snapshot_id = data.nutanix_ndb_tms_capability.test.last_continuous_snapshot.0.id"
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccEraPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEraCloneConfig(name, desc, vmName, sshKey, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceClone, "name", name),
+				),
+			},
+			{
+				Config: testAccEraCloneConfig(name, desc, vmName, sshKey, refresh),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceClone, "name", name),
+					resource.TestCheckResourceAttr(resourceClone, "description", desc),
+					resource.TestCheckResourceAttrSet(resourceClone, "refresh_to_snapshot_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccEraCloneConfig(name, desc, vmName, sshKey, refresh string) string {
 	return fmt.Sprintf(`
 	data "nutanix_ndb_profiles" "p"{
 	}
@@ -94,6 +123,7 @@ func testAccEraCloneConfig(name, desc, vmName, sshKey string) string {
 		  db_password= "pass"
 		  # dbserver_description = "des"
 		}
+		%[5]s
 	}
-	`, name, desc, vmName, sshKey)
+	`, name, desc, vmName, sshKey, refresh)
 }
@@ -42,6 +42,14 @@ func ResourceNutanixNDBLogCatchUps() *schema.Resource {
 				Type:     schema.TypeInt,
 				Optional: true,
 			},
+			// any change of trigger performs a new log catchup, e.g. right before a point in time clone
+			"trigger": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
 		},
 	}
 }
@@ -1,6 +1,7 @@
 package ndb_test
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -24,6 +25,29 @@ func TestAccEra_LogCatchUpbasic(t *testing.T) {
 	})
 }
 
+func TestAccEra_LogCatchUpWithTrigger(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccEraPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEraDatabaseLogCatchUpConfigWithTrigger("1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceNameLogCatchDB, "time_machine_id"),
+					resource.TestCheckResourceAttr(resourceNameLogCatchDB, "trigger.run", "1"),
+				),
+			},
+			// changing the trigger performs a new log catchup
+			{
+				Config: testAccEraDatabaseLogCatchUpConfigWithTrigger("2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceNameLogCatchDB, "trigger.run", "2"),
+				),
+			},
+		},
+	})
+}
+
 func testAccEraDatabaseLogCatchUpConfig() string {
 	return (`
 		data "nutanix_ndb_time_machines" "test1" {}
@@ -33,3 +57,16 @@ func testAccEraDatabaseLogCatchUpConfig() string {
 		}
 	`)
 }
+
+func testAccEraDatabaseLogCatchUpConfigWithTrigger(run string) string {
+	return fmt.Sprintf(`
+		data "nutanix_ndb_time_machines" "test1" {}
+
+		resource "nutanix_ndb_log_catchups" "acctest-managed" {
+			time_machine_id = data.nutanix_ndb_time_machines.test1.time_machines.0.id
+			trigger = {
+				run = "%s"
+			}
+		}
+	`, run)
+}
@@ -2,7 +2,10 @@ package ndb
 
 import (
 	"context"
+	"fmt"
 	"log"
+	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -30,10 +33,12 @@ func ResourceNutanixNDBMaintenanceWindow() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			// when omitted, NDB uses the timezone of the NDB server
 			"timezone": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Default:  "Asia/Calcutta",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateMaintenanceWindowTimezone,
 			},
 
 			"recurrence": {
@@ -47,8 +52,12 @@ func ResourceNutanixNDBMaintenanceWindow() *schema.Resource {
 				Default:  "2",
 			},
 			"start_time": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateMaintenanceWindowStartTime,
+				StateFunc: func(v interface{}) string {
+					return normalizeMaintenanceWindowStartTime(v.(string))
+				},
 			},
 			"day_of_week": {
 				Type:     schema.TypeString,
@@ -186,7 +195,7 @@ func resourceNutanixNDBMaintenanceWindowCreate(ctx context.Context, d *schema.Re
 	}
 
 	if startTime, ok := d.GetOk("start_time"); ok {
-		schedule.StartTime = utils.StringPtr(startTime.(string))
+		schedule.StartTime = utils.StringPtr(normalizeMaintenanceWindowStartTime(startTime.(string)))
 	}
 
 	if dayOfWeek, ok := d.GetOk("day_of_week"); ok && len(dayOfWeek.(string)) > 0 {
@@ -206,7 +215,8 @@ func resourceNutanixNDBMaintenanceWindowCreate(ctx context.Context, d *schema.Re
 
 	d.SetId(*resp.ID)
 	log.Printf("NDB Maintenance Window with %s id is created successfully", d.Id())
-	return resourceNutanixNDBMaintenanceWindowRead(ctx, d, meta)
+	diags := resourceNutanixNDBMaintenanceWindowRead(ctx, d, meta)
+	return append(diags, maintenanceWindowStartTimeWarnings(d)...)
 }
 
 func resourceNutanixNDBMaintenanceWindowRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -227,6 +237,11 @@ func resourceNutanixNDBMaintenanceWindowRead(ctx context.Context, d *schema.Reso
 	if err := d.Set("description", resp.Description); err != nil {
 		return diag.FromErr(err)
 	}
+	if resp.Timezone != nil {
+		if err := d.Set("timezone", resp.Timezone); err != nil {
+			return diag.FromErr(err)
+		}
+	}
 
 	if err := d.Set("owner_id", resp.OwnerID); err != nil {
 		return diag.FromErr(err)
@@ -323,7 +338,7 @@ func resourceNutanixNDBMaintenanceWindowUpdate(ctx context.Context, d *schema.Re
 	}
 
 	if d.HasChange("start_time") {
-		sch.StartTime = utils.StringPtr(d.Get("start_time").(string))
+		sch.StartTime = utils.StringPtr(normalizeMaintenanceWindowStartTime(d.Get("start_time").(string)))
 	}
 
 	if d.HasChange("day_of_week") {
@@ -343,7 +358,11 @@ func resourceNutanixNDBMaintenanceWindowUpdate(ctx context.Context, d *schema.Re
 	}
 
 	log.Printf("NDB Maintenance Window with %s id is updated successfully", *respUpdate.ID)
-	return resourceNutanixNDBMaintenanceWindowRead(ctx, d, meta)
+	diags := resourceNutanixNDBMaintenanceWindowRead(ctx, d, meta)
+	if d.HasChanges("start_time", "timezone") {
+		diags = append(diags, maintenanceWindowStartTimeWarnings(d)...)
+	}
+	return diags
 }
 
 func resourceNutanixNDBMaintenanceWindowDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -414,3 +433,71 @@ func expandMaintenanceSchdeule(pr []interface{}) *era.MaintenaceSchedule {
 	}
 	return nil
 }
+
+// maintenanceWindowStartTimeLayouts are the accepted start_time formats, the
+// first one is the form sent to NDB.
+var maintenanceWindowStartTimeLayouts = []string{"15:04:05", "15:04"}
+
+func parseMaintenanceWindowStartTime(startTime string) (time.Time, error) {
+	var err error
+	for _, layout := range maintenanceWindowStartTimeLayouts {
+		var t time.Time
+		if t, err = time.Parse(layout, strings.TrimSpace(startTime)); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, err
+}
+
+// normalizeMaintenanceWindowStartTime returns start_time as HH:MM:SS, e.g. 9:30 becomes 09:30:00.
+func normalizeMaintenanceWindowStartTime(startTime string) string {
+	t, err := parseMaintenanceWindowStartTime(startTime)
+	if err != nil {
+		return startTime
+	}
+	return t.Format(maintenanceWindowStartTimeLayouts[0])
+}
+
+func validateMaintenanceWindowStartTime(v interface{}, k string) (ws []string, es []error) {
+	if _, err := parseMaintenanceWindowStartTime(v.(string)); err != nil {
+		es = append(es, fmt.Errorf("%q must be a wall-clock time as HH:MM:SS or HH:MM, got %q", k, v))
+	}
+	return
+}
+
+func validateMaintenanceWindowTimezone(v interface{}, k string) (ws []string, es []error) {
+	if _, err := time.LoadLocation(v.(string)); err != nil {
+		es = append(es, fmt.Errorf("%q must be an IANA timezone such as Asia/Calcutta or UTC, got %q: %v", k, v, err))
+	}
+	return
+}
+
+// maintenanceWindowStartTimeWarnings warns when start_time does not exist on some
+// day of the coming year in timezone, because of a daylight saving time change.
+// NDB then shifts the window on those days.
+func maintenanceWindowStartTimeWarnings(d *schema.ResourceData) diag.Diagnostics {
+	start, err := parseMaintenanceWindowStartTime(d.Get("start_time").(string))
+	if err != nil {
+		return nil
+	}
+	loc, err := time.LoadLocation(d.Get("timezone").(string))
+	if err != nil {
+		return nil
+	}
+
+	const daysInYear = 366
+	now := time.Now().In(loc)
+	for i := 0; i < daysInYear; i++ {
+		day := now.AddDate(0, 0, i)
+		t := time.Date(day.Year(), day.Month(), day.Day(), start.Hour(), start.Minute(), start.Second(), 0, loc)
+		if t.Hour() != start.Hour() || t.Minute() != start.Minute() {
+			return diag.Diagnostics{{
+				Severity: diag.Warning,
+				Summary:  "maintenance window start_time skipped by a daylight saving time change",
+				Detail: fmt.Sprintf("start_time %s does not exist in %s on %s, the maintenance window will not start at that time on this day",
+					start.Format(maintenanceWindowStartTimeLayouts[0]), loc, t.Format("2006-01-02")),
+			}}
+		}
+	}
+	return nil
+}
@@ -2,6 +2,7 @@ package ndb_test
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -32,6 +33,35 @@ func TestAccEra_MaintenanceWindow(t *testing.T) {
 	})
 }
 
+func TestAccEra_MaintenanceWindowWithTimezone(t *testing.T) {
+	r := acc.RandIntBetween(10, 20)
+	name := fmt.Sprintf("test-maintenance-%d", r)
+	desc := "this is desc"
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccEraPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccEraMaintenanceWindowWithTimezone(name, desc, "Mars/Olympus", "17:04"),
+				ExpectError: regexp.MustCompile("must be an IANA timezone"),
+			},
+			{
+				Config:      testAccEraMaintenanceWindowWithTimezone(name, desc, "UTC", "25:04"),
+				ExpectError: regexp.MustCompile("must be a wall-clock time"),
+			},
+			// start_time is normalized to HH:MM:SS
+			{
+				Config: testAccEraMaintenanceWindowWithTimezone(name, desc, "UTC", "7:04"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceMaintenaceWindowName, "name", name),
+					resource.TestCheckResourceAttr(resourceMaintenaceWindowName, "timezone", "UTC"),
+					resource.TestCheckResourceAttr(resourceMaintenaceWindowName, "start_time", "07:04:00"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccEra_MaintenanceWindowUpdate(t *testing.T) {
 	r := acc.RandIntBetween(21, 30)
 	name := fmt.Sprintf("test-maintenance-%d", r)
@@ -128,3 +158,17 @@ func testAccEraMaintenanceWindowByMonthlyRecurrence(name, desc string) string {
 		}
 	`, name, desc)
 }
+
+func testAccEraMaintenanceWindowWithTimezone(name, desc, timezone, startTime string) string {
+	return fmt.Sprintf(`
+		resource nutanix_ndb_maintenance_window acctest-managed{
+			name = "%[1]s"
+			description = "%[2]s"
+			recurrence = "WEEKLY"
+			duration = 2
+			day_of_week = "TUESDAY"
+			timezone = "%[3]s"
+			start_time = "%[4]s"
+		}
+	`, name, desc, timezone, startTime)
+}
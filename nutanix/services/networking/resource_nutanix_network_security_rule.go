@@ -17,7 +17,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-nutanix/utils"
 )
 
-var (
+const (
 	netDelay      = 10 * time.Second
 	netMinTimeout = 3 * time.Second
 )
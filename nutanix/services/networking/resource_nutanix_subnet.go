@@ -17,7 +17,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-nutanix/utils"
 )
 
-var (
+const (
 	subnetDelay      = 10 * time.Second
 	subnetMinTimeout = 3 * time.Second
 )
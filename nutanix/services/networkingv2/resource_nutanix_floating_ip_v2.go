@@ -234,8 +234,8 @@ func ResourceNutanixFloatingIPv2Create(ctx context.Context, d *schema.ResourceDa
 	taskconn := meta.(*conns.Client).PrismAPI
 	// Wait for the Routing Policy to be available
 	stateConf := &resource.StateChangeConf{
-		Pending: []string{"QUEUED", "RUNNING"},
-		Target:  []string{"SUCCEEDED"},
+		Pending: utils.TaskPendingStates,
+		Target:  utils.TaskTargetStates,
 		Refresh: taskStateRefreshPrismTaskGroupFunc(ctx, taskconn, utils.StringValue(taskUUID)),
 		Timeout: d.Timeout(schema.TimeoutCreate),
 	}
@@ -244,7 +244,7 @@ func ResourceNutanixFloatingIPv2Create(ctx context.Context, d *schema.ResourceDa
 		return diag.Errorf("error waiting for floating IP (%s) to create: %s", utils.StringValue(taskUUID), errWaitTask)
 	}
 
-	filter := fmt.Sprintf("name eq  '%s'", fipName)
+	filter := fmt.Sprintf("name eq %s", utils.ODataString(fipName))
 	readResp, err := conn.FloatingIPAPIInstance.ListFloatingIps(nil, nil, &filter, nil, nil, nil)
 	if err != nil {
 		return diag.Errorf("error while fetching fips : %v", err)
@@ -261,6 +261,11 @@ func ResourceNutanixFloatingIPv2Read(ctx context.Context, d *schema.ResourceData
 
 	resp, err := conn.FloatingIPAPIInstance.GetFloatingIpById(utils.StringPtr(d.Id()))
 	if err != nil {
+		if utils.IsV4NotFound(err) {
+			log.Printf("[DEBUG] floating ip %s not found, removing it from state", d.Id())
+			d.SetId("")
+			return nil
+		}
 		return diag.Errorf("error while fetching floating ips : %v", err)
 	}
 
@@ -387,8 +392,8 @@ func ResourceNutanixFloatingIPv2Update(ctx context.Context, d *schema.ResourceDa
 	taskconn := meta.(*conns.Client).PrismAPI
 	// Wait for the Floating IP to be available
 	stateConf := &resource.StateChangeConf{
-		Pending: []string{"QUEUED", "RUNNING"},
-		Target:  []string{"SUCCEEDED"},
+		Pending: utils.TaskPendingStates,
+		Target:  utils.TaskTargetStates,
 		Refresh: taskStateRefreshPrismTaskGroupFunc(ctx, taskconn, utils.StringValue(taskUUID)),
 		Timeout: d.Timeout(schema.TimeoutCreate),
 	}
@@ -414,8 +419,8 @@ func ResourceNutanixFloatingIPv2Delete(ctx context.Context, d *schema.ResourceDa
 	taskconn := meta.(*conns.Client).PrismAPI
 	// Wait for the Floating IP to be available
 	stateConf := &resource.StateChangeConf{
-		Pending: []string{"QUEUED", "RUNNING"},
-		Target:  []string{"SUCCEEDED"},
+		Pending: utils.TaskPendingStates,
+		Target:  utils.TaskTargetStates,
 		Refresh: taskStateRefreshPrismTaskGroupFunc(ctx, taskconn, utils.StringValue(taskUUID)),
 		Timeout: d.Timeout(schema.TimeoutCreate),
 	}
@@ -608,45 +613,27 @@ func expandVMNic(pr interface{}) *import1.VmNic {
 }
 
 func taskStateRefreshPrismTaskGroupFunc(ctx context.Context, client *prism.Client, taskUUID string) resource.StateRefreshFunc {
+	poll := &utils.TaskStatusPoll{}
 	return func() (interface{}, string, error) {
-		vresp, err := client.TaskRefAPI.GetTaskById(utils.StringPtr(taskUUID), nil)
+		// batched with the other running tasks when task_poll_batch_interval is set
+		v, err := client.GetTask(ctx, taskUUID)
 		if err != nil {
-			return "", "", (fmt.Errorf("error while polling prism task: %v", err))
+			return "", "", &utils.TaskPollError{TaskExtID: taskUUID, Err: err}
 		}
 
-		// get the group results
-
-		v := vresp.Data.GetValue().(import2.Task)
+		status, err := poll.Status(taskUUID, v.Status)
+		utils.LogTaskPoll(client.LogFormat, v, status)
+		if err != nil {
+			return v, status, err
+		}
 
-		if getTaskStatus(v.Status) == "CANCELED" || getTaskStatus(v.Status) == "FAILED" {
-			return v, getTaskStatus(v.Status),
-				fmt.Errorf("error_detail: %s, progress_message: %d", utils.StringValue(v.ErrorMessages[0].Message), utils.IntValue(v.ProgressPercentage))
+		if utils.IsTaskFailed(status) {
+			return v, status, utils.NewTaskFailedError(taskUUID, status, v)
 		}
-		return v, getTaskStatus(v.Status), nil
+		return v, status, nil
 	}
 }
 
 func getTaskStatus(pr *import2.TaskStatus) string {
-	if pr != nil {
-		const two, three, four, five, six, seven = 2, 3, 4, 5, 6, 7
-		if *pr == import2.TaskStatus(two) {
-			return "QUEUED"
-		}
-		if *pr == import2.TaskStatus(three) {
-			return "RUNNING"
-		}
-		if *pr == import2.TaskStatus(four) {
-			return "CANCELING"
-		}
-		if *pr == import2.TaskStatus(five) {
-			return "SUCCEEDED"
-		}
-		if *pr == import2.TaskStatus(six) {
-			return "FAILED"
-		}
-		if *pr == import2.TaskStatus(seven) {
-			return "CANCELED"
-		}
-	}
-	return "UNKNOWN"
+	return utils.TaskStatusName(pr)
 }
@@ -3,6 +3,7 @@ package networkingv2
 import (
 	"context"
 	"fmt"
+	"log"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -416,8 +417,8 @@ func ResourceNutanixPbrsV2Create(ctx context.Context, d *schema.ResourceData, me
 	taskconn := meta.(*conns.Client).PrismAPI
 	// Wait for the Routing Policy to be available
 	stateConf := &resource.StateChangeConf{
-		Pending: []string{"QUEUED", "RUNNING"},
-		Target:  []string{"SUCCEEDED"},
+		Pending: utils.TaskPendingStates,
+		Target:  utils.TaskTargetStates,
 		Refresh: taskStateRefreshPrismTaskGroupFunc(ctx, taskconn, utils.StringValue(taskUUID)),
 		Timeout: d.Timeout(schema.TimeoutCreate),
 	}
@@ -427,7 +428,7 @@ func ResourceNutanixPbrsV2Create(ctx context.Context, d *schema.ResourceData, me
 	}
 
 	// Get UUID from List Routing Policy API as Currently task entities does not return uuid
-	filter := fmt.Sprintf("vpcExtId eq  '%s'", vpcRef)
+	filter := fmt.Sprintf("vpcExtId eq %s", utils.ODataString(vpcRef))
 	readResp, err := conn.RoutingPolicy.ListRoutingPolicies(nil, nil, &filter, nil, nil, nil)
 	if err != nil {
 		return diag.Errorf("error while fetching routing policies : %v", err)
@@ -448,6 +449,11 @@ func ResourceNutanixPbrsV2Read(ctx context.Context, d *schema.ResourceData, meta
 
 	resp, err := conn.RoutingPolicy.GetRoutingPolicyById(utils.StringPtr(d.Id()))
 	if err != nil {
+		if utils.IsV4NotFound(err) {
+			log.Printf("[DEBUG] routing policy %s not found, removing it from state", d.Id())
+			d.SetId("")
+			return nil
+		}
 		return diag.Errorf("error while fetching routing policy : %v", err)
 	}
 
@@ -524,8 +530,8 @@ func ResourceNutanixPbrsV2Update(ctx context.Context, d *schema.ResourceData, me
 	taskconn := meta.(*conns.Client).PrismAPI
 	// Wait for the Routing Policy to be available
 	stateConf := &resource.StateChangeConf{
-		Pending: []string{"QUEUED", "RUNNING"},
-		Target:  []string{"SUCCEEDED"},
+		Pending: utils.TaskPendingStates,
+		Target:  utils.TaskTargetStates,
 		Refresh: taskStateRefreshPrismTaskGroupFunc(ctx, taskconn, utils.StringValue(taskUUID)),
 		Timeout: d.Timeout(schema.TimeoutCreate),
 	}
@@ -551,8 +557,8 @@ func ResourceNutanixPbrsV2Delete(ctx context.Context, d *schema.ResourceData, me
 	taskconn := meta.(*conns.Client).PrismAPI
 	// Wait for the Subnet to be available
 	stateConf := &resource.StateChangeConf{
-		Pending: []string{"QUEUED", "RUNNING"},
-		Target:  []string{"SUCCEEDED"},
+		Pending: utils.TaskPendingStates,
+		Target:  utils.TaskTargetStates,
 		Refresh: taskStateRefreshPrismTaskGroupFunc(ctx, taskconn, utils.StringValue(taskUUID)),
 		Timeout: d.Timeout(schema.TimeoutCreate),
 	}
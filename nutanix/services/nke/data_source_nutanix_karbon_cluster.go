@@ -21,7 +21,6 @@ func DataSourceNutanixKarbonCluster() *schema.Resource {
 func dataSourceNutanixKarbonClusterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	// Get client connection
 	conn := meta.(*conns.Client).KarbonAPI
-	setTimeout(meta)
 	// Make request to the API
 	karbonClusterID, iok := d.GetOk("karbon_cluster_id")
 	karbonClusterNameInput, nok := d.GetOk("karbon_cluster_name")
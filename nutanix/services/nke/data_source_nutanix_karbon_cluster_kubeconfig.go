@@ -50,7 +50,6 @@ func DataSourceNutanixKarbonClusterKubeconfig() *schema.Resource {
 func dataSourceNutanixKarbonClusterKubeconfigRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	// Get client connection
 	conn := meta.(*conns.Client).KarbonAPI
-	setTimeout(meta)
 	// Make request to the API
 	karbonClusterID, iok := d.GetOk("karbon_cluster_id")
 	karbonClusterName, nok := d.GetOk("karbon_cluster_name")
@@ -29,7 +29,6 @@ func DataSourceNutanixKarbonClusters() *schema.Resource {
 func dataSourceNutanixKarbonClustersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	// Get client connection
 	conn := meta.(*conns.Client).KarbonAPI
-	setTimeout(meta)
 	// Make request to the API
 	resp, err := conn.Cluster.ListKarbonClusters()
 	if err != nil {
@@ -22,7 +22,6 @@ func DataSourceNutanixKarbonPrivateRegistry() *schema.Resource {
 func dataSourceNutanixKarbonPrivateRegistryRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	// Get client connection
 	conn := meta.(*conns.Client).KarbonAPI
-	setTimeout(meta)
 	// Make request to the API
 	karbonPrivateRegistryID, iok := d.GetOk("private_registry_id")
 	karbonPrivateRegistryName, nok := d.GetOk("private_registry_name")
@@ -55,10 +55,6 @@ const (
 	WAITING                   = "WAITING"
 )
 
-var (
-	vmTimeout = 1 * time.Minute
-)
-
 // Known issues:
 //  - Importing karbon clusters do not contain cni_configs and storage_class_configs
 //  - Importing karbon clusters show an incorrect version
@@ -412,7 +408,6 @@ func resourceNutanixKarbonClusterCreate(ctx context.Context, d *schema.ResourceD
 	// Get client connection
 	client := meta.(*conns.Client)
 	conn := client.KarbonAPI
-	setTimeout(meta)
 	// Node pools
 	var err error
 	karbonVersion, err := conn.Meta.GetSemanticVersion()
@@ -550,7 +545,6 @@ func resourceNutanixKarbonClusterRead(ctx context.Context, d *schema.ResourceDat
 	log.Print("[Debug] Entering resourceNutanixKarbonClusterRead")
 	// Get client connection
 	conn := meta.(*conns.Client).KarbonAPI
-	setTimeout(meta)
 	// Make request to the API
 	var err error
 	resp, err := conn.Cluster.GetKarbonCluster(d.Id())
@@ -625,7 +619,6 @@ func resourceNutanixKarbonClusterUpdate(ctx context.Context, d *schema.ResourceD
 	// Get client connection
 	client := meta.(*conns.Client)
 	conn := client.KarbonAPI
-	setTimeout(meta)
 
 	// Make request to the API
 	resp, err := conn.Cluster.GetKarbonCluster(d.Id())
@@ -689,7 +682,6 @@ func resourceNutanixKarbonClusterDelete(ctx context.Context, d *schema.ResourceD
 	log.Print("[Debug] Entering resourceNutanixKarbonClusterDelete")
 	client := meta.(*conns.Client)
 	conn := client.KarbonAPI
-	setTimeout(meta)
 	timeout, timeoutErr := getTimeout(d)
 	if timeoutErr != nil {
 		return diag.FromErr(timeoutErr)
@@ -715,7 +707,6 @@ func resourceNutanixKarbonClusterDelete(ctx context.Context, d *schema.ResourceD
 func resourceNutanixKarbonClusterExists(d *schema.ResourceData, meta interface{}) (bool, error) {
 	log.Print("[DEBUG] Entering resourceNutanixKarbonClusterExists")
 	conn := meta.(*conns.Client).KarbonAPI
-	setTimeout(meta)
 	karbonClusterName, ok := d.GetOk("name")
 	var exists bool
 	var err error
@@ -1019,13 +1010,6 @@ func WaitForKarbonCluster(ctx context.Context, client *conns.Client, waitTimeout
 	return nil
 }
 
-func setTimeout(meta interface{}) {
-	client := meta.(*conns.Client)
-	if client.WaitTimeout != 0 {
-		vmTimeout = time.Duration(client.WaitTimeout) * time.Minute
-	}
-}
-
 func expandStorageClassConfig(storageClassConfigsInput []interface{}) (*karbon.ClusterStorageClassConfigIntentInput, error) {
 	if len(storageClassConfigsInput) != 1 {
 		return nil, fmt.Errorf("more than one storage class input passed")
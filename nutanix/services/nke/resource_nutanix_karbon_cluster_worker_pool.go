@@ -187,7 +187,6 @@ func resourceNutanixKarbonWorkerNodePoolCreate(ctx context.Context, d *schema.Re
 
 func resourceNutanixKarbonWorkerNodePoolRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.Client).KarbonAPI
-	setTimeout(meta)
 	// Make request to the API
 	var err error
 	karbonClsName := d.Get("cluster_name")
@@ -72,7 +72,6 @@ func resourceNutanixKarbonPrivateRegistryCreate(ctx context.Context, d *schema.R
 	// Get client connection
 	client := meta.(*conns.Client)
 	conn := client.KarbonAPI
-	setTimeout(meta)
 	// Prepare request
 	karbonPrivateRegistry := &karbon.PrivateRegistryIntentInput{}
 	if name, ok := d.GetOk("name"); ok {
@@ -118,7 +117,6 @@ func resourceNutanixKarbonPrivateRegistryRead(ctx context.Context, d *schema.Res
 	log.Print("[Debug] Entering resourceNutanixKarbonPrivateRegistryRead")
 	// Get client connection
 	conn := meta.(*conns.Client).KarbonAPI
-	setTimeout(meta)
 	// Make request to the API
 	var name interface{}
 	var ok bool
@@ -149,7 +147,6 @@ func resourceNutanixKarbonPrivateRegistryDelete(ctx context.Context, d *schema.R
 	log.Print("[Debug] Entering resourceNutanixKarbonPrivateRegistryDelete")
 	client := meta.(*conns.Client)
 	conn := client.KarbonAPI
-	setTimeout(meta)
 	karbonPrivateRegistryName := d.Get("name").(string)
 
 	_, err := conn.PrivateRegistry.DeleteKarbonPrivateRegistry(karbonPrivateRegistryName)
@@ -163,7 +160,6 @@ func resourceNutanixKarbonPrivateRegistryDelete(ctx context.Context, d *schema.R
 func resourceNutanixKarbonPrivateRegistryExists(d *schema.ResourceData, meta interface{}) (bool, error) {
 	log.Print("[DEBUG] Entering resourceNutanixKarbonPrivateRegistryExists")
 	conn := meta.(*conns.Client).KarbonAPI
-	setTimeout(meta)
 	// Make request to the API
 	var name interface{}
 	var ok bool
@@ -18,7 +18,7 @@ const (
 	ERROR = "ERROR"
 )
 
-var (
+const (
 	subnetDelay      = 10 * time.Second
 	subnetMinTimeout = 3 * time.Second
 	vmDelay          = 3 * time.Second
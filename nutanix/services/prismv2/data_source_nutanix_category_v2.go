@@ -2,6 +2,7 @@ package prismv2
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -16,20 +17,27 @@ func DatasourceNutanixCategoryV2() *schema.Resource {
 		ReadContext: DatasourceNutanixCategoryV2Read,
 		Schema: map[string]*schema.Schema{
 			"ext_id": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ExactlyOneOf:  []string{"ext_id", "key"},
+				ConflictsWith: []string{"value"},
 			},
 			"expand": {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
 			"key": {
-				Type:     schema.TypeString,
-				Computed: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				RequiredWith: []string{"value"},
 			},
 			"value": {
-				Type:     schema.TypeString,
-				Computed: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				RequiredWith: []string{"key"},
 			},
 			"type": {
 				Type:     schema.TypeString,
@@ -118,14 +126,37 @@ func DatasourceNutanixCategoryV2() *schema.Resource {
 func DatasourceNutanixCategoryV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.Client).PrismAPI
 
-	extID := d.Get("ext_id")
+	extID := d.Get("ext_id").(string)
 	var expand *string
 	if expandf, ok := d.GetOk("expand"); ok {
 		expand = utils.StringPtr(expandf.(string))
 	} else {
 		expand = nil
 	}
-	resp, err := conn.CategoriesAPIInstance.GetCategoryById(utils.StringPtr(extID.(string)), expand)
+
+	// resolve the ext_id of the category from its key and value
+	if extID == "" {
+		key := d.Get("key").(string)
+		value := d.Get("value").(string)
+		filter := fmt.Sprintf("key eq %s and value eq %s", utils.ODataString(key), utils.ODataString(value))
+		listResp, err := conn.CategoriesAPIInstance.ListCategories(nil, nil, utils.StringPtr(filter), nil, nil, nil)
+		if err != nil {
+			return diag.Errorf("error while fetching category %s/%s : %v", key, value, err)
+		}
+		var categories []import1.Category
+		if listResp.Data != nil {
+			categories, _ = listResp.Data.GetValue().([]import1.Category)
+		}
+		if len(categories) == 0 {
+			return diag.Errorf("no category found with key %s and value %s", key, value)
+		}
+		if len(categories) > 1 {
+			return diag.Errorf("found %d categories with key %s and value %s, use ext_id instead", len(categories), key, value)
+		}
+		extID = utils.StringValue(categories[0].ExtId)
+	}
+
+	resp, err := conn.CategoriesAPIInstance.GetCategoryById(utils.StringPtr(extID), expand)
 	if err != nil {
 		return diag.Errorf("error while fetching category : %v", err)
 	}
@@ -160,6 +191,10 @@ func DatasourceNutanixCategoryV2Read(ctx context.Context, d *schema.ResourceData
 		return diag.FromErr(err)
 	}
 
+	if err := d.Set("ext_id", getResp.ExtId); err != nil {
+		return diag.FromErr(err)
+	}
+
 	d.SetId(*getResp.ExtId)
 	return nil
 }
@@ -1,6 +1,7 @@
 package prismv2_test
 
 import (
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -29,6 +30,52 @@ func TestAccV2NutanixCategoryDataSource_basic(t *testing.T) {
 	})
 }
 
+func TestAccV2NutanixCategoryDataSource_KeyValue(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCategoryDataSourceKeyValueConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(datasourceNameCategory, "ext_id", "data.nutanix_categories_v2.dtest", "categories.0.ext_id"),
+					resource.TestCheckResourceAttrPair(datasourceNameCategory, "key", "data.nutanix_categories_v2.dtest", "categories.0.key"),
+					resource.TestCheckResourceAttrPair(datasourceNameCategory, "value", "data.nutanix_categories_v2.dtest", "categories.0.value"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccV2NutanixCategoryDataSource_KeyValueNotFound(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					data "nutanix_category_v2" "test" {
+						key   = "tf-test-missing-key"
+						value = "tf-test-missing-value"
+					}
+				`,
+				ExpectError: regexp.MustCompile("no category found with key tf-test-missing-key and value tf-test-missing-value"),
+			},
+		},
+	})
+}
+
+func testAccCategoryDataSourceKeyValueConfig() string {
+	return `
+		data "nutanix_categories_v2" "dtest" { }
+
+		data "nutanix_category_v2" "test" {
+			key   = data.nutanix_categories_v2.dtest.categories.0.key
+			value = data.nutanix_categories_v2.dtest.categories.0.value
+		}
+	`
+}
+
 func testAccCategoryDataSourceConfig() string {
 	return `
 		data "nutanix_categories_v2" "dtest" { }
@@ -142,6 +142,11 @@ func ResourceNutanixCategoriesV2Read(ctx context.Context, d *schema.ResourceData
 
 	resp, err := conn.CategoriesAPIInstance.GetCategoryById(utils.StringPtr(d.Id()), nil)
 	if err != nil {
+		if utils.IsV4NotFound(err) {
+			log.Printf("[DEBUG] category %s not found, removing it from state", d.Id())
+			d.SetId("")
+			return nil
+		}
 		return diag.Errorf("error while fetching category : %v", err)
 	}
 
@@ -2,11 +2,14 @@ package storagecontainersv2
 
 import (
 	"context"
+	"fmt"
+	"log"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	clustermgmt "github.com/nutanix/ntnx-api-golang-clients/clustermgmt-go-client/v4/models/clustermgmt/v4/config"
 	conns "github.com/terraform-providers/terraform-provider-nutanix/nutanix"
+	"github.com/terraform-providers/terraform-provider-nutanix/nutanix/sdks/v4/clusters"
 	"github.com/terraform-providers/terraform-provider-nutanix/utils"
 )
 
@@ -149,6 +152,12 @@ func DatasourceNutanixStorageContainerV2() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"nfs_mount_path": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"datastores":            SchemaForDataStores(),
+			"fault_tolerance_state": SchemaForFaultToleranceState(),
 		},
 	}
 }
@@ -251,11 +260,217 @@ func DatasourceNutanixStorageContainerV2Read(ctx context.Context, d *schema.Reso
 	if err := d.Set("cluster_name", getResp.ClusterName); err != nil {
 		return diag.FromErr(err)
 	}
+	if err := d.Set("nfs_mount_path", storageContainerNfsMountPath(getResp.Name)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("datastores", flattenDataStores(listStorageContainerDataStores(conn, getResp.ClusterExtId, getResp.ContainerExtId))); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("fault_tolerance_state", flattenFaultToleranceState(getStorageContainerFaultToleranceState(conn, getResp.ClusterExtId))); err != nil {
+		return diag.FromErr(err)
+	}
 
 	d.SetId(*getResp.ContainerExtId)
 	return nil
 }
 
+// SchemaForDataStores is the schema of the NFS datastores a storage container
+// is mounted as on ESXi hosts.
+func SchemaForDataStores() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"datastore_name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"host_ext_id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"host_ip_address": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"capacity_bytes": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				"free_space_bytes": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				"vm_names": {
+					Type:     schema.TypeList,
+					Computed: true,
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+				},
+			},
+		},
+	}
+}
+
+// SchemaForFaultToleranceState is the fault tolerance state of the cluster
+// owning a storage container, the replication factor and erasure coding
+// settings of the container are only as resilient as the cluster.
+func SchemaForFaultToleranceState() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"current_max_fault_tolerance": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				"desired_max_fault_tolerance": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				"current_cluster_fault_tolerance": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"desired_cluster_fault_tolerance": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"domain_awareness_level": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+// getStorageContainerFaultToleranceState returns the fault tolerance state of
+// the cluster owning the storage container. Errors are logged and treated as
+// an unknown state so that reading the container itself does not fail.
+func getStorageContainerFaultToleranceState(conn *clusters.Client, clusterExtID *string) *clustermgmt.FaultToleranceState {
+	if clusterExtID == nil {
+		return nil
+	}
+	resp, err := conn.ClusterEntityAPI.GetClusterById(clusterExtID, nil)
+	if err != nil {
+		log.Printf("[DEBUG] unable to fetch the fault tolerance state of cluster %s: %v", *clusterExtID, err)
+		return nil
+	}
+	if resp.Data == nil {
+		return nil
+	}
+	cluster, ok := resp.Data.GetValue().(clustermgmt.Cluster)
+	if !ok || cluster.Config == nil {
+		return nil
+	}
+	return cluster.Config.FaultToleranceState
+}
+
+func flattenFaultToleranceState(faultToleranceState *clustermgmt.FaultToleranceState) []map[string]interface{} {
+	if faultToleranceState == nil {
+		return nil
+	}
+	return []map[string]interface{}{
+		{
+			"current_max_fault_tolerance":     faultToleranceState.CurrentMaxFaultTolerance,
+			"desired_max_fault_tolerance":     faultToleranceState.DesiredMaxFaultTolerance,
+			"current_cluster_fault_tolerance": flattenClusterFaultTolerance(faultToleranceState.CurrentClusterFaultTolerance),
+			"desired_cluster_fault_tolerance": flattenClusterFaultTolerance(faultToleranceState.DesiredClusterFaultTolerance),
+			"domain_awareness_level":          flattenDomainAwarenessLevel(faultToleranceState.DomainAwarenessLevel),
+		},
+	}
+}
+
+func flattenClusterFaultTolerance(faultTolerance *clustermgmt.ClusterFaultToleranceRef) string {
+	if faultTolerance != nil {
+		const two, three, four, five = 2, 3, 4, 5
+
+		switch *faultTolerance {
+		case two:
+			return "CFT_0N_AND_0D"
+		case three:
+			return "CFT_1N_OR_1D"
+		case four:
+			return "CFT_2N_OR_2D"
+		case five:
+			return "CFT_1N_AND_1D"
+		}
+	}
+	return "UNKNOWN"
+}
+
+func flattenDomainAwarenessLevel(domainAwarenessLevel *clustermgmt.DomainAwarenessLevel) string {
+	if domainAwarenessLevel != nil {
+		const two, three, four, five = 2, 3, 4, 5
+
+		switch *domainAwarenessLevel {
+		case two:
+			return "NODE"
+		case three:
+			return "BLOCK"
+		case four:
+			return "RACK"
+		case five:
+			return "DISK"
+		}
+	}
+	return "UNKNOWN"
+}
+
+// storageContainerNfsMountPath returns the path the container is exported
+// as over NFS, ie. the path to mount it as a datastore.
+func storageContainerNfsMountPath(name *string) string {
+	if name == nil {
+		return ""
+	}
+	return fmt.Sprintf("/%s", *name)
+}
+
+// listStorageContainerDataStores lists the datastores backed by the storage
+// container. Only ESXi clusters have datastores, errors are logged and treated
+// as no datastores so that reading the container itself does not fail.
+func listStorageContainerDataStores(conn *clusters.Client, clusterExtID, containerExtID *string) []clustermgmt.DataStore {
+	if clusterExtID == nil || containerExtID == nil {
+		return nil
+	}
+	filter := fmt.Sprintf("containerExtId eq %s", utils.ODataString(*containerExtID))
+	resp, err := conn.StorageContainersAPI.ListDataStoresByClusterId(clusterExtID, nil, nil, utils.StringPtr(filter))
+	if err != nil {
+		log.Printf("[DEBUG] unable to list datastores of storage container %s: %v", *containerExtID, err)
+		return nil
+	}
+	if resp.Data == nil {
+		return nil
+	}
+	dataStores, ok := resp.Data.GetValue().([]clustermgmt.DataStore)
+	if !ok {
+		return nil
+	}
+	return dataStores
+}
+
+func flattenDataStores(dataStores []clustermgmt.DataStore) []map[string]interface{} {
+	if len(dataStores) == 0 {
+		return nil
+	}
+	result := make([]map[string]interface{}, len(dataStores))
+	for k, v := range dataStores {
+		result[k] = map[string]interface{}{
+			"datastore_name":   v.DatastoreName,
+			"host_ext_id":      v.HostExtId,
+			"host_ip_address":  v.HostIpAddress,
+			"capacity_bytes":   v.CapacityBytes,
+			"free_space_bytes": v.FreeSpaceBytes,
+			"vm_names":         v.VmNames,
+		}
+	}
+	return result
+}
+
 func SchemaForFqdnValue() *schema.Schema {
 	return &schema.Schema{
 		Type:     schema.TypeList,
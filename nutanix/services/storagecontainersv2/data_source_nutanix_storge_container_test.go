@@ -28,6 +28,7 @@ func TestAccV2NutanixStorageContainerDataSource_Basic(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttrSet(datasourceNameStorageContainer, "container_ext_id"),
 					resource.TestCheckResourceAttr(datasourceNameStorageContainer, "name", name),
+					resource.TestCheckResourceAttr(datasourceNameStorageContainer, "nfs_mount_path", "/"+name),
 					resource.TestCheckResourceAttr(datasourceNameStorageContainer, "logical_advertised_capacity_bytes", strconv.Itoa(testVars.StorageContainer.LogicalAdvertisedCapacityBytes)),
 					resource.TestCheckResourceAttr(datasourceNameStorageContainer, "logical_explicit_reserved_capacity_bytes", strconv.Itoa(testVars.StorageContainer.LogicalExplicitReservedCapacityBytes)),
 					resource.TestCheckResourceAttr(datasourceNameStorageContainer, "replication_factor", strconv.Itoa(testVars.StorageContainer.ReplicationFactor)),
@@ -29,6 +29,10 @@ func DatasourceNutanixStorageContainersV2() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"tenant_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
 			"order_by": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -72,6 +76,9 @@ func DatasourceNutanixStorageContainersV2Read(ctx context.Context, d *schema.Res
 	} else {
 		filter = nil
 	}
+	if tenantID, ok := d.GetOk("tenant_id"); ok {
+		filter = utils.AndFilter(filter, utils.TenantFilter(tenantID.(string)))
+	}
 	if order, ok := d.GetOk("order_by"); ok {
 		orderBy = utils.StringPtr(order.(string))
 	} else {
@@ -264,7 +271,7 @@ func flattenIPv6Address(pr *clsConfig.IPv6Address) []interface{} {
 
 		ip := make(map[string]interface{})
 
-		ip["value"] = pr.Value
+		ip["value"] = normalizeIPAddress(utils.StringValue(pr.Value))
 		ip["prefix_length"] = pr.PrefixLength
 
 		ipv6 = append(ipv6, ip)
@@ -6,10 +6,14 @@ import (
 	"regexp"
 	"strconv"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	conns "github.com/terraform-providers/terraform-provider-nutanix/nutanix"
 	acc "github.com/terraform-providers/terraform-provider-nutanix/nutanix/acctest"
+	"github.com/terraform-providers/terraform-provider-nutanix/utils"
 )
 
 const resourceNameStorageContainers = "nutanix_storage_containers_v2.test"
@@ -28,12 +32,17 @@ func TestAccV2NutanixStorageContainersResource_Basic(t *testing.T) {
 				Config: testStorageContainersResourceConfig(filepath, name),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttrSet(resourceNameStorageContainers, "container_ext_id"),
+					resource.TestCheckResourceAttrSet(resourceNameStorageContainers, "last_task_ext_id"),
 					resource.TestCheckResourceAttr(resourceNameStorageContainers, "name", name),
+					resource.TestCheckResourceAttr(resourceNameStorageContainers, "nfs_mount_path", "/"+name),
 					resource.TestCheckResourceAttr(resourceNameStorageContainers, "logical_advertised_capacity_bytes", strconv.Itoa(testVars.StorageContainer.LogicalAdvertisedCapacityBytes)),
 					resource.TestCheckResourceAttr(resourceNameStorageContainers, "logical_explicit_reserved_capacity_bytes", strconv.Itoa(testVars.StorageContainer.LogicalExplicitReservedCapacityBytes)),
 					resource.TestCheckResourceAttr(resourceNameStorageContainers, "replication_factor", strconv.Itoa(testVars.StorageContainer.ReplicationFactor)),
-					resource.TestCheckResourceAttr(resourceNameStorageContainers, "nfs_whitelist_addresses.0.ipv4.0.value", testVars.StorageContainer.NfsWhitelistAddresses.Ipv4.Value),
-					resource.TestCheckResourceAttr(resourceNameStorageContainers, "nfs_whitelist_addresses.0.ipv4.0.prefix_length", strconv.Itoa(testVars.StorageContainer.NfsWhitelistAddresses.Ipv4.PrefixLength)),
+					resource.TestCheckResourceAttr(resourceNameStorageContainers, "nfs_whitelist_addresses.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceNameStorageContainers, "nfs_whitelist_addresses.*", map[string]string{
+						"ipv4.0.value":         testVars.StorageContainer.NfsWhitelistAddresses.Ipv4.Value,
+						"ipv4.0.prefix_length": strconv.Itoa(testVars.StorageContainer.NfsWhitelistAddresses.Ipv4.PrefixLength),
+					}),
 				),
 			},
 			// test update
@@ -45,14 +54,140 @@ func TestAccV2NutanixStorageContainersResource_Basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceNameStorageContainers, "logical_advertised_capacity_bytes", strconv.Itoa(testVars.StorageContainer.LogicalAdvertisedCapacityBytes)),
 					resource.TestCheckResourceAttr(resourceNameStorageContainers, "logical_explicit_reserved_capacity_bytes", strconv.Itoa(testVars.StorageContainer.LogicalExplicitReservedCapacityBytes)),
 					resource.TestCheckResourceAttr(resourceNameStorageContainers, "replication_factor", strconv.Itoa(testVars.StorageContainer.ReplicationFactor)),
-					resource.TestCheckResourceAttr(resourceNameStorageContainers, "nfs_whitelist_addresses.0.ipv4.0.value", "192.168.15.0"),
-					resource.TestCheckResourceAttr(resourceNameStorageContainers, "nfs_whitelist_addresses.0.ipv4.0.prefix_length", strconv.Itoa(testVars.StorageContainer.NfsWhitelistAddresses.Ipv4.PrefixLength)),
+					resource.TestCheckResourceAttr(resourceNameStorageContainers, "nfs_whitelist_addresses.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceNameStorageContainers, "nfs_whitelist_addresses.*", map[string]string{
+						"ipv4.0.value":         testVars.StorageContainer.NfsWhitelistAddresses.Ipv4.Value,
+						"ipv4.0.prefix_length": strconv.Itoa(testVars.StorageContainer.NfsWhitelistAddresses.Ipv4.PrefixLength),
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceNameStorageContainers, "nfs_whitelist_addresses.*", map[string]string{
+						"ipv4.0.value":         "192.168.15.0",
+						"ipv4.0.prefix_length": "24",
+					}),
 				),
 			},
 		},
 	})
 }
 
+func TestAccV2NutanixStorageContainersResource_WithIPv6Whitelist(t *testing.T) {
+	r := acctest.RandInt()
+	name := fmt.Sprintf("terraform-test-storage-container-%d", r)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccFoundationPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testStorageContainersResourceIPv6WhitelistConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceNameStorageContainers, "name", name),
+					resource.TestCheckResourceAttr(resourceNameStorageContainers, "nfs_whitelist_addresses.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceNameStorageContainers, "nfs_whitelist_addresses.*", map[string]string{
+						"ipv4.0.value":         "192.168.15.0",
+						"ipv4.0.prefix_length": "24",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceNameStorageContainers, "nfs_whitelist_addresses.*", map[string]string{
+						"ipv6.0.value":         "2001:db8::",
+						"ipv6.0.prefix_length": "64",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccV2NutanixStorageContainersResource_FaultTolerance(t *testing.T) {
+	r := acctest.RandInt()
+	name := fmt.Sprintf("terraform-test-storage-container-%d", r)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccFoundationPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testStorageContainersResourceFaultToleranceConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceNameStorageContainers, "has_higher_ec_fault_domain_preference", "true"),
+					resource.TestCheckResourceAttr(resourceNameStorageContainers, "fault_tolerance_state.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceNameStorageContainers, "fault_tolerance_state.0.current_max_fault_tolerance"),
+					resource.TestCheckResourceAttrSet(resourceNameStorageContainers, "fault_tolerance_state.0.desired_max_fault_tolerance"),
+					resource.TestCheckResourceAttrSet(resourceNameStorageContainers, "fault_tolerance_state.0.domain_awareness_level"),
+				),
+			},
+			// has_higher_ec_fault_domain_preference must round-trip without drift
+			{
+				Config:   testStorageContainersResourceFaultToleranceConfig(name),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccV2NutanixStorageContainersResource_Rename(t *testing.T) {
+	r := acctest.RandInt()
+	name := fmt.Sprintf("terraform-test-storage-container-%d", r)
+	newName := fmt.Sprintf("%s-renamed", name)
+	var extID string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccFoundationPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckStorageContainerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testStorageContainersResourceFaultToleranceConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceNameStorageContainers, "name", name),
+					testAccCheckStorageContainerExtID(resourceNameStorageContainers, &extID, false),
+				),
+			},
+			// renaming must update the container in place
+			{
+				Config: testStorageContainersResourceFaultToleranceConfig(newName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceNameStorageContainers, "name", newName),
+					resource.TestCheckResourceAttr(resourceNameStorageContainers, "nfs_mount_path", "/"+newName),
+					testAccCheckStorageContainerExtID(resourceNameStorageContainers, &extID, true),
+				),
+			},
+		},
+	})
+}
+
+func TestAccV2NutanixStorageContainersResource_Disappears(t *testing.T) {
+	r := acctest.RandInt()
+	name := fmt.Sprintf("terraform-test-storage-container-%d", r)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccFoundationPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testStorageContainersResourceIPv6WhitelistConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceNameStorageContainers, "name", name),
+					testAccCheckStorageContainerDisappears(resourceNameStorageContainers),
+				),
+				// the storage container deleted out of band is planned for recreation
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccV2NutanixStorageContainersResource_WithInvalidIPv6Whitelist(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testStorageContainersResourceInvalidIPv6WhitelistConfig(),
+				ExpectError: regexp.MustCompile("expected .* to contain a valid IPv6 address"),
+			},
+		},
+	})
+}
+
 func TestAccV2NutanixStorageContainersResource_WithNoClusterExtId(t *testing.T) {
 	path, _ := os.Getwd()
 	filepath := path + "/../../../test_config_v2.json"
@@ -62,7 +197,7 @@ func TestAccV2NutanixStorageContainersResource_WithNoClusterExtId(t *testing.T)
 		Steps: []resource.TestStep{
 			{
 				Config:      testStorageContainersResourceWithoutClusterExtIDConfig(filepath),
-				ExpectError: regexp.MustCompile("Missing required argument"),
+				ExpectError: regexp.MustCompile("cluster_ext_id is not set and no default_cluster_ext_id is configured"),
 			},
 		},
 	})
@@ -142,10 +277,16 @@ func testStorageContainersResourceUpdateConfig(filepath, name string) string {
 			replication_factor = local.storage_container.replication_factor
 			nfs_whitelist_addresses {
 				ipv4  {
-					value = "192.168.15.0"
+					value = local.storage_container.nfs_whitelist_addresses.ipv4.value
 					prefix_length = local.storage_container.nfs_whitelist_addresses.ipv4.prefix_length
 				}
 			}
+			nfs_whitelist_addresses {
+				ipv4  {
+					value = "192.168.15.0"
+					prefix_length = 24
+				}
+			}
 			erasure_code = "OFF"
 			is_inline_ec_enabled = false
 			has_higher_ec_fault_domain_preference = false
@@ -228,3 +369,183 @@ func testStorageContainersResourceWithoutClusterExtIDConfig(filepath string) str
 			is_software_encryption_enabled = false
 		}`, filepath)
 }
+
+func testStorageContainersResourceIPv6WhitelistConfig(name string) string {
+	return fmt.Sprintf(`
+
+		data "nutanix_clusters_v2" "clusters" {}
+
+		locals{
+			cluster = [
+				for cluster in data.nutanix_clusters_v2.clusters.cluster_entities :
+				cluster.ext_id if cluster.config[0].cluster_function[0] != "PRISM_CENTRAL"
+			][0]
+		}
+
+		resource "nutanix_storage_containers_v2" "test" {
+			name = "%[1]s"
+			cluster_ext_id = local.cluster
+			nfs_whitelist_addresses {
+				ipv4  {
+					value = "192.168.15.0"
+					prefix_length = 24
+				}
+			}
+			nfs_whitelist_addresses {
+				ipv6  {
+					value = "2001:db8::"
+					prefix_length = 64
+				}
+			}
+		}`, name)
+}
+
+func testStorageContainersResourceFaultToleranceConfig(name string) string {
+	return fmt.Sprintf(`
+
+		data "nutanix_clusters_v2" "clusters" {}
+
+		locals{
+			cluster = [
+				for cluster in data.nutanix_clusters_v2.clusters.cluster_entities :
+				cluster.ext_id if cluster.config[0].cluster_function[0] != "PRISM_CENTRAL"
+			][0]
+		}
+
+		resource "nutanix_storage_containers_v2" "test" {
+			name                                  = "%[1]s"
+			cluster_ext_id                        = local.cluster
+			has_higher_ec_fault_domain_preference = true
+		}`, name)
+}
+
+func testStorageContainersResourceInvalidIPv6WhitelistConfig() string {
+	return `
+		resource "nutanix_storage_containers_v2" "test" {
+			name = "terraform-test-invalid-whitelist"
+			cluster_ext_id = "00000000-0000-0000-0000-000000000000"
+			nfs_whitelist_addresses {
+				ipv6  {
+					value = "192.168.15.0"
+					prefix_length = 64
+				}
+			}
+		}`
+}
+
+// testAccCheckStorageContainerDisappears deletes the storage container out of band.
+func testAccCheckStorageContainerDisappears(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource %s not found", resourceName)
+		}
+		conn := acc.TestAccProvider.Meta().(*conns.Client)
+
+		if _, err := conn.ClusterAPI.StorageContainersAPI.DeleteStorageContainerById(utils.StringPtr(rs.Primary.ID), nil); err != nil {
+			return err
+		}
+		// delete is asynchronous, wait for the storage container to be gone
+		const attempts = 24
+		for i := 0; i < attempts; i++ {
+			_, err := conn.ClusterAPI.StorageContainersAPI.GetStorageContainerById(utils.StringPtr(rs.Primary.ID))
+			if utils.IsV4NotFound(err) {
+				return nil
+			}
+			time.Sleep(5 * time.Second)
+		}
+		return fmt.Errorf("storage container %s still exists after delete", rs.Primary.ID)
+	}
+}
+
+// testAccCheckStorageContainerExtID records the ext_id of the storage container,
+// or checks it did not change, ie. the container was not recreated.
+func testAccCheckStorageContainerExtID(resourceName string, extID *string, same bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource %s not found", resourceName)
+		}
+		if same && rs.Primary.ID != *extID {
+			return fmt.Errorf("storage container was recreated, ext_id changed from %s to %s", *extID, rs.Primary.ID)
+		}
+		*extID = rs.Primary.ID
+		return nil
+	}
+}
+
+func testAccCheckStorageContainerDestroy(s *terraform.State) error {
+	conn := acc.TestAccProvider.Meta().(*conns.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "nutanix_storage_containers_v2" {
+			continue
+		}
+		_, err := conn.ClusterAPI.StorageContainersAPI.GetStorageContainerById(utils.StringPtr(rs.Primary.ID))
+		if err == nil {
+			return fmt.Errorf("storage container %s still exists", rs.Primary.ID)
+		}
+		if !utils.IsV4NotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+func TestAccV2NutanixStorageContainersResource_Import(t *testing.T) {
+	r := acctest.RandInt()
+	name := fmt.Sprintf("terraform-test-storage-container-%d", r)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccFoundationPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testStorageContainersResourceAllFlagsConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceNameStorageContainers, "is_inline_ec_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceNameStorageContainers, "cache_deduplication", "ON"),
+					resource.TestCheckResourceAttr(resourceNameStorageContainers, "on_disk_dedup", "POST_PROCESS"),
+					resource.TestCheckResourceAttr(resourceNameStorageContainers, "is_compression_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceNameStorageContainers, "is_internal", "false"),
+					resource.TestCheckResourceAttr(resourceNameStorageContainers, "is_software_encryption_enabled", "false"),
+				),
+			},
+			// every configured attribute, feature flags included, must be
+			// read back by the import
+			{
+				ResourceName:            resourceNameStorageContainers,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"last_task_ext_id", "ignore_small_files"},
+			},
+		},
+	})
+}
+
+func testStorageContainersResourceAllFlagsConfig(name string) string {
+	return fmt.Sprintf(`
+
+		data "nutanix_clusters_v2" "clusters" {}
+
+		locals{
+			cluster = [
+				for cluster in data.nutanix_clusters_v2.clusters.cluster_entities :
+				cluster.ext_id if cluster.config[0].cluster_function[0] != "PRISM_CENTRAL"
+			][0]
+		}
+
+		resource "nutanix_storage_containers_v2" "test" {
+			name                                  = "%[1]s"
+			cluster_ext_id                        = local.cluster
+			erasure_code                          = "OFF"
+			is_inline_ec_enabled                  = false
+			has_higher_ec_fault_domain_preference = false
+			cache_deduplication                   = "ON"
+			on_disk_dedup                         = "POST_PROCESS"
+			is_compression_enabled                = true
+			compression_delay_secs                = 0
+			is_internal                           = false
+			is_software_encryption_enabled        = false
+		}`, name)
+}
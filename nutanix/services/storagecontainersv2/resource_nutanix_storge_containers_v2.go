@@ -5,6 +5,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"log"
+	"net"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -12,10 +14,13 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	clustermgmtConfig "github.com/nutanix/ntnx-api-golang-clients/clustermgmt-go-client/v4/models/clustermgmt/v4/config"
+	clustermgmtStats "github.com/nutanix/ntnx-api-golang-clients/clustermgmt-go-client/v4/models/clustermgmt/v4/stats"
 	clsCommonConfig "github.com/nutanix/ntnx-api-golang-clients/clustermgmt-go-client/v4/models/common/v1/config"
+	clsstats "github.com/nutanix/ntnx-api-golang-clients/clustermgmt-go-client/v4/models/common/v1/stats"
 	clsPrismConfig "github.com/nutanix/ntnx-api-golang-clients/clustermgmt-go-client/v4/models/prism/v4/config"
 	prismConfig "github.com/nutanix/ntnx-api-golang-clients/prism-go-client/v4/models/prism/v4/config"
 	conns "github.com/terraform-providers/terraform-provider-nutanix/nutanix"
+	"github.com/terraform-providers/terraform-provider-nutanix/nutanix/sdks/v4/clusters"
 	"github.com/terraform-providers/terraform-provider-nutanix/nutanix/sdks/v4/prism"
 	"github.com/terraform-providers/terraform-provider-nutanix/utils"
 )
@@ -23,6 +28,9 @@ import (
 const (
 	timePeriod = 1 * time.Minute
 	timeSleep  = 2 * time.Minute
+
+	ipv4MaxPrefixLength = 32
+	ipv6MaxPrefixLength = 128
 )
 
 func ResourceNutanixStorageContainersV2() *schema.Resource {
@@ -31,10 +39,15 @@ func ResourceNutanixStorageContainersV2() *schema.Resource {
 		ReadContext:   ResourceNutanixStorageContainersV2Read,
 		UpdateContext: ResourceNutanixStorageContainersV2Update,
 		DeleteContext: ResourceNutanixStorageContainersV2Delete,
+		CustomizeDiff: resourceNutanixStorageContainersV2Diff,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceNutanixStorageContainersV2Import,
+		},
 		Schema: map[string]*schema.Schema{
 			"cluster_ext_id": {
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
+				Computed: true,
 			},
 			"ext_id": {
 				Type:     schema.TypeString,
@@ -107,12 +120,12 @@ func ResourceNutanixStorageContainersV2() *schema.Resource {
 				Optional: true,
 			},
 			"nfs_whitelist_addresses": {
-				Type:     schema.TypeList,
+				Type:     schema.TypeSet,
 				Optional: true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
-						"ipv4": resourceSchemaForValuePrefixLength(),
-						"ipv6": resourceSchemaForValuePrefixLength(),
+						"ipv4": resourceSchemaForValuePrefixLength(ipv4MaxPrefixLength, validation.IsIPv4Address),
+						"ipv6": resourceSchemaForValuePrefixLength(ipv6MaxPrefixLength, validation.IsIPv6Address),
 						"fqdn": resourceSchemaForFqdnValue(),
 					},
 				},
@@ -183,11 +196,22 @@ func ResourceNutanixStorageContainersV2() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"nfs_mount_path": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"datastores":            SchemaForDataStores(),
+			"fault_tolerance_state": SchemaForFaultToleranceState(),
+			"task_poll_retry":       utils.TaskPollRetrySchema(),
 			"ignore_small_files": {
 				Type:     schema.TypeBool,
 				Computed: true,
 				Optional: true,
 			},
+			"last_task_ext_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -196,7 +220,10 @@ func ResourceNutanixStorageContainersV2Create(ctx context.Context, d *schema.Res
 	conn := meta.(*conns.Client).ClusterAPI
 	body := &clustermgmtConfig.StorageContainer{}
 
-	clusterExtID := d.Get("cluster_ext_id")
+	clusterExtID, err := meta.(*conns.Client).ClusterExtIDOrDefault(d.Get("cluster_ext_id").(string), "cluster_ext_id")
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
 	if extID, ok := d.GetOk("ext_id"); ok {
 		body.ExtId = utils.StringPtr(extID.(string))
@@ -285,9 +312,9 @@ func ResourceNutanixStorageContainersV2Create(ctx context.Context, d *schema.Res
 
 	jsonBody, _ := json.MarshalIndent(body, "", "  ")
 	log.Printf("[DEBUG] create storage container body: %s", string(jsonBody))
-	resp, err := conn.StorageContainersAPI.CreateStorageContainer(body, utils.StringPtr(clusterExtID.(string)))
+	resp, err := conn.StorageContainersAPI.CreateStorageContainer(body, utils.StringPtr(clusterExtID))
 	if err != nil {
-		return diag.Errorf("error while creating storage containers : %v", err)
+		return utils.WithRemediationHint(diag.Errorf("error while creating storage containers : %v", err), err)
 	}
 
 	TaskRef := resp.Data.GetValue().(clsPrismConfig.TaskReference)
@@ -296,9 +323,9 @@ func ResourceNutanixStorageContainersV2Create(ctx context.Context, d *schema.Res
 	taskconn := meta.(*conns.Client).PrismAPI
 	// Wait for the cluster to be available
 	stateConf := &resource.StateChangeConf{
-		Pending: []string{"PENDING", "RUNNING", "QUEUED"},
-		Target:  []string{"SUCCEEDED"},
-		Refresh: taskStateRefreshPrismTaskGroupFunc(ctx, taskconn, utils.StringValue(taskUUID)),
+		Pending: utils.TaskPendingStates,
+		Target:  utils.TaskTargetStates,
+		Refresh: taskStateRefreshPrismTaskGroupFunc(ctx, taskconn, utils.StringValue(taskUUID), utils.ExpandTaskPollRetry(d.Get("task_poll_retry"))),
 		Timeout: d.Timeout(schema.TimeoutCreate),
 	}
 
@@ -316,6 +343,7 @@ func ResourceNutanixStorageContainersV2Create(ctx context.Context, d *schema.Res
 
 	uuid := rUUID.EntitiesAffected[0].ExtId
 	d.SetId(*uuid)
+	d.Set("last_task_ext_id", utils.StringValue(taskUUID))
 
 	// Delay/sleep for 2 Minute, replication factor is not updated immediately
 	time.Sleep(timeSleep)
@@ -324,11 +352,16 @@ func ResourceNutanixStorageContainersV2Create(ctx context.Context, d *schema.Res
 }
 
 func ResourceNutanixStorageContainersV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Reading storage container with ID: %s", d.Id())
+	utils.LogEvent(meta.(*conns.Client).LogFormat, "DEBUG", "reading storage container", utils.LogFields{"resource": "nutanix_storage_containers_v2", "ext_id": d.Id()})
 	conn := meta.(*conns.Client).ClusterAPI
 
 	resp, err := conn.StorageContainersAPI.GetStorageContainerById(utils.StringPtr(d.Id()))
 	if err != nil {
+		if utils.IsV4NotFound(err) {
+			log.Printf("[DEBUG] storage container %s not found, removing it from state", d.Id())
+			d.SetId("")
+			return nil
+		}
 		return diag.Errorf("error while fetching Storage Container : %v", err)
 	}
 
@@ -388,8 +421,11 @@ func ResourceNutanixStorageContainersV2Read(ctx context.Context, d *schema.Resou
 	if err := d.Set("is_inline_ec_enabled", getResp.IsInlineEcEnabled); err != nil {
 		return diag.FromErr(err)
 	}
-	if err := d.Set("has_higher_ec_fault_domain_preference", getResp.HasHigherEcFaultDomainPreference); err != nil {
-		return diag.FromErr(err)
+	// keep the configured value when the read omits it
+	if getResp.HasHigherEcFaultDomainPreference != nil {
+		if err := d.Set("has_higher_ec_fault_domain_preference", getResp.HasHigherEcFaultDomainPreference); err != nil {
+			return diag.FromErr(err)
+		}
 	}
 	if err := d.Set("erasure_code_delay_secs", getResp.ErasureCodeDelaySecs); err != nil {
 		return diag.FromErr(err)
@@ -421,13 +457,27 @@ func ResourceNutanixStorageContainersV2Read(ctx context.Context, d *schema.Resou
 	if err := d.Set("cluster_name", getResp.ClusterName); err != nil {
 		return diag.FromErr(err)
 	}
+	if err := d.Set("nfs_mount_path", storageContainerNfsMountPath(getResp.Name)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("datastores", flattenDataStores(listStorageContainerDataStores(conn, getResp.ClusterExtId, getResp.ContainerExtId))); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("fault_tolerance_state", flattenFaultToleranceState(getStorageContainerFaultToleranceState(conn, getResp.ClusterExtId))); err != nil {
+		return diag.FromErr(err)
+	}
 	return nil
 }
 
 func ResourceNutanixStorageContainersV2Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	log.Printf("[DEBUG] Update Storage Container")
+	utils.LogEvent(meta.(*conns.Client).LogFormat, "DEBUG", "updating storage container", utils.LogFields{"resource": "nutanix_storage_containers_v2", "ext_id": d.Id()})
 	conn := meta.(*conns.Client).ClusterAPI
 
+	// these arguments only tune the provider, there is nothing to send
+	if !d.HasChangesExcept("ignore_small_files", "task_poll_retry") {
+		return ResourceNutanixStorageContainersV2Read(ctx, d, meta)
+	}
+
 	resp, err := conn.StorageContainersAPI.GetStorageContainerById(utils.StringPtr(d.Id()))
 	if err != nil {
 		return diag.Errorf("error while fetching storage container : %v", err)
@@ -461,11 +511,20 @@ func ResourceNutanixStorageContainersV2Update(ctx context.Context, d *schema.Res
 		updateSpec.LogicalAdvertisedCapacityBytes = utils.Int64Ptr(int64(d.Get("logical_advertised_capacity_bytes").(int)))
 	}
 	if d.HasChange("replication_factor") {
-		updateSpec.ReplicationFactor = utils.IntPtr(d.Get("replication_factor").(int))
+		replicationFactor := d.Get("replication_factor").(int)
+		if err := checkClusterSupportsReplicationFactor(conn, respStorageContainer.ClusterExtId, replicationFactor); err != nil {
+			return diag.Errorf("error while updating storage container replication_factor : %v", err)
+		}
+		updateSpec.ReplicationFactor = utils.IntPtr(replicationFactor)
 	}
 	if d.HasChange("nfs_whitelist_addresses") {
-		log.Printf("[DEBUG] nfs_whitelist_addresses: %v", d.Get("nfs_whitelist_addresses"))
-		updateSpec.NfsWhitelistAddress = expandNfsWhitelistAddresses(d.Get("nfs_whitelist_addresses"))
+		oldAddresses, newAddresses := d.GetChange("nfs_whitelist_addresses")
+		removed := oldAddresses.(*schema.Set).Difference(newAddresses.(*schema.Set))
+		added := newAddresses.(*schema.Set).Difference(oldAddresses.(*schema.Set))
+		log.Printf("[DEBUG] nfs_whitelist_addresses removed: %v, added: %v", removed.List(), added.List())
+
+		updateSpec.NfsWhitelistAddress = applyNfsWhitelistAddressesChange(respStorageContainer.NfsWhitelistAddress,
+			expandNfsWhitelistAddresses(removed), expandNfsWhitelistAddresses(added))
 	}
 	if d.HasChange("erasure_code") {
 		const two, three, four = 2, 3, 4
@@ -527,7 +586,7 @@ func ResourceNutanixStorageContainersV2Update(ctx context.Context, d *schema.Res
 
 	updateResp, err := conn.StorageContainersAPI.UpdateStorageContainerById(utils.StringPtr(d.Id()), &updateSpec, args)
 	if err != nil {
-		return diag.Errorf("error while updating storage container : %v", err)
+		return utils.WithRemediationHint(diag.Errorf("error while updating storage container : %v", err), err)
 	}
 
 	TaskRef := updateResp.Data.GetValue().(clsPrismConfig.TaskReference)
@@ -536,19 +595,26 @@ func ResourceNutanixStorageContainersV2Update(ctx context.Context, d *schema.Res
 	taskconn := meta.(*conns.Client).PrismAPI
 	// Wait for the cluster to be available
 	stateConf := &resource.StateChangeConf{
-		Pending: []string{"PENDING", "RUNNING", "QUEUED"},
-		Target:  []string{"SUCCEEDED"},
-		Refresh: taskStateRefreshPrismTaskGroupFunc(ctx, taskconn, utils.StringValue(taskUUID)),
+		Pending: utils.TaskPendingStates,
+		Target:  utils.TaskTargetStates,
+		Refresh: taskStateRefreshPrismTaskGroupFunc(ctx, taskconn, utils.StringValue(taskUUID), utils.ExpandTaskPollRetry(d.Get("task_poll_retry"))),
 		Timeout: d.Timeout(schema.TimeoutCreate),
 	}
 
 	if _, errWaitTask := stateConf.WaitForStateContext(ctx); errWaitTask != nil {
 		return diag.Errorf("error waiting for storage container (%s) to update: %s", utils.StringValue(taskUUID), errWaitTask)
 	}
+	d.Set("last_task_ext_id", utils.StringValue(taskUUID))
 
 	// delay/sleep for 1 Minute, replication factor is not updated immediately
 	time.Sleep(timePeriod)
-	return ResourceNutanixStorageContainersV2Read(ctx, d, meta)
+	diags := ResourceNutanixStorageContainersV2Read(ctx, d, meta)
+	if d.HasChange("logical_advertised_capacity_bytes") {
+		if msg := advertisedCapacityBelowReservedMessage(d.Get("logical_advertised_capacity_bytes").(int), d.Get("logical_explicit_reserved_capacity_bytes").(int)); msg != "" {
+			diags = append(diags, diag.Diagnostic{Severity: diag.Warning, Summary: msg})
+		}
+	}
+	return diags
 }
 
 func ResourceNutanixStorageContainersV2Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -562,7 +628,7 @@ func ResourceNutanixStorageContainersV2Delete(ctx context.Context, d *schema.Res
 
 	resp, err := conn.StorageContainersAPI.DeleteStorageContainerById(utils.StringPtr(d.Id()), utils.BoolPtr(ignoreSmallFiles))
 	if err != nil {
-		return diag.Errorf("error while Deleting storage containers : %v", err)
+		return utils.WithRemediationHint(diag.Errorf("error while Deleting storage containers : %v%s", err, storageContainerInUseDetails(conn, d)), err)
 	}
 
 	TaskRef := resp.Data.GetValue().(clsPrismConfig.TaskReference)
@@ -571,124 +637,313 @@ func ResourceNutanixStorageContainersV2Delete(ctx context.Context, d *schema.Res
 	taskconn := meta.(*conns.Client).PrismAPI
 	// Wait for the cluster to be available
 	stateConf := &resource.StateChangeConf{
-		Pending: []string{"PENDING", "RUNNING", "QUEUED"},
-		Target:  []string{"SUCCEEDED"},
-		Refresh: taskStateRefreshPrismTaskGroupFunc(ctx, taskconn, utils.StringValue(taskUUID)),
+		Pending: utils.TaskPendingStates,
+		Target:  utils.TaskTargetStates,
+		Refresh: taskStateRefreshPrismTaskGroupFunc(ctx, taskconn, utils.StringValue(taskUUID), utils.ExpandTaskPollRetry(d.Get("task_poll_retry"))),
 		Timeout: d.Timeout(schema.TimeoutCreate),
 	}
 
 	if _, errWaitTask := stateConf.WaitForStateContext(ctx); errWaitTask != nil {
-		return diag.Errorf("error waiting for storage container (%s) to delete: %s", utils.StringValue(taskUUID), errWaitTask)
+		return diag.Errorf("error waiting for storage container (%s) to delete: %s%s", utils.StringValue(taskUUID), errWaitTask, storageContainerInUseDetails(conn, d))
+	}
+	return nil
+}
+
+// resourceNutanixStorageContainersV2Import sets the arguments the read can not
+// return to their defaults, so that an imported storage container has no diff
+// against a configuration leaving them unset or false.
+func resourceNutanixStorageContainersV2Import(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	if d.Id() == "" || strings.Contains(d.Id(), "/") {
+		return nil, fmt.Errorf("invalid storage container import id %q, expected <ext_id>", d.Id())
+	}
+	log.Printf("[DEBUG] Importing storage container %s", d.Id())
+
+	if err := d.Set("ignore_small_files", false); err != nil {
+		return nil, err
+	}
+	// the read keeps the configured value when the API omits it
+	if err := d.Set("has_higher_ec_fault_domain_preference", false); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
+// resourceNutanixStorageContainersV2Diff rejects shrinking the advertised
+// capacity of a storage container below the storage it already uses, the
+// cluster would either refuse the update or leave the container over its
+// advertised capacity.
+func resourceNutanixStorageContainersV2Diff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" || !d.HasChange("logical_advertised_capacity_bytes") || !d.NewValueKnown("logical_advertised_capacity_bytes") {
+		return nil
+	}
+	oldCapacity, newCapacity := d.GetChange("logical_advertised_capacity_bytes")
+	advertised := newCapacity.(int)
+	// 0 removes the advertised capacity, only a shrink can go below the usage
+	if advertised == 0 || (oldCapacity.(int) != 0 && advertised >= oldCapacity.(int)) {
+		return nil
+	}
+
+	usage, err := storageContainerUsageBytes(meta.(*conns.Client).ClusterAPI, d.Id())
+	if err != nil {
+		return fmt.Errorf("error while checking the usage of storage container %s before shrinking its advertised capacity : %v", d.Id(), err)
+	}
+	if usage != nil && int64(advertised) < *usage {
+		return fmt.Errorf("logical_advertised_capacity_bytes %d is below the %d bytes storage container %s already uses, free space on the container or keep a capacity of at least %d bytes",
+			advertised, *usage, d.Id(), *usage)
+	}
+
+	if msg := advertisedCapacityBelowReservedMessage(advertised, d.Get("logical_explicit_reserved_capacity_bytes").(int)); msg != "" {
+		log.Printf("[WARN] %s", msg)
+	}
+	return nil
+}
+
+// storageContainerUsageBytes returns the latest storage usage of a storage
+// container, or nil when the cluster has no recent sample for it.
+func storageContainerUsageBytes(conn *clusters.Client, extID string) (*int64, error) {
+	const samplingIntervalSecs = 300
+	endTime := time.Now().UTC()
+	startTime := endTime.Add(-1 * time.Hour)
+	statType := clsstats.DOWNSAMPLINGOPERATOR_LAST
+
+	resp, err := conn.StorageContainersAPI.GetStorageContainerStats(utils.StringPtr(extID), &startTime, &endTime, utils.IntPtr(samplingIntervalSecs), &statType)
+	if err != nil {
+		return nil, err
+	}
+	if resp.Data == nil {
+		return nil, nil
+	}
+	stats, ok := resp.Data.GetValue().(clustermgmtStats.StorageContainerStats)
+	if !ok {
+		return nil, nil
+	}
+
+	var usage *int64
+	var sampledAt time.Time
+	for _, v := range stats.StorageUsageBytes {
+		if v.Value == nil {
+			continue
+		}
+		if usage == nil || (v.Timestamp != nil && v.Timestamp.After(sampledAt)) {
+			usage = v.Value
+			if v.Timestamp != nil {
+				sampledAt = *v.Timestamp
+			}
+		}
+	}
+	return usage, nil
+}
+
+// advertisedCapacityBelowReservedMessage describes an advertised capacity
+// below the explicitly reserved capacity, which is allowed but leaves part
+// of the reservation unusable.
+func advertisedCapacityBelowReservedMessage(advertised, reserved int) string {
+	if advertised == 0 || reserved == 0 || advertised >= reserved {
+		return ""
+	}
+	return fmt.Sprintf("logical_advertised_capacity_bytes %d is below logical_explicit_reserved_capacity_bytes %d, the storage container can not use all of its reserved capacity", advertised, reserved)
+}
+
+// storageContainerInUseDetails describes what still uses a storage container
+// that failed to delete, so the error points at the datastores and VMs to clean
+// up first.
+func storageContainerInUseDetails(conn *clusters.Client, d *schema.ResourceData) string {
+	dataStores := listStorageContainerDataStores(conn, utils.StringPtr(d.Get("cluster_ext_id").(string)), utils.StringPtr(d.Get("container_ext_id").(string)))
+
+	details := make([]string, 0, len(dataStores))
+	for _, dataStore := range dataStores {
+		detail := fmt.Sprintf("datastore %s on host %s", utils.StringValue(dataStore.DatastoreName), utils.StringValue(dataStore.HostIpAddress))
+		if len(dataStore.VmNames) > 0 {
+			detail += fmt.Sprintf(" used by vms [%s]", strings.Join(dataStore.VmNames, ", "))
+		}
+		details = append(details, detail)
+	}
+
+	msg := ""
+	if len(details) > 0 {
+		msg = fmt.Sprintf("\nthe storage container is still mounted as %s", strings.Join(details, "; "))
+	}
+	return msg + "\nremove the vdisks and files left on the storage container before deleting it"
+}
+
+// checkClusterSupportsReplicationFactor rejects a replication factor the cluster
+// owning the storage container cannot satisfy, a container cannot have a higher
+// replication factor than the cluster redundancy factor, and the cluster has
+// to currently tolerate replication_factor - 1 failures to rebuild the copies.
+func checkClusterSupportsReplicationFactor(conn *clusters.Client, clusterExtID *string, replicationFactor int) error {
+	if clusterExtID == nil {
+		return nil
+	}
+	resp, err := conn.ClusterEntityAPI.GetClusterById(clusterExtID, nil)
+	if err != nil {
+		return fmt.Errorf("error while fetching cluster %s to check replication factor : %v", *clusterExtID, err)
+	}
+	cluster := resp.Data.GetValue().(clustermgmtConfig.Cluster)
+	if cluster.Config == nil {
+		return nil
+	}
+
+	if rf := cluster.Config.RedundancyFactor; rf != nil && int64(replicationFactor) > *rf {
+		return fmt.Errorf("replication factor %d is higher than the redundancy factor %d of cluster %s",
+			replicationFactor, *rf, *clusterExtID)
+	}
+
+	if ft := cluster.Config.FaultToleranceState; ft != nil && ft.CurrentMaxFaultTolerance != nil {
+		if *ft.CurrentMaxFaultTolerance < replicationFactor-1 {
+			return fmt.Errorf("replication factor %d needs a fault tolerance of %d but cluster %s currently tolerates %d failure(s)",
+				replicationFactor, replicationFactor-1, *clusterExtID, *ft.CurrentMaxFaultTolerance)
+		}
 	}
 	return nil
 }
 
 func expandNfsWhitelistAddresses(nfsWhitelistAddresses interface{}) []clsCommonConfig.IPAddressOrFQDN {
-	if nfsWhitelistAddresses != nil {
-		nfsWhitelistAddressesList := nfsWhitelistAddresses.([]interface{})
-		ips := make([]clsCommonConfig.IPAddressOrFQDN, len(nfsWhitelistAddressesList))
+	if nfsWhitelistAddresses == nil {
+		return nil
+	}
+	nfsWhitelistAddressesList := nfsWhitelistAddresses.(*schema.Set).List()
+	if len(nfsWhitelistAddressesList) == 0 {
+		return nil
+	}
+	ips := make([]clsCommonConfig.IPAddressOrFQDN, len(nfsWhitelistAddressesList))
 
-		ip := &clsCommonConfig.IPAddressOrFQDN{}
-		prI := nfsWhitelistAddresses.([]interface{})
-		val := prI[0].(map[string]interface{})
+	for k, v := range nfsWhitelistAddressesList {
+		ip := clsCommonConfig.IPAddressOrFQDN{}
+		val := v.(map[string]interface{})
 
 		if ipv4, ok := val["ipv4"]; ok && len(ipv4.([]interface{})) > 0 {
 			ip.Ipv4 = expandIPv4Address(ipv4)
 		}
 		if ipv6, ok := val["ipv6"]; ok && len(ipv6.([]interface{})) > 0 {
-			log.Printf("[DEBUG] ipv6: %v", ipv6)
-
 			ip.Ipv6 = expandIPv6Address(ipv6)
 		}
 		if fqdn, ok := val["fqdn"]; ok && len(fqdn.([]interface{})) > 0 {
 			ip.Fqdn = expandFQDN(fqdn.([]interface{}))
 		}
-		ips[0] = *ip
-		return ips
+		ips[k] = ip
 	}
-	return nil
+	return ips
+}
+
+// applyNfsWhitelistAddressesChange removes and adds the given addresses to the
+// whitelist currently configured on the storage container.
+func applyNfsWhitelistAddressesChange(current, removed, added []clsCommonConfig.IPAddressOrFQDN) []clsCommonConfig.IPAddressOrFQDN {
+	removedKeys := make(map[string]bool, len(removed))
+	for _, ip := range removed {
+		removedKeys[nfsWhitelistAddressKey(ip)] = true
+	}
+
+	ips := make([]clsCommonConfig.IPAddressOrFQDN, 0, len(current)+len(added))
+	seen := make(map[string]bool, len(current)+len(added))
+	for _, ip := range append(current, added...) {
+		key := nfsWhitelistAddressKey(ip)
+		if removedKeys[key] || seen[key] {
+			continue
+		}
+		seen[key] = true
+		ips = append(ips, ip)
+	}
+	return ips
 }
 
-func resourceSchemaForValuePrefixLength() *schema.Schema {
+func nfsWhitelistAddressKey(ip clsCommonConfig.IPAddressOrFQDN) string {
+	if ip.Ipv4 != nil {
+		return fmt.Sprintf("ipv4:%s/%d", utils.StringValue(ip.Ipv4.Value), utils.IntValue(ip.Ipv4.PrefixLength))
+	}
+	if ip.Ipv6 != nil {
+		return fmt.Sprintf("ipv6:%s/%d", normalizeIPAddress(utils.StringValue(ip.Ipv6.Value)), utils.IntValue(ip.Ipv6.PrefixLength))
+	}
+	if ip.Fqdn != nil {
+		return fmt.Sprintf("fqdn:%s", utils.StringValue(ip.Fqdn.Value))
+	}
+	return ""
+}
+
+func resourceSchemaForValuePrefixLength(maxPrefixLength int, validateValue schema.SchemaValidateFunc) *schema.Schema {
 	return &schema.Schema{
 		Type:     schema.TypeList,
 		Optional: true,
-		Computed: true,
+		MaxItems: 1,
 		Elem: &schema.Resource{
 			Schema: map[string]*schema.Schema{
 				"value": {
-					Type:     schema.TypeString,
-					Optional: true,
-					Computed: true,
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validateValue,
+					StateFunc: func(v interface{}) string {
+						return normalizeIPAddress(v.(string))
+					},
 				},
 				"prefix_length": {
-					Type:     schema.TypeInt,
-					Optional: true,
-					Computed: true,
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      maxPrefixLength,
+					ValidateFunc: validation.IntBetween(0, maxPrefixLength),
 				},
 			},
 		},
 	}
 }
 
+// normalizeIPAddress returns the canonical form of an ip address, so that
+// "2001:DB8:0::1" in the config and "2001:db8::1" from the API are the same
+// whitelist entry.
+func normalizeIPAddress(value string) string {
+	if ip := net.ParseIP(value); ip != nil {
+		return ip.String()
+	}
+	return value
+}
+
 func resourceSchemaForFqdnValue() *schema.Schema {
 	return &schema.Schema{
 		Type:     schema.TypeList,
 		Optional: true,
-		Computed: true,
+		MaxItems: 1,
 		Elem: &schema.Resource{
 			Schema: map[string]*schema.Schema{
 				"value": {
 					Type:     schema.TypeString,
-					Optional: true,
-					Computed: true,
+					Required: true,
 				},
 			},
 		},
 	}
 }
 
-func taskStateRefreshPrismTaskGroupFunc(ctx context.Context, client *prism.Client, taskUUID string) resource.StateRefreshFunc {
+// taskStateRefreshPrismTaskGroupFunc polls the task taskUUID, retrying the
+// polls failing with a transient error as configured by retry.
+func taskStateRefreshPrismTaskGroupFunc(ctx context.Context, client *prism.Client, taskUUID string, retry utils.TaskPollRetry) resource.StateRefreshFunc {
+	poll := &utils.TaskStatusPoll{}
 	return func() (interface{}, string, error) {
 		// data := base64.StdEncoding.EncodeToString([]byte("ergon"))
 		// encodeUUID := data + ":" + taskUUID
-		vresp, err := client.TaskRefAPI.GetTaskById(utils.StringPtr(taskUUID), nil)
+		// batched with the other running tasks when task_poll_batch_interval is set
+		var v prismConfig.Task
+		err := retry.Do(ctx, func() error {
+			var err error
+			v, err = client.GetTask(ctx, taskUUID)
+			return err
+		})
 		if err != nil {
-			return "", "", (fmt.Errorf("error while polling prism task: %v", err))
+			return "", "", &utils.TaskPollError{TaskExtID: taskUUID, Err: err}
 		}
 
-		// get the group results
-
-		v := vresp.Data.GetValue().(prismConfig.Task)
+		status, err := poll.Status(taskUUID, v.Status)
+		utils.LogTaskPoll(client.LogFormat, v, status)
+		if err != nil {
+			return v, status, err
+		}
 
-		if getTaskStatus(v.Status) == "CANCELED" || getTaskStatus(v.Status) == "FAILED" {
-			return v, getTaskStatus(v.Status),
-				fmt.Errorf("error_detail: %s, progress_message: %d", utils.StringValue(v.ErrorMessages[0].Message), utils.IntValue(v.ProgressPercentage))
+		if utils.IsTaskFailed(status) {
+			return v, status, utils.NewTaskFailedError(taskUUID, status, v)
 		}
-		return v, getTaskStatus(v.Status), nil
+		return v, status, nil
 	}
 }
 
 func getTaskStatus(pr *prismConfig.TaskStatus) string {
-	const two, three, five, six, seven = 2, 3, 5, 6, 7
-	if pr != nil {
-		if *pr == prismConfig.TaskStatus(six) {
-			return "FAILED"
-		}
-		if *pr == prismConfig.TaskStatus(seven) {
-			return "CANCELED"
-		}
-		if *pr == prismConfig.TaskStatus(two) {
-			return "QUEUED"
-		}
-		if *pr == prismConfig.TaskStatus(three) {
-			return "RUNNING"
-		}
-		if *pr == prismConfig.TaskStatus(five) {
-			return "SUCCEEDED"
-		}
-	}
-	return "UNKNOWN"
+	return utils.TaskStatusName(pr)
 }
 
 func expandIPv4Address(pr interface{}) *clsCommonConfig.IPv4Address {
@@ -715,7 +970,7 @@ func expandIPv6Address(pr interface{}) *clsCommonConfig.IPv6Address {
 		val := prI[0].(map[string]interface{})
 
 		if value, ok := val["value"]; ok {
-			ipv6.Value = utils.StringPtr(value.(string))
+			ipv6.Value = utils.StringPtr(normalizeIPAddress(value.(string)))
 		}
 		if prefix, ok := val["prefix_length"]; ok {
 			ipv6.PrefixLength = utils.IntPtr(prefix.(int))
@@ -20,12 +20,11 @@ import (
 	"github.com/terraform-providers/terraform-provider-nutanix/utils"
 )
 
-var (
+const (
 	vmTimeout    = 1 * time.Minute
 	vmDelay      = 3 * time.Second
 	vmMinTimeout = 3 * time.Second
 	IDE          = "IDE"
-	useHotAdd    = true
 )
 
 func ResourceNutanixVirtualMachine() *schema.Resource {
@@ -637,7 +636,6 @@ func ResourceNutanixVirtualMachine() *schema.Resource {
 func resourceNutanixVirtualMachineCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	// Get client connection
 	conn := meta.(*conns.Client).API
-	setVMTimeout(meta)
 	// Prepare request
 	request := &v3.VMIntentInput{}
 	spec := &v3.VM{}
@@ -704,7 +702,7 @@ func resourceNutanixVirtualMachineCreate(ctx context.Context, d *schema.Resource
 		Pending:    []string{WAITING},
 		Target:     []string{"AVAILABLE"},
 		Refresh:    waitForIPRefreshFunc(conn, uuid),
-		Timeout:    vmTimeout,
+		Timeout:    vmWaitTimeout(meta),
 		Delay:      vmDelay,
 		MinTimeout: vmMinTimeout,
 	}
@@ -731,7 +729,6 @@ func resourceNutanixVirtualMachineCreate(ctx context.Context, d *schema.Resource
 func resourceNutanixVirtualMachineRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	// Get client connection
 	conn := meta.(*conns.Client).API
-	setVMTimeout(meta)
 
 	var err error
 	// Make request to the API
@@ -802,6 +799,7 @@ func resourceNutanixVirtualMachineRead(ctx context.Context, d *schema.ResourceDa
 		return diag.Errorf("error setting parent_reference for Virtual Machine %s: %s", d.Id(), err)
 	}
 
+	useHotAdd := true
 	if uha, ok := d.GetOkExists("use_hot_add"); ok {
 		useHotAdd = uha.(bool)
 	}
@@ -908,7 +906,6 @@ func resourceNutanixVirtualMachineRead(ctx context.Context, d *schema.ResourceDa
 
 func resourceNutanixVirtualMachineUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.Client).API
-	setVMTimeout(meta)
 	hotPlugChange := true
 
 	log.Printf("[Debug] Updating VM values %s", d.Id())
@@ -941,9 +938,6 @@ func resourceNutanixVirtualMachineUpdate(ctx context.Context, d *schema.Resource
 		metadata = response.Metadata
 	}
 
-	if d.HasChange("use_hot_add") {
-		useHotAdd = d.Get("use_hot_add").(bool)
-	}
 	if d.HasChange("categories") {
 		metadata.Categories = expandCategories(d.Get("categories"))
 	}
@@ -1194,7 +1188,7 @@ func resourceNutanixVirtualMachineUpdate(ctx context.Context, d *schema.Resource
 
 	// If there are non-hotPlug changes, then poweroff is needed
 	if !hotPlugChange {
-		if err := changePowerState(ctx, conn, d.Id(), "OFF"); err != nil {
+		if err := changePowerState(ctx, conn, d.Id(), "OFF", vmWaitTimeout(meta)); err != nil {
 			return diag.Errorf("internal error: cannot shut down the VM with UUID(%s): %s", d.Id(), err)
 		}
 		// SpecVersion has changed due previous poweroff
@@ -1231,7 +1225,7 @@ func resourceNutanixVirtualMachineUpdate(ctx context.Context, d *schema.Resource
 	}
 
 	// Then, Turn On the VM.
-	if err := changePowerState(ctx, conn, d.Id(), "ON"); err != nil {
+	if err := changePowerState(ctx, conn, d.Id(), "ON", vmWaitTimeout(meta)); err != nil {
 		return diag.Errorf("internal error: cannot turn ON the VM with UUID(%s): %s", d.Id(), err)
 	}
 
@@ -1296,7 +1290,7 @@ func bootConfigHasChange(boot *v3.VMBootConfig, d *schema.ResourceData) (*v3.VMB
 	return bootConfig, hotPlugChange
 }
 
-func changePowerState(ctx context.Context, conn *v3.Client, id string, powerState string) error {
+func changePowerState(ctx context.Context, conn *v3.Client, id string, powerState string, timeout time.Duration) error {
 	request := &v3.VMIntentInput{}
 	metadata := &v3.Metadata{}
 	res := &v3.VMResources{}
@@ -1358,7 +1352,7 @@ func changePowerState(ctx context.Context, conn *v3.Client, id string, powerStat
 		Pending:    []string{"QUEUED", "RUNNING"},
 		Target:     []string{"SUCCEEDED"},
 		Refresh:    taskStateRefreshFunc(conn, resp.Status.ExecutionContext.TaskUUID.(string)),
-		Timeout:    vmTimeout,
+		Timeout:    timeout,
 		Delay:      vmDelay,
 		MinTimeout: vmMinTimeout,
 	}
@@ -1373,7 +1367,7 @@ func changePowerState(ctx context.Context, conn *v3.Client, id string, powerStat
 		Pending:    []string{"PENDING", "RUNNING"},
 		Target:     []string{"COMPLETE"},
 		Refresh:    taskVMStateRefreshFunc(conn, id, powerState),
-		Timeout:    vmTimeout,
+		Timeout:    timeout,
 		Delay:      vmDelay,
 		MinTimeout: vmMinTimeout,
 	}
@@ -1404,7 +1398,6 @@ func taskVMStateRefreshFunc(client *v3.Client, vmUUID string, powerState string)
 
 func resourceNutanixVirtualMachineDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.Client).API
-	setVMTimeout(meta)
 	log.Printf("[DEBUG] Deleting Virtual Machine: %s, %s", d.Get("name").(string), d.Id())
 	resp, err := conn.V3.DeleteVM(d.Id())
 	if err != nil {
@@ -2030,11 +2023,14 @@ func resourceVirtualMachineInstanceStateUpgradeV0(ctx context.Context, is map[st
 	return resourceNutanixCategoriesMigrateState(is, meta)
 }
 
-func setVMTimeout(meta interface{}) {
-	client := meta.(*conns.Client)
-	if client.WaitTimeout != 0 {
-		vmTimeout = time.Duration(client.WaitTimeout) * time.Minute
+// vmWaitTimeout returns how long to wait for a VM task, the provider
+// wait_timeout when it is set. It is read from the provider config on every
+// call instead of being stored in a package variable shared by all resources.
+func vmWaitTimeout(meta interface{}) time.Duration {
+	if client := meta.(*conns.Client); client.WaitTimeout != 0 {
+		return time.Duration(client.WaitTimeout) * time.Minute
 	}
+	return vmTimeout
 }
 
 func resourceNutanixVirtualMachineDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
@@ -3,6 +3,7 @@ package vmmv2
 import (
 	"context"
 	"encoding/json"
+	"log"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -166,8 +167,8 @@ func ResourceNutanixImagePlacementV2Create(ctx context.Context, d *schema.Resour
 	taskconn := meta.(*conns.Client).PrismAPI
 	// Wait for the ImagePlacement to be available
 	stateConf := &resource.StateChangeConf{
-		Pending: []string{"PENDING", "RUNNING"},
-		Target:  []string{"SUCCEEDED"},
+		Pending: utils.TaskPendingStates,
+		Target:  utils.TaskTargetStates,
 		Refresh: taskStateRefreshPrismTaskGroupFunc(ctx, taskconn, utils.StringValue(taskUUID)),
 		Timeout: d.Timeout(schema.TimeoutCreate),
 	}
@@ -202,6 +203,11 @@ func ResourceNutanixImagePlacementV2Read(ctx context.Context, d *schema.Resource
 
 	resp, err := conn.ImagesPlacementAPIInstance.GetPlacementPolicyById(utils.StringPtr(d.Id()))
 	if err != nil {
+		if utils.IsV4NotFound(err) {
+			log.Printf("[DEBUG] image placement policy %s not found, removing it from state", d.Id())
+			d.SetId("")
+			return nil
+		}
 		return diag.Errorf("error while fetching image placement policy : %v", err)
 	}
 
@@ -320,8 +326,8 @@ func ResourceNutanixImagePlacementV2Update(ctx context.Context, d *schema.Resour
 		taskconn := meta.(*conns.Client).PrismAPI
 		// Wait for the ImagePlacement to be available
 		stateConf := &resource.StateChangeConf{
-			Pending: []string{"PENDING", "RUNNING"},
-			Target:  []string{"SUCCEEDED"},
+			Pending: utils.TaskPendingStates,
+			Target:  utils.TaskTargetStates,
 			Refresh: taskStateRefreshPrismTaskGroupFunc(ctx, taskconn, utils.StringValue(taskUUID)),
 			Timeout: d.Timeout(schema.TimeoutCreate),
 		}
@@ -364,8 +370,8 @@ func suspendAction(ctx context.Context, conn *vmm.Client, d *schema.ResourceData
 	taskconn := meta.(*conns.Client).PrismAPI
 	// Wait for the ImagePlacement to be available
 	stateConf := &resource.StateChangeConf{
-		Pending: []string{"PENDING", "RUNNING"},
-		Target:  []string{"SUCCEEDED"},
+		Pending: utils.TaskPendingStates,
+		Target:  utils.TaskTargetStates,
 		Refresh: taskStateRefreshPrismTaskGroupFunc(ctx, taskconn, utils.StringValue(taskUUID)),
 		Timeout: d.Timeout(schema.TimeoutCreate),
 	}
@@ -407,8 +413,8 @@ func resumeAction(ctx context.Context, conn *vmm.Client, d *schema.ResourceData,
 	taskconn := meta.(*conns.Client).PrismAPI
 	// Wait for the ImagePlacement to be available
 	stateConf := &resource.StateChangeConf{
-		Pending: []string{"PENDING", "RUNNING"},
-		Target:  []string{"SUCCEEDED"},
+		Pending: utils.TaskPendingStates,
+		Target:  utils.TaskTargetStates,
 		Refresh: taskStateRefreshPrismTaskGroupFunc(ctx, taskconn, utils.StringValue(taskUUID)),
 		Timeout: d.Timeout(schema.TimeoutCreate),
 	}
@@ -449,8 +455,8 @@ func ResourceNutanixImagePlacementV2Delete(ctx context.Context, d *schema.Resour
 	taskconn := meta.(*conns.Client).PrismAPI
 	// Wait for the ImagePlacement to be available
 	stateConf := &resource.StateChangeConf{
-		Pending: []string{"PENDING", "RUNNING"},
-		Target:  []string{"SUCCEEDED"},
+		Pending: utils.TaskPendingStates,
+		Target:  utils.TaskTargetStates,
 		Refresh: taskStateRefreshPrismTaskGroupFunc(ctx, taskconn, utils.StringValue(taskUUID)),
 		Timeout: d.Timeout(schema.TimeoutCreate),
 	}
@@ -2,7 +2,7 @@ package vmmv2
 
 import (
 	"context"
-	"fmt"
+	"log"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -242,8 +242,8 @@ func ResourceNutanixImageV4Create(ctx context.Context, d *schema.ResourceData, m
 	taskconn := meta.(*conns.Client).PrismAPI
 	// Wait for the Image to be available
 	stateConf := &resource.StateChangeConf{
-		Pending: []string{"QUEUED", "RUNNING"},
-		Target:  []string{"SUCCEEDED"},
+		Pending: utils.TaskPendingStates,
+		Target:  utils.TaskTargetStates,
 		Refresh: taskStateRefreshPrismTaskGroupFunc(ctx, taskconn, utils.StringValue(taskUUID)),
 		Timeout: d.Timeout(schema.TimeoutCreate),
 	}
@@ -270,6 +270,11 @@ func ResourceNutanixImageV4Read(ctx context.Context, d *schema.ResourceData, met
 
 	resp, err := conn.ImagesAPIInstance.GetImageById(utils.StringPtr(d.Id()))
 	if err != nil {
+		if utils.IsV4NotFound(err) {
+			log.Printf("[DEBUG] image %s not found, removing it from state", d.Id())
+			d.SetId("")
+			return nil
+		}
 		return diag.Errorf("error while fetching images : %v", err)
 	}
 
@@ -373,8 +378,8 @@ func ResourceNutanixImageV4Update(ctx context.Context, d *schema.ResourceData, m
 	taskconn := meta.(*conns.Client).PrismAPI
 	// Wait for the Image to be available
 	stateConf := &resource.StateChangeConf{
-		Pending: []string{"QUEUED", "RUNNING"},
-		Target:  []string{"SUCCEEDED"},
+		Pending: utils.TaskPendingStates,
+		Target:  utils.TaskTargetStates,
 		Refresh: taskStateRefreshPrismTaskGroupFunc(ctx, taskconn, utils.StringValue(taskUUID)),
 		Timeout: d.Timeout(schema.TimeoutCreate),
 	}
@@ -401,8 +406,8 @@ func ResourceNutanixImageV4Delete(ctx context.Context, d *schema.ResourceData, m
 	taskconn := meta.(*conns.Client).PrismAPI
 	// Wait for the Image to be available
 	stateConf := &resource.StateChangeConf{
-		Pending: []string{"QUEUED", "RUNNING"},
-		Target:  []string{"SUCCEEDED"},
+		Pending: utils.TaskPendingStates,
+		Target:  utils.TaskTargetStates,
 		Refresh: taskStateRefreshPrismTaskGroupFunc(ctx, taskconn, utils.StringValue(taskUUID)),
 		Timeout: d.Timeout(schema.TimeoutCreate),
 	}
@@ -414,46 +419,31 @@ func ResourceNutanixImageV4Delete(ctx context.Context, d *schema.ResourceData, m
 }
 
 func taskStateRefreshPrismTaskGroupFunc(ctx context.Context, client *prism.Client, taskUUID string) resource.StateRefreshFunc {
+	poll := &utils.TaskStatusPoll{}
 	return func() (interface{}, string, error) {
 		// data := base64.StdEncoding.EncodeToString([]byte("ergon"))
 		// encodeUUID := data + ":" + taskUUID
-		vresp, err := client.TaskRefAPI.GetTaskById(utils.StringPtr(taskUUID), nil)
+		// batched with the other running tasks when task_poll_batch_interval is set
+		v, err := client.GetTask(ctx, taskUUID)
 		if err != nil {
-			return "", "", (fmt.Errorf("error while polling prism task: %v", err))
+			return "", "", &utils.TaskPollError{TaskExtID: taskUUID, Err: err}
 		}
 
-		// get the group results
-
-		v := vresp.Data.GetValue().(import2.Task)
+		status, err := poll.Status(taskUUID, v.Status)
+		utils.LogTaskPoll(client.LogFormat, v, status)
+		if err != nil {
+			return v, status, err
+		}
 
-		if getTaskStatus(v.Status) == "CANCELED" || getTaskStatus(v.Status) == "FAILED" {
-			return v, getTaskStatus(v.Status),
-				fmt.Errorf("error_detail: %s, progress_message: %d", utils.StringValue(v.ErrorMessages[0].Message), utils.IntValue(v.ProgressPercentage))
+		if utils.IsTaskFailed(status) {
+			return v, status, utils.NewTaskFailedError(taskUUID, status, v)
 		}
-		return v, getTaskStatus(v.Status), nil
+		return v, status, nil
 	}
 }
 
 func getTaskStatus(pr *import2.TaskStatus) string {
-	if pr != nil {
-		const two, three, five, six, seven = 2, 3, 5, 6, 7
-		if *pr == import2.TaskStatus(six) {
-			return "FAILED"
-		}
-		if *pr == import2.TaskStatus(seven) {
-			return "CANCELED"
-		}
-		if *pr == import2.TaskStatus(two) {
-			return "QUEUED"
-		}
-		if *pr == import2.TaskStatus(three) {
-			return "RUNNING"
-		}
-		if *pr == import2.TaskStatus(five) {
-			return "SUCCEEDED"
-		}
-	}
-	return "UNKNOWN"
+	return utils.TaskStatusName(pr)
 }
 
 func expandOneOfImageChecksum(pr interface{}) *import5.OneOfImageChecksum {
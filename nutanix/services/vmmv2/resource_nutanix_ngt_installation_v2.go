@@ -248,8 +248,8 @@ func ResourceNutanixNGTInstallationV4Create(ctx context.Context, d *schema.Resou
 	taskconn := meta.(*conns.Client).PrismAPI
 	// Wait for the VM to be available
 	stateConf := &resource.StateChangeConf{
-		Pending: []string{"PENDING", "RUNNING", "QUEUED"},
-		Target:  []string{"SUCCEEDED"},
+		Pending: utils.TaskPendingStates,
+		Target:  utils.TaskTargetStates,
 		Refresh: taskStateRefreshPrismTaskGroupFunc(ctx, taskconn, utils.StringValue(taskUUID)),
 		Timeout: d.Timeout(schema.TimeoutCreate),
 	}
@@ -283,6 +283,11 @@ func ResourceNutanixNGTInstallationV4Read(ctx context.Context, d *schema.Resourc
 	extID := d.Id()
 	resp, err := conn.VMAPIInstance.GetGuestToolsById(utils.StringPtr(extID))
 	if err != nil {
+		if utils.IsV4NotFound(err) {
+			log.Printf("[DEBUG] vm %s not found, removing it from state", d.Id())
+			d.SetId("")
+			return nil
+		}
 		return diag.Errorf("error while fetching Gest Tool : %v", err)
 	}
 	getResp := resp.Data.GetValue().(vmmConfig.GuestTools)
@@ -388,8 +393,8 @@ func ResourceNutanixNGTInstallationV4Update(ctx context.Context, d *schema.Resou
 
 	// Wait for the VM to be available
 	stateConf := &resource.StateChangeConf{
-		Pending: []string{"PENDING", "RUNNING", "QUEUED"},
-		Target:  []string{"SUCCEEDED"},
+		Pending: utils.TaskPendingStates,
+		Target:  utils.TaskTargetStates,
 		Refresh: taskStateRefreshPrismTaskGroupFunc(ctx, taskconn, utils.StringValue(taskUUID)),
 		Timeout: d.Timeout(schema.TimeoutCreate),
 	}
@@ -426,8 +431,8 @@ func ResourceNutanixNGTInstallationV4Delete(ctx context.Context, d *schema.Resou
 
 	// Wait for the VM to be available
 	stateConf := &resource.StateChangeConf{
-		Pending: []string{"PENDING", "RUNNING", "QUEUED"},
-		Target:  []string{"SUCCEEDED"},
+		Pending: utils.TaskPendingStates,
+		Target:  utils.TaskTargetStates,
 		Refresh: taskStateRefreshPrismTaskGroupFunc(ctx, taskconn, utils.StringValue(taskUUID)),
 		Timeout: d.Timeout(schema.TimeoutCreate),
 	}
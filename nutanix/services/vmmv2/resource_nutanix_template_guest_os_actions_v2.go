@@ -71,8 +71,8 @@ func ResourceNutanixTemplateActionsV2Create(ctx context.Context, d *schema.Resou
 		taskconn := meta.(*conns.Client).PrismAPI
 		// Wait for the VM to be available
 		stateConf := &resource.StateChangeConf{
-			Pending: []string{"QUEUED", "RUNNING"},
-			Target:  []string{"SUCCEEDED"},
+			Pending: utils.TaskPendingStates,
+			Target:  utils.TaskTargetStates,
 			Refresh: taskStateRefreshPrismTaskGroupFunc(ctx, taskconn, utils.StringValue(taskUUID)),
 			Timeout: d.Timeout(schema.TimeoutCreate),
 		}
@@ -102,8 +102,8 @@ func ResourceNutanixTemplateActionsV2Create(ctx context.Context, d *schema.Resou
 		taskconn := meta.(*conns.Client).PrismAPI
 		// Wait for the VM to be available
 		stateConf := &resource.StateChangeConf{
-			Pending: []string{"QUEUED", "RUNNING"},
-			Target:  []string{"SUCCEEDED"},
+			Pending: utils.TaskPendingStates,
+			Target:  utils.TaskTargetStates,
 			Refresh: taskStateRefreshPrismTaskGroupFunc(ctx, taskconn, utils.StringValue(taskUUID)),
 			Timeout: d.Timeout(schema.TimeoutCreate),
 		}
@@ -124,8 +124,8 @@ func ResourceNutanixTemplateActionsV2Create(ctx context.Context, d *schema.Resou
 		taskconn := meta.(*conns.Client).PrismAPI
 		// Wait for the VM to be available
 		stateConf := &resource.StateChangeConf{
-			Pending: []string{"QUEUED", "RUNNING"},
-			Target:  []string{"SUCCEEDED"},
+			Pending: utils.TaskPendingStates,
+			Target:  utils.TaskTargetStates,
 			Refresh: taskStateRefreshPrismTaskGroupFunc(ctx, taskconn, utils.StringValue(taskUUID)),
 			Timeout: d.Timeout(schema.TimeoutCreate),
 		}
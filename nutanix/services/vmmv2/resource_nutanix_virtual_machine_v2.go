@@ -1584,8 +1584,8 @@ func ResourceNutanixVirtualMachineV2Create(ctx context.Context, d *schema.Resour
 	taskconn := meta.(*conns.Client).PrismAPI
 	// Wait for the VM to be available
 	stateConf := &resource.StateChangeConf{
-		Pending: []string{"QUEUED", "RUNNING"},
-		Target:  []string{"SUCCEEDED"},
+		Pending: utils.TaskPendingStates,
+		Target:  utils.TaskTargetStates,
 		Refresh: taskStateRefreshPrismTaskGroupFunc(ctx, taskconn, utils.StringValue(taskUUID)),
 		Timeout: d.Timeout(schema.TimeoutCreate),
 	}
@@ -1639,8 +1639,8 @@ func ResourceNutanixVirtualMachineV2Create(ctx context.Context, d *schema.Resour
 
 	// Wait for the VM to be available
 	powerStateConf := &resource.StateChangeConf{
-		Pending: []string{"QUEUED", "RUNNING"},
-		Target:  []string{"SUCCEEDED"},
+		Pending: utils.TaskPendingStates,
+		Target:  utils.TaskTargetStates,
 		Refresh: taskStateRefreshPrismTaskGroupFunc(ctx, taskconn, utils.StringValue(powertaskUUID)),
 		Timeout: d.Timeout(schema.TimeoutCreate),
 	}
@@ -1691,6 +1691,11 @@ func ResourceNutanixVirtualMachineV2Read(ctx context.Context, d *schema.Resource
 
 	resp, err := conn.VMAPIInstance.GetVmById(utils.StringPtr(d.Id()))
 	if err != nil {
+		if utils.IsV4NotFound(err) {
+			log.Printf("[DEBUG] vm %s not found, removing it from state", d.Id())
+			d.SetId("")
+			return nil
+		}
 		return diag.Errorf("error while fetching vm : %v", err)
 	}
 
@@ -2013,8 +2018,8 @@ func ResourceNutanixVirtualMachineV2Update(ctx context.Context, d *schema.Resour
 		taskconn := meta.(*conns.Client).PrismAPI
 		// Wait for the VM to be available
 		stateConf := &resource.StateChangeConf{
-			Pending: []string{"QUEUED", "RUNNING"},
-			Target:  []string{"SUCCEEDED"},
+			Pending: utils.TaskPendingStates,
+			Target:  utils.TaskTargetStates,
 			Refresh: taskStateRefreshPrismTaskGroupFunc(ctx, taskconn, utils.StringValue(taskUUID)),
 			Timeout: d.Timeout(schema.TimeoutCreate),
 		}
@@ -2055,8 +2060,8 @@ func ResourceNutanixVirtualMachineV2Update(ctx context.Context, d *schema.Resour
 				taskconn := meta.(*conns.Client).PrismAPI
 				// Wait for the VM to be available
 				stateConf := &resource.StateChangeConf{
-					Pending: []string{"QUEUED", "RUNNING"},
-					Target:  []string{"SUCCEEDED"},
+					Pending: utils.TaskPendingStates,
+					Target:  utils.TaskTargetStates,
 					Refresh: taskStateRefreshPrismTaskGroupFunc(ctx, taskconn, utils.StringValue(taskUUID)),
 					Timeout: d.Timeout(schema.TimeoutCreate),
 				}
@@ -2092,8 +2097,8 @@ func ResourceNutanixVirtualMachineV2Update(ctx context.Context, d *schema.Resour
 				taskconn := meta.(*conns.Client).PrismAPI
 				// Wait for the VM to be available
 				stateConf := &resource.StateChangeConf{
-					Pending: []string{"QUEUED", "RUNNING"},
-					Target:  []string{"SUCCEEDED"},
+					Pending: utils.TaskPendingStates,
+					Target:  utils.TaskTargetStates,
 					Refresh: taskStateRefreshPrismTaskGroupFunc(ctx, taskconn, utils.StringValue(taskUUID)),
 					Timeout: d.Timeout(schema.TimeoutCreate),
 				}
@@ -2128,8 +2133,8 @@ func ResourceNutanixVirtualMachineV2Update(ctx context.Context, d *schema.Resour
 				taskconn := meta.(*conns.Client).PrismAPI
 				// Wait for the VM to be available
 				stateConf := &resource.StateChangeConf{
-					Pending: []string{"QUEUED", "RUNNING"},
-					Target:  []string{"SUCCEEDED"},
+					Pending: utils.TaskPendingStates,
+					Target:  utils.TaskTargetStates,
 					Refresh: taskStateRefreshPrismTaskGroupFunc(ctx, taskconn, utils.StringValue(taskUUID)),
 					Timeout: d.Timeout(schema.TimeoutCreate),
 				}
@@ -2171,8 +2176,8 @@ func ResourceNutanixVirtualMachineV2Update(ctx context.Context, d *schema.Resour
 				taskconn := meta.(*conns.Client).PrismAPI
 				// Wait for the VM to be available
 				stateConf := &resource.StateChangeConf{
-					Pending: []string{"QUEUED", "RUNNING"},
-					Target:  []string{"SUCCEEDED"},
+					Pending: utils.TaskPendingStates,
+					Target:  utils.TaskTargetStates,
 					Refresh: taskStateRefreshPrismTaskGroupFunc(ctx, taskconn, utils.StringValue(taskUUID)),
 					Timeout: d.Timeout(schema.TimeoutCreate),
 				}
@@ -2208,8 +2213,8 @@ func ResourceNutanixVirtualMachineV2Update(ctx context.Context, d *schema.Resour
 				taskconn := meta.(*conns.Client).PrismAPI
 				// Wait for the VM to be available
 				stateConf := &resource.StateChangeConf{
-					Pending: []string{"QUEUED", "RUNNING"},
-					Target:  []string{"SUCCEEDED"},
+					Pending: utils.TaskPendingStates,
+					Target:  utils.TaskTargetStates,
 					Refresh: taskStateRefreshPrismTaskGroupFunc(ctx, taskconn, utils.StringValue(taskUUID)),
 					Timeout: d.Timeout(schema.TimeoutCreate),
 				}
@@ -2243,8 +2248,8 @@ func ResourceNutanixVirtualMachineV2Update(ctx context.Context, d *schema.Resour
 				taskconn := meta.(*conns.Client).PrismAPI
 				// Wait for the VM to be available
 				stateConf := &resource.StateChangeConf{
-					Pending: []string{"QUEUED", "RUNNING"},
-					Target:  []string{"SUCCEEDED"},
+					Pending: utils.TaskPendingStates,
+					Target:  utils.TaskTargetStates,
 					Refresh: taskStateRefreshPrismTaskGroupFunc(ctx, taskconn, utils.StringValue(taskUUID)),
 					Timeout: d.Timeout(schema.TimeoutCreate),
 				}
@@ -2283,8 +2288,8 @@ func ResourceNutanixVirtualMachineV2Update(ctx context.Context, d *schema.Resour
 				taskconn := meta.(*conns.Client).PrismAPI
 				// Wait for the VM to be available
 				stateConf := &resource.StateChangeConf{
-					Pending: []string{"QUEUED", "RUNNING"},
-					Target:  []string{"SUCCEEDED"},
+					Pending: utils.TaskPendingStates,
+					Target:  utils.TaskTargetStates,
 					Refresh: taskStateRefreshPrismTaskGroupFunc(ctx, taskconn, utils.StringValue(taskUUID)),
 					Timeout: d.Timeout(schema.TimeoutCreate),
 				}
@@ -2321,8 +2326,8 @@ func ResourceNutanixVirtualMachineV2Update(ctx context.Context, d *schema.Resour
 				taskconn := meta.(*conns.Client).PrismAPI
 				// Wait for the VM to be available
 				stateConf := &resource.StateChangeConf{
-					Pending: []string{"QUEUED", "RUNNING"},
-					Target:  []string{"SUCCEEDED"},
+					Pending: utils.TaskPendingStates,
+					Target:  utils.TaskTargetStates,
 					Refresh: taskStateRefreshPrismTaskGroupFunc(ctx, taskconn, utils.StringValue(taskUUID)),
 					Timeout: d.Timeout(schema.TimeoutCreate),
 				}
@@ -2364,8 +2369,8 @@ func ResourceNutanixVirtualMachineV2Update(ctx context.Context, d *schema.Resour
 				taskconn := meta.(*conns.Client).PrismAPI
 				// Wait for the VM to be available
 				stateConf := &resource.StateChangeConf{
-					Pending: []string{"QUEUED", "RUNNING"},
-					Target:  []string{"SUCCEEDED"},
+					Pending: utils.TaskPendingStates,
+					Target:  utils.TaskTargetStates,
 					Refresh: taskStateRefreshPrismTaskGroupFunc(ctx, taskconn, utils.StringValue(taskUUID)),
 					Timeout: d.Timeout(schema.TimeoutCreate),
 				}
@@ -2401,8 +2406,8 @@ func ResourceNutanixVirtualMachineV2Update(ctx context.Context, d *schema.Resour
 				taskconn := meta.(*conns.Client).PrismAPI
 				// Wait for the VM to be available
 				stateConf := &resource.StateChangeConf{
-					Pending: []string{"QUEUED", "RUNNING"},
-					Target:  []string{"SUCCEEDED"},
+					Pending: utils.TaskPendingStates,
+					Target:  utils.TaskTargetStates,
 					Refresh: taskStateRefreshPrismTaskGroupFunc(ctx, taskconn, utils.StringValue(taskUUID)),
 					Timeout: d.Timeout(schema.TimeoutCreate),
 				}
@@ -2436,8 +2441,8 @@ func ResourceNutanixVirtualMachineV2Update(ctx context.Context, d *schema.Resour
 				taskconn := meta.(*conns.Client).PrismAPI
 				// Wait for the VM to be available
 				stateConf := &resource.StateChangeConf{
-					Pending: []string{"QUEUED", "RUNNING"},
-					Target:  []string{"SUCCEEDED"},
+					Pending: utils.TaskPendingStates,
+					Target:  utils.TaskTargetStates,
 					Refresh: taskStateRefreshPrismTaskGroupFunc(ctx, taskconn, utils.StringValue(taskUUID)),
 					Timeout: d.Timeout(schema.TimeoutCreate),
 				}
@@ -2477,8 +2482,8 @@ func ResourceNutanixVirtualMachineV2Update(ctx context.Context, d *schema.Resour
 				taskconn := meta.(*conns.Client).PrismAPI
 				// Wait for the VM to be available
 				stateConf := &resource.StateChangeConf{
-					Pending: []string{"QUEUED", "RUNNING"},
-					Target:  []string{"SUCCEEDED"},
+					Pending: utils.TaskPendingStates,
+					Target:  utils.TaskTargetStates,
 					Refresh: taskStateRefreshPrismTaskGroupFunc(ctx, taskconn, utils.StringValue(taskUUID)),
 					Timeout: d.Timeout(schema.TimeoutCreate),
 				}
@@ -2514,8 +2519,8 @@ func ResourceNutanixVirtualMachineV2Update(ctx context.Context, d *schema.Resour
 				taskconn := meta.(*conns.Client).PrismAPI
 				// Wait for the VM to be available
 				stateConf := &resource.StateChangeConf{
-					Pending: []string{"QUEUED", "RUNNING"},
-					Target:  []string{"SUCCEEDED"},
+					Pending: utils.TaskPendingStates,
+					Target:  utils.TaskTargetStates,
 					Refresh: taskStateRefreshPrismTaskGroupFunc(ctx, taskconn, utils.StringValue(taskUUID)),
 					Timeout: d.Timeout(schema.TimeoutCreate),
 				}
@@ -2555,8 +2560,8 @@ func ResourceNutanixVirtualMachineV2Update(ctx context.Context, d *schema.Resour
 			taskconn := meta.(*conns.Client).PrismAPI
 			// Wait for the VM to be available
 			stateConf := &resource.StateChangeConf{
-				Pending: []string{"QUEUED", "RUNNING"},
-				Target:  []string{"SUCCEEDED"},
+				Pending: utils.TaskPendingStates,
+				Target:  utils.TaskTargetStates,
 				Refresh: taskStateRefreshPrismTaskGroupFunc(ctx, taskconn, utils.StringValue(taskUUID)),
 				Timeout: d.Timeout(schema.TimeoutCreate),
 			}
@@ -2590,8 +2595,8 @@ func ResourceNutanixVirtualMachineV2Update(ctx context.Context, d *schema.Resour
 			taskconn := meta.(*conns.Client).PrismAPI
 			// Wait for the VM to be available
 			stateConf := &resource.StateChangeConf{
-				Pending: []string{"QUEUED", "RUNNING"},
-				Target:  []string{"SUCCEEDED"},
+				Pending: utils.TaskPendingStates,
+				Target:  utils.TaskTargetStates,
 				Refresh: taskStateRefreshPrismTaskGroupFunc(ctx, taskconn, utils.StringValue(taskUUID)),
 				Timeout: d.Timeout(schema.TimeoutCreate),
 			}
@@ -2650,8 +2655,8 @@ func ResourceNutanixVirtualMachineV2Delete(ctx context.Context, d *schema.Resour
 	taskconn := meta.(*conns.Client).PrismAPI
 	// Wait for the VM to be available
 	stateConf := &resource.StateChangeConf{
-		Pending: []string{"QUEUED", "RUNNING"},
-		Target:  []string{"SUCCEEDED"},
+		Pending: utils.TaskPendingStates,
+		Target:  utils.TaskTargetStates,
 		Refresh: taskStateRefreshPrismTaskGroupFunc(ctx, taskconn, utils.StringValue(taskUUID)),
 		Timeout: d.Timeout(schema.TimeoutCreate),
 	}
@@ -3469,8 +3474,8 @@ func callForPowerOffVM(ctx context.Context, conn *vmm.Client, d *schema.Resource
 
 	// Wait for the VM to be available
 	stateConf := &resource.StateChangeConf{
-		Pending: []string{"QUEUED", "RUNNING"},
-		Target:  []string{"SUCCEEDED"},
+		Pending: utils.TaskPendingStates,
+		Target:  utils.TaskTargetStates,
 		Refresh: taskStateRefreshPrismTaskGroupFunc(ctx, prismConn, utils.StringValue(taskUUID)),
 		Timeout: d.Timeout(schema.TimeoutCreate),
 	}
@@ -3513,8 +3518,8 @@ func callForPowerOnVM(ctx context.Context, conn *vmm.Client, d *schema.ResourceD
 
 	// Wait for the VM to be available
 	stateConf := &resource.StateChangeConf{
-		Pending: []string{"QUEUED", "RUNNING"},
-		Target:  []string{"SUCCEEDED"},
+		Pending: utils.TaskPendingStates,
+		Target:  utils.TaskTargetStates,
 		Refresh: taskStateRefreshPrismTaskGroupFunc(ctx, prismConn, utils.StringValue(taskUUID)),
 		Timeout: d.Timeout(schema.TimeoutCreate),
 	}
@@ -0,0 +1,108 @@
+package vmmv2
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	dataprotectionConfig "github.com/nutanix/ntnx-api-golang-clients/dataprotection-go-client/v4/models/dataprotection/v4/config"
+	conns "github.com/terraform-providers/terraform-provider-nutanix/nutanix"
+	"github.com/terraform-providers/terraform-provider-nutanix/utils"
+)
+
+// ResourceNutanixVMRecoveryPointRevertV2 reverts an existing VM in place to its
+// member of a top level recovery point. A revert is destructive and one-shot,
+// every argument forces a new revert and delete only removes the resource from state.
+func ResourceNutanixVMRecoveryPointRevertV2() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: ResourceNutanixVMRecoveryPointRevertV2Create,
+		ReadContext:   ResourceNutanixVMRecoveryPointRevertV2Read,
+		DeleteContext: ResourceNutanixVMRecoveryPointRevertV2Delete,
+		Schema: map[string]*schema.Schema{
+			"vm_ext_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"recovery_point_ext_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"confirm": {
+				Type:     schema.TypeBool,
+				Required: true,
+				ForceNew: true,
+			},
+			"vm_recovery_point_ext_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func ResourceNutanixVMRecoveryPointRevertV2Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf("[DEBUG] ResourceNutanixVMRecoveryPointRevertV2Create \n")
+
+	vmExtID := d.Get("vm_ext_id").(string)
+	recoveryPointExtID := d.Get("recovery_point_ext_id").(string)
+
+	if !d.Get("confirm").(bool) {
+		return diag.Errorf("reverting vm %s overwrites its current state, set confirm = true to revert it to recovery point %s", vmExtID, recoveryPointExtID)
+	}
+
+	vmRecoveryPointExtID, err := findVMRecoveryPointExtID(meta, recoveryPointExtID, vmExtID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	task, err := revertVMToRecoveryPoint(ctx, meta, vmExtID, vmRecoveryPointExtID, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err = d.Set("vm_recovery_point_ext_id", vmRecoveryPointExtID); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("status", getTaskStatus(task.Status)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(utils.StringValue(task.ExtId))
+	return ResourceNutanixVMRecoveryPointRevertV2Read(ctx, d, meta)
+}
+
+func ResourceNutanixVMRecoveryPointRevertV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return nil
+}
+
+func ResourceNutanixVMRecoveryPointRevertV2Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// the revert cannot be undone, only forget it
+	return nil
+}
+
+// findVMRecoveryPointExtID returns the ext id of the VM recovery point of vmExtID
+// within the top level recovery point recoveryPointExtID.
+func findVMRecoveryPointExtID(meta interface{}, recoveryPointExtID, vmExtID string) (string, error) {
+	conn := meta.(*conns.Client).DataProtectionAPI
+
+	resp, err := conn.RecoveryPoint.GetRecoveryPointById(utils.StringPtr(recoveryPointExtID))
+	if err != nil {
+		return "", fmt.Errorf("error while fetching recovery point %s : %v", recoveryPointExtID, err)
+	}
+	recoveryPoint := resp.Data.GetValue().(dataprotectionConfig.RecoveryPoint)
+
+	for _, vmRecoveryPoint := range recoveryPoint.VmRecoveryPoints {
+		if utils.StringValue(vmRecoveryPoint.VmExtId) == vmExtID {
+			return utils.StringValue(vmRecoveryPoint.ExtId), nil
+		}
+	}
+	return "", fmt.Errorf("recovery point %s does not contain a recovery point of vm %s", recoveryPointExtID, vmExtID)
+}
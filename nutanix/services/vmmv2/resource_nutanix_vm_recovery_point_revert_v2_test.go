@@ -0,0 +1,54 @@
+package vmmv2_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	acc "github.com/terraform-providers/terraform-provider-nutanix/nutanix/acctest"
+)
+
+const resourceNameVMRecoveryPointRevert = "nutanix_vm_recovery_point_revert_v2.test"
+
+func TestAccV2NutanixVMRecoveryPointRevertResource_basic(t *testing.T) {
+	r := acctest.RandInt()
+	name := fmt.Sprintf("tf-test-recovery-point-%d", r)
+
+	// End time is two week later
+	expirationTime := time.Now().Add(14 * 24 * time.Hour)
+
+	expirationTimeFormatted := expirationTime.UTC().Format(time.RFC3339)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccFoundationPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			// revert is refused without confirm
+			{
+				Config:      testVMRecoveryPointRevertResourceConfig(name, expirationTimeFormatted, false),
+				ExpectError: regexp.MustCompile("set confirm = true"),
+			},
+			{
+				Config: testVMRecoveryPointRevertResourceConfig(name, expirationTimeFormatted, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceNameVMRecoveryPointRevert, "vm_ext_id", "nutanix_virtual_machine_v2.test", "id"),
+					resource.TestCheckResourceAttrPair(resourceNameVMRecoveryPointRevert, "vm_recovery_point_ext_id", resourceNameRecoveryPoint, "vm_recovery_points.0.ext_id"),
+					resource.TestCheckResourceAttr(resourceNameVMRecoveryPointRevert, "status", "SUCCEEDED"),
+				),
+			},
+		},
+	})
+}
+
+func testVMRecoveryPointRevertResourceConfig(name, expirationTime string, confirm bool) string {
+	return testRecoveryPointsResourceConfigWithVMRecoveryPoints(name, expirationTime) + fmt.Sprintf(`
+		resource "nutanix_vm_recovery_point_revert_v2" "test" {
+		  vm_ext_id             = nutanix_virtual_machine_v2.test.id
+		  recovery_point_ext_id = nutanix_recovery_points_v2.test.id
+		  confirm               = %t
+		}
+     `, confirm)
+}
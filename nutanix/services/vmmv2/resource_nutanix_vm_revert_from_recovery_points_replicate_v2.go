@@ -3,7 +3,9 @@ package vmmv2
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"log"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -42,27 +44,42 @@ func ResourceNutanixRevertVMRecoveryPointV2() *schema.Resource {
 func ResourceNutanixRevertVMRecoveryPointV2Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	log.Printf("[DEBUG] ResourceNutanixRevertVMRecoveryPointV2Create \n")
 
-	conn := meta.(*conns.Client).VmmAPI
+	vmRecoveryPointExtID := d.Get("vm_recovery_point_ext_id").(string)
 
-	extID := d.Get("ext_id")
+	rUUID, err := revertVMToRecoveryPoint(ctx, meta, d.Get("ext_id").(string), vmRecoveryPointExtID, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
-	readResp, err := conn.VMAPIInstance.GetVmById(utils.StringPtr(extID.(string)))
+	if err = d.Set("status", getTaskStatus(rUUID.Status)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	uuid := rUUID.CompletionDetails[0].Value
+	d.SetId(uuid.GetValue().(string))
+
+	return ResourceNutanixRevertVMRecoveryPointV2Read(ctx, d, meta)
+}
+
+// revertVMToRecoveryPoint reverts the VM vmExtID in place to the VM recovery point
+// vmRecoveryPointExtID and waits for the revert task to finish.
+func revertVMToRecoveryPoint(ctx context.Context, meta interface{}, vmExtID, vmRecoveryPointExtID string, timeout time.Duration) (*prismConfig.Task, error) {
+	conn := meta.(*conns.Client).VmmAPI
+
+	readResp, err := conn.VMAPIInstance.GetVmById(utils.StringPtr(vmExtID))
 	if err != nil {
-		return diag.Errorf("error while fetching Vm : %v", err)
+		return nil, fmt.Errorf("error while fetching Vm : %v", err)
 	}
 	args := make(map[string]interface{})
 	args["If-Match"] = getEtagHeader(readResp, conn)
 
-	body := config.RevertParams{}
-	rpExtID := d.Get("ext_id").(string)
-
-	if v, ok := d.GetOk("vm_recovery_point_ext_id"); ok {
-		body.VmRecoveryPointExtId = utils.StringPtr(v.(string))
+	body := config.RevertParams{
+		VmRecoveryPointExtId: utils.StringPtr(vmRecoveryPointExtID),
 	}
 
-	resp, err := conn.VMAPIInstance.RevertVm(utils.StringPtr(rpExtID), &body, args)
+	resp, err := conn.VMAPIInstance.RevertVm(utils.StringPtr(vmExtID), &body, args)
 	if err != nil {
-		return diag.Errorf("error while reverting vm : %v", err)
+		return nil, fmt.Errorf("error while reverting vm : %v", err)
 	}
 
 	TaskRef := resp.Data.GetValue().(vmmPrismConfig.TaskReference)
@@ -71,35 +88,28 @@ func ResourceNutanixRevertVMRecoveryPointV2Create(ctx context.Context, d *schema
 	taskconn := meta.(*conns.Client).PrismAPI
 	// Wait for the cluster to be available
 	stateConf := &resource.StateChangeConf{
-		Pending: []string{"PENDING", "RUNNING", "QUEUED"},
-		Target:  []string{"SUCCEEDED"},
+		Pending: utils.TaskPendingStates,
+		Target:  utils.TaskTargetStates,
 		Refresh: taskStateRefreshPrismTaskGroupFunc(ctx, taskconn, utils.StringValue(taskUUID)),
-		Timeout: d.Timeout(schema.TimeoutCreate),
+		Timeout: timeout,
 	}
 
 	if _, errWaitTask := stateConf.WaitForStateContext(ctx); errWaitTask != nil {
-		return diag.Errorf("error waiting for vm: (%s) to revert: %s", utils.StringValue(taskUUID), errWaitTask)
+		return nil, fmt.Errorf("error waiting for vm: (%s) to revert: %s", utils.StringValue(taskUUID), errWaitTask)
 	}
 
 	// Get UUID from TASK API
 
 	resourceUUID, err := taskconn.TaskRefAPI.GetTaskById(taskUUID, nil)
 	if err != nil {
-		return diag.Errorf("error while fetching revert vm UUID : %v", err)
+		return nil, fmt.Errorf("error while fetching revert vm UUID : %v", err)
 	}
 	rUUID := resourceUUID.Data.GetValue().(prismConfig.Task)
 
 	aJSON, _ := json.Marshal(rUUID)
 	log.Printf("[DEBUG] revert vm task Details: %v", string(aJSON))
 
-	if err = d.Set("status", getTaskStatus(rUUID.Status)); err != nil {
-		return diag.FromErr(err)
-	}
-
-	uuid := rUUID.CompletionDetails[0].Value
-	d.SetId(uuid.GetValue().(string))
-
-	return ResourceNutanixRevertVMRecoveryPointV2Read(ctx, d, meta)
+	return &rUUID, nil
 }
 
 func ResourceNutanixRevertVMRecoveryPointV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -19,6 +19,7 @@ func DatasourceNutanixVolumeDisksV2() *schema.Resource {
 		Description: "Query the list of disks corresponding to a Volume Group identified by {volumeGroupExtId}.",
 		ReadContext: DatasourceNutanixVolumeDisksV2Read,
 		Schema: map[string]*schema.Schema{
+			"refresh_token": schemaForRefreshToken(),
 			"volume_group_ext_id": {
 				Description: "The external identifier of the Volume Group.",
 				Type:        schema.TypeString,
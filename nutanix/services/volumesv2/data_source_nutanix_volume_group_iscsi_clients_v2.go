@@ -0,0 +1,189 @@
+package volumesv2
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	volumesClient "github.com/nutanix/ntnx-api-golang-clients/volumes-go-client/v4/models/volumes/v4/config"
+	conns "github.com/terraform-providers/terraform-provider-nutanix/nutanix"
+	"github.com/terraform-providers/terraform-provider-nutanix/utils"
+)
+
+// List the iSCSI clients attached to a Volume Group.
+func DatasourceNutanixVolumeGroupIscsiClientsV2() *schema.Resource {
+	return &schema.Resource{
+		Description: "Lists the iSCSI clients attached to the Volume Group identified by {volume_group_ext_id}, with their initiator, authentication and target connection.",
+		ReadContext: DatasourceNutanixVolumeGroupIscsiClientsV2Read,
+		Schema: map[string]*schema.Schema{
+			"refresh_token": schemaForRefreshToken(),
+			"volume_group_ext_id": {
+				Description: "The external identifier of the Volume Group.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"target_name": {
+				Description: "Name of the external client target of the Volume Group.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"enabled_authentications": {
+				Description: "The authentication type enabled for the Volume Group.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"iscsi_clients": {
+				Description: "The iSCSI clients attached to the Volume Group.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ext_id": {
+							Description: "The external identifier of the iSCSI client.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"cluster_reference": {
+							Description: "The UUID of the cluster that hosts the iSCSI client.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"iscsi_initiator_name": {
+							Description: "iSCSI initiator name (IQN) of the client.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"iscsi_initiator_network_id": {
+							Description: "The address of the client, when it is attached by IP address or FQDN instead of initiator name.",
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"ipv4": SchemaForIPV4ValuePrefixLength(),
+									"ipv6": SchemaForIPV6ValuePrefixLength(),
+									"fqdn": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"value": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"enabled_authentications": {
+							Description: "The authentication type enabled for the iSCSI client.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"is_chap_enabled": {
+							Description: "Whether the iSCSI client authenticates with CHAP.",
+							Type:        schema.TypeBool,
+							Computed:    true,
+						},
+						"attachment_site": {
+							Description: "The site where the attachment was processed, PRIMARY or SECONDARY with Metro DR.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"is_target_connected": {
+							Description: "Whether one of the attached targets of the iSCSI client is the target of the Volume Group.",
+							Type:        schema.TypeBool,
+							Computed:    true,
+						},
+						"attached_targets": {
+							Description: "The iSCSI targets the client is connected to.",
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"num_virtual_targets": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+									"iscsi_target_name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func DatasourceNutanixVolumeGroupIscsiClientsV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.Client).VolumeAPI
+
+	volumeGroupExtID := d.Get("volume_group_ext_id").(string)
+
+	resp, err := conn.VolumeAPIInstance.GetVolumeGroupById(utils.StringPtr(volumeGroupExtID))
+	if err != nil {
+		return diag.Errorf("error while fetching Volume Group : %v", err)
+	}
+	volumeGroup := resp.Data.GetValue().(volumesClient.VolumeGroup)
+	targetName := utils.StringValue(volumeGroup.TargetName)
+
+	_, attachments, err := listVolumeGroupAttachments(conn, volumeGroupExtID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// the attachments only reference the clients, the initiator and the
+	// targets are read from each client
+	iscsiClients := make([]interface{}, 0, len(attachments))
+	for _, attachment := range attachments {
+		clientResp, err := conn.IscsiClientAPIInstance.GetIscsiClientById(attachment.ExtId)
+		if err != nil {
+			return diag.Errorf("error while fetching Iscsi Client %s : %v", utils.StringValue(attachment.ExtId), err)
+		}
+		iscsiClients = append(iscsiClients, flattenVolumeGroupIscsiClient(clientResp.Data.GetValue().(volumesClient.IscsiClient), targetName))
+	}
+
+	if err := d.Set("target_name", targetName); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("enabled_authentications", flattenEnabledAuthentications(volumeGroup.EnabledAuthentications)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("iscsi_clients", iscsiClients); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(volumeGroupExtID)
+	return nil
+}
+
+func flattenVolumeGroupIscsiClient(iscsiClient volumesClient.IscsiClient, targetName string) map[string]interface{} {
+	enabledAuthentications := flattenEnabledAuthentications(iscsiClient.EnabledAuthentications)
+
+	// the virtual targets of a Volume Group are named after its target name
+	isTargetConnected := false
+	for _, target := range iscsiClient.AttachedTargets {
+		if targetName != "" && strings.HasPrefix(utils.StringValue(target.IscsiTargetName), targetName) {
+			isTargetConnected = true
+			break
+		}
+	}
+
+	return map[string]interface{}{
+		"ext_id":                     utils.StringValue(iscsiClient.ExtId),
+		"cluster_reference":          utils.StringValue(iscsiClient.ClusterReference),
+		"iscsi_initiator_name":       utils.StringValue(iscsiClient.IscsiInitiatorName),
+		"iscsi_initiator_network_id": flattenIscsiInitiatorNetworkID(iscsiClient.IscsiInitiatorNetworkId),
+		"enabled_authentications":    enabledAuthentications,
+		"is_chap_enabled":            enabledAuthentications == "CHAP",
+		"attachment_site":            flattenAttachmentSite(iscsiClient.AttachmentSite),
+		"is_target_connected":        isTargetConnected,
+		"attached_targets":           flattenAttachedTargets(iscsiClient.AttachedTargets),
+	}
+}
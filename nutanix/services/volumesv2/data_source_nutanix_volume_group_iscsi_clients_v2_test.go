@@ -0,0 +1,40 @@
+package volumesv2_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	acc "github.com/terraform-providers/terraform-provider-nutanix/nutanix/acctest"
+)
+
+const dataSourceVolumeGroupIscsiClients = "data.nutanix_volume_group_iscsi_clients_v2.test"
+
+func TestAccV2NutanixVolumeGroupIscsiClientsDataSource_Basic(t *testing.T) {
+	r := acctest.RandInt()
+	name := fmt.Sprintf("tf-test-volume-group-%d", r)
+	desc := "test volume group Iscsi Clients description"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVolumeGroupResourceConfig(name, desc) + testAccVolumeGroupIscsiClientResourceConfig() + `
+					data "nutanix_volume_group_iscsi_clients_v2" "test" {
+						volume_group_ext_id = nutanix_volume_group_v2.test.id
+						depends_on          = [nutanix_volume_group_iscsi_client_v2.test]
+					}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceVolumeGroupIscsiClients, "iscsi_clients.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceVolumeGroupIscsiClients, "iscsi_clients.0.ext_id", resourceVolumeGroupIscsiClient, "ext_id"),
+					resource.TestCheckResourceAttrPair(dataSourceVolumeGroupIscsiClients, "iscsi_clients.0.iscsi_initiator_name", resourceVolumeGroupIscsiClient, "iscsi_initiator_name"),
+					resource.TestCheckResourceAttrPair(dataSourceVolumeGroupIscsiClients, "target_name", resourceNameVolumeGroup, "target_name"),
+					resource.TestCheckResourceAttrSet(dataSourceVolumeGroupIscsiClients, "iscsi_clients.0.is_chap_enabled"),
+				),
+			},
+		},
+	})
+}
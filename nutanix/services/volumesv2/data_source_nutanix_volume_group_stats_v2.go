@@ -0,0 +1,219 @@
+package volumesv2
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	volumesCommonStats "github.com/nutanix/ntnx-api-golang-clients/volumes-go-client/v4/models/common/v1/stats"
+	volumesStats "github.com/nutanix/ntnx-api-golang-clients/volumes-go-client/v4/models/volumes/v4/stats"
+	conns "github.com/terraform-providers/terraform-provider-nutanix/nutanix"
+	"github.com/terraform-providers/terraform-provider-nutanix/utils"
+)
+
+// Query the controller stats of a Volume Group over a time window.
+func DatasourceNutanixVolumeGroupStatsV2() *schema.Resource {
+	return &schema.Resource{
+		Description: "Query the controller IOPS, bandwidth and latency stats of a Volume Group over a time window.",
+		ReadContext: DatasourceNutanixVolumeGroupStatsV2Read,
+		Schema: map[string]*schema.Schema{
+			"ext_id": {
+				Description: "The external identifier of the Volume Group.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"start_time": {
+				Description: "The start time of the period for which stats should be reported, in RFC3339 format.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"end_time": {
+				Description: "The end time of the period for which stats should be reported, in RFC3339 format.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"sampling_interval": {
+				Description: "The sampling interval in seconds at which statistical data should be collected.",
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     1,
+			},
+			"stat_type": {
+				Description:  "The operator to use while performing down-sampling on stats data. Possible values [AVG, MIN, MAX, LAST, SUM, COUNT]",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"AVG", "MIN", "MAX", "LAST", "SUM", "COUNT"}, false),
+			},
+			"volume_group_ext_id": {
+				Description: "Uuid of the Volume Group.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"tenant_id": {
+				Description: "A globally unique identifier that represents the tenant that owns this entity.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"links": {
+				Description: "A HATEOAS style link for the response.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"href": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"rel": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"controller_num_iops":                   schemaForVolumeGroupStat("Controller I/O rate measured in iops."),
+			"controller_num_read_iops":              schemaForVolumeGroupStat("Controller read I/O measured in iops."),
+			"controller_num_write_iops":             schemaForVolumeGroupStat("Controller write I/O measured in iops."),
+			"controller_io_bandwidth_kbps":          schemaForVolumeGroupStat("Controller I/O bandwidth measured in Kbps."),
+			"controller_read_io_bandwidth_kbps":     schemaForVolumeGroupStat("Controller read I/O bandwidth measured in Kbps."),
+			"controller_write_io_bandwidth_kbps":    schemaForVolumeGroupStat("Controller write I/O bandwidth measured in Kbps."),
+			"controller_avg_io_latency_usecs":       schemaForVolumeGroupStat("Controller average I/O latency measured in microseconds."),
+			"controller_avg_read_io_latency_usecs":  schemaForVolumeGroupStat("Controller average read I/O latency measured in microseconds."),
+			"controller_avg_write_io_latency_usecs": schemaForVolumeGroupStat("Controller average write I/O latency measured in microseconds."),
+			"controller_user_bytes":                 schemaForVolumeGroupStat("Controller user bytes."),
+		},
+	}
+}
+
+func DatasourceNutanixVolumeGroupStatsV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.Client).VolumeAPI
+
+	extID := d.Get("ext_id").(string)
+	samplingInterval := d.Get("sampling_interval").(int)
+
+	if samplingInterval <= 0 {
+		return diag.Errorf("sampling_interval should be greater than 0")
+	}
+
+	startTime, err := time.Parse(time.RFC3339, d.Get("start_time").(string))
+	if err != nil {
+		return diag.Errorf("error while parsing start_time : %v", err)
+	}
+	endTime, err := time.Parse(time.RFC3339, d.Get("end_time").(string))
+	if err != nil {
+		return diag.Errorf("error while parsing end_time : %v", err)
+	}
+	if !endTime.After(startTime) {
+		return diag.Errorf("end_time should be after start_time")
+	}
+
+	const two, three, four, five, six, seven = 2, 3, 4, 5, 6, 7
+	statType := volumesCommonStats.DownSamplingOperator(seven) // Default value is LAST, Aggregation containing only the last recorded value.
+
+	subMap := map[string]interface{}{
+		"SUM":   two,
+		"MIN":   three,
+		"MAX":   four,
+		"AVG":   five,
+		"COUNT": six,
+		"LAST":  seven,
+	}
+	pVal := subMap[d.Get("stat_type").(string)]
+	if pVal != nil {
+		statType = volumesCommonStats.DownSamplingOperator(pVal.(int))
+	}
+
+	resp, err := conn.VolumeAPIInstance.GetVolumeGroupStats(utils.StringPtr(extID), &startTime, &endTime, utils.IntPtr(samplingInterval), &statType, nil)
+	if err != nil {
+		return diag.Errorf("error while fetching Volume Group stats : %v", err)
+	}
+
+	if resp.Data == nil {
+		return diag.Errorf("no stats returned for Volume Group %s", extID)
+	}
+	getResp := resp.Data.GetValue().(volumesStats.VolumeGroupStats)
+
+	if err := d.Set("volume_group_ext_id", getResp.VolumeGroupExtId); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("tenant_id", getResp.TenantId); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("links", flattenLinks(getResp.Links)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("controller_num_iops", flattenTimeValuePairs(getResp.ControllerNumIOPS)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("controller_num_read_iops", flattenTimeValuePairs(getResp.ControllerNumReadIOPS)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("controller_num_write_iops", flattenTimeValuePairs(getResp.ControllerNumWriteIOPS)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("controller_io_bandwidth_kbps", flattenTimeValuePairs(getResp.ControllerIOBandwidthKBps)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("controller_read_io_bandwidth_kbps", flattenTimeValuePairs(getResp.ControllerReadIOBandwidthKBps)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("controller_write_io_bandwidth_kbps", flattenTimeValuePairs(getResp.ControllerWriteIOBandwidthKBps)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("controller_avg_io_latency_usecs", flattenTimeValuePairs(getResp.ControllerAvgIOLatencyUsecs)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("controller_avg_read_io_latency_usecs", flattenTimeValuePairs(getResp.ControllerAvgReadIOLatencyUsecs)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("controller_avg_write_io_latency_usecs", flattenTimeValuePairs(getResp.ControllerAvgWriteIOLatencyUsecs)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("controller_user_bytes", flattenTimeValuePairs(getResp.ControllerUserBytes)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(extID)
+	return nil
+}
+
+func schemaForVolumeGroupStat(description string) *schema.Schema {
+	return &schema.Schema{
+		Description: description,
+		Type:        schema.TypeList,
+		Computed:    true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"value": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				"timestamp": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+func flattenTimeValuePairs(timeValuePairs []volumesStats.TimeValuePair) []map[string]interface{} {
+	if len(timeValuePairs) > 0 {
+		timeValueList := make([]map[string]interface{}, len(timeValuePairs))
+
+		for k, v := range timeValuePairs {
+			timeValuePair := map[string]interface{}{}
+			if v.Value != nil {
+				timeValuePair["value"] = v.Value
+			}
+			if v.Timestamp != nil {
+				timeValuePair["timestamp"] = v.Timestamp.Format(time.RFC3339)
+			}
+			timeValueList[k] = timeValuePair
+		}
+		return timeValueList
+	}
+	return nil
+}
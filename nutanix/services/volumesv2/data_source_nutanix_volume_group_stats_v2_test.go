@@ -0,0 +1,68 @@
+package volumesv2_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	acc "github.com/terraform-providers/terraform-provider-nutanix/nutanix/acctest"
+)
+
+const dataSourceVolumeGroupStats = "data.nutanix_volume_group_stats_v2.test"
+
+func TestAccV2NutanixVolumeGroupStatsDataSource_Basic(t *testing.T) {
+	r := acctest.RandInt()
+	name := fmt.Sprintf("terraform-test-volume-group-stats-%d", r)
+	desc := "terraform test volume group stats description"
+
+	endTime := time.Now().UTC()
+	startTime := endTime.Add(-1 * time.Hour)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVolumeGroupStatsDataSourceConfig(name, desc, startTime.Format(time.RFC3339), endTime.Format(time.RFC3339), "AVG"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceVolumeGroupStats, "volume_group_ext_id", resourceNameVolumeGroup, "id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccV2NutanixVolumeGroupStatsDataSource_InvalidWindow(t *testing.T) {
+	r := acctest.RandInt()
+	name := fmt.Sprintf("terraform-test-volume-group-stats-%d", r)
+	desc := "terraform test volume group stats description"
+
+	startTime := time.Now().UTC()
+	endTime := startTime.Add(-1 * time.Hour)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccVolumeGroupStatsDataSourceConfig(name, desc, startTime.Format(time.RFC3339), endTime.Format(time.RFC3339), "AVG"),
+				ExpectError: regexp.MustCompile("end_time should be after start_time"),
+			},
+		},
+	})
+}
+
+func testAccVolumeGroupStatsDataSourceConfig(name, desc, startTime, endTime, statType string) string {
+	return testAccVolumeGroupResourceConfig(name, desc) + fmt.Sprintf(`
+		data "nutanix_volume_group_stats_v2" "test" {
+			ext_id            = resource.nutanix_volume_group_v2.test.id
+			start_time        = "%[1]s"
+			end_time          = "%[2]s"
+			sampling_interval = 60
+			stat_type         = "%[3]s"
+		}
+	`, startTime, endTime, statType)
+}
@@ -2,11 +2,14 @@ package volumesv2
 
 import (
 	"context"
+	"log"
+	"sort"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	volumesClient "github.com/nutanix/ntnx-api-golang-clients/volumes-go-client/v4/models/volumes/v4/config"
 	conns "github.com/terraform-providers/terraform-provider-nutanix/nutanix"
+	"github.com/terraform-providers/terraform-provider-nutanix/nutanix/sdks/v4/volumes"
 	"github.com/terraform-providers/terraform-provider-nutanix/utils"
 )
 
@@ -132,6 +135,16 @@ func DatasourceNutanixVolumeGroupV2() *schema.Resource {
 				Type:        schema.TypeBool,
 				Computed:    true,
 			},
+			"vm_attachments":           schemaForVMAttachments(),
+			"iscsi_client_attachments": schemaForIscsiClientAttachments(),
+			"allowed_initiators": {
+				Description: "The IQNs of the iSCSI initiators currently attached to the Volume Group, sorted. iSCSI clients identified by their network address only are not listed.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
 		},
 	}
 }
@@ -185,6 +198,113 @@ func DatasourceNutanixVolumeGroupV2Read(ctx context.Context, d *schema.ResourceD
 		return diag.FromErr(err)
 	}
 
+	vmAttachments, iscsiAttachments, err := listVolumeGroupAttachments(conn, utils.StringValue(getResp.ExtId))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("vm_attachments", flattenVMAttachments(vmAttachments)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("iscsi_client_attachments", flattenIscsiClientAttachments(iscsiAttachments)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("allowed_initiators", volumeGroupAllowedInitiators(conn, iscsiAttachments)); err != nil {
+		return diag.FromErr(err)
+	}
+
 	d.SetId(*getResp.ExtId)
 	return nil
 }
+
+func schemaForVMAttachments() *schema.Schema {
+	return &schema.Schema{
+		Description: "VMs attached to the Volume Group.",
+		Type:        schema.TypeList,
+		Computed:    true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"ext_id": {
+					Description: "The external identifier of the VM.",
+					Type:        schema.TypeString,
+					Computed:    true,
+				},
+				"index": {
+					Description: "The index on the SCSI bus the VM is attached at.",
+					Type:        schema.TypeInt,
+					Computed:    true,
+				},
+			},
+		},
+	}
+}
+
+func schemaForIscsiClientAttachments() *schema.Schema {
+	return &schema.Schema{
+		Description: "iSCSI clients attached to the Volume Group.",
+		Type:        schema.TypeList,
+		Computed:    true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"ext_id": {
+					Description: "The external identifier of the iSCSI client.",
+					Type:        schema.TypeString,
+					Computed:    true,
+				},
+				"cluster_reference": {
+					Description: "The UUID of the cluster the iSCSI client is associated with.",
+					Type:        schema.TypeString,
+					Computed:    true,
+				},
+			},
+		},
+	}
+}
+
+func flattenVMAttachments(vmAttachments []volumesClient.VmAttachment) []map[string]interface{} {
+	if len(vmAttachments) == 0 {
+		return nil
+	}
+	attachments := make([]map[string]interface{}, len(vmAttachments))
+	for k, v := range vmAttachments {
+		attachments[k] = map[string]interface{}{
+			"ext_id": v.ExtId,
+			"index":  v.Index,
+		}
+	}
+	return attachments
+}
+
+func flattenIscsiClientAttachments(iscsiAttachments []volumesClient.IscsiClientAttachment) []map[string]interface{} {
+	if len(iscsiAttachments) == 0 {
+		return nil
+	}
+	attachments := make([]map[string]interface{}, len(iscsiAttachments))
+	for k, v := range iscsiAttachments {
+		attachments[k] = map[string]interface{}{
+			"ext_id":            v.ExtId,
+			"cluster_reference": v.ClusterReference,
+		}
+	}
+	return attachments
+}
+
+// volumeGroupAllowedInitiators returns the sorted IQNs of the iSCSI clients of
+// the given attachments. The attachments only reference the clients, the
+// initiator name is read from each client, a client that can not be read is
+// left out.
+func volumeGroupAllowedInitiators(conn *volumes.Client, attachments []volumesClient.IscsiClientAttachment) []string {
+	initiators := make([]string, 0, len(attachments))
+	for _, attachment := range attachments {
+		resp, err := conn.IscsiClientAPIInstance.GetIscsiClientById(attachment.ExtId)
+		if err != nil {
+			log.Printf("[DEBUG] unable to fetch Iscsi Client %s: %v", utils.StringValue(attachment.ExtId), err)
+			continue
+		}
+		iscsiClient := resp.Data.GetValue().(volumesClient.IscsiClient)
+		if initiator := utils.StringValue(iscsiClient.IscsiInitiatorName); initiator != "" {
+			initiators = append(initiators, initiator)
+		}
+	}
+	sort.Strings(initiators)
+	return initiators
+}
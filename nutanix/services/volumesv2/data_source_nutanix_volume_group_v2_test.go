@@ -32,6 +32,35 @@ func TestAccV2NutanixVolumeGroupDataSource_Basic(t *testing.T) {
 					resource.TestCheckResourceAttr(dataSourceVolumeGroup, "storage_features.0.flash_mode.0.is_enabled", "true"),
 					resource.TestCheckResourceAttr(dataSourceVolumeGroup, "usage_type", "USER"),
 					resource.TestCheckResourceAttr(dataSourceVolumeGroup, "is_hidden", "false"),
+					resource.TestCheckResourceAttr(dataSourceVolumeGroup, "vm_attachments.#", "0"),
+					resource.TestCheckResourceAttr(dataSourceVolumeGroup, "iscsi_client_attachments.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccV2NutanixVolumeGroupDataSource_AllowedInitiators(t *testing.T) {
+	r := acctest.RandInt()
+	name := fmt.Sprintf("tf-test-volume-group-%d", r)
+	desc := "test volume group allowed initiators"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckNutanixVolumeGroupV2Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVolumeGroupResourceWithTwoIscsiClientsConfig(name, desc, "SHARED") + `
+		data "nutanix_volume_group_v2" "test" {
+			ext_id     = resource.nutanix_volume_group_v2.test.id
+			depends_on = [resource.nutanix_volume_group_iscsi_client_v2.test]
+		}
+	`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceVolumeGroup, "iscsi_client_attachments.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceVolumeGroup, "allowed_initiators.#", "2"),
+					resource.TestCheckResourceAttrSet(dataSourceVolumeGroup, "allowed_initiators.0"),
 				),
 			},
 		},
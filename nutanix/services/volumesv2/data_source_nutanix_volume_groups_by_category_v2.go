@@ -0,0 +1,143 @@
+package volumesv2
+
+import (
+	"context"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	prismConfig "github.com/nutanix/ntnx-api-golang-clients/prism-go-client/v4/models/prism/v4/config"
+	volumesClient "github.com/nutanix/ntnx-api-golang-clients/volumes-go-client/v4/models/volumes/v4/config"
+	conns "github.com/terraform-providers/terraform-provider-nutanix/nutanix"
+	"github.com/terraform-providers/terraform-provider-nutanix/nutanix/sdks/v4/prism"
+	"github.com/terraform-providers/terraform-provider-nutanix/nutanix/sdks/v4/volumes"
+	"github.com/terraform-providers/terraform-provider-nutanix/utils"
+)
+
+// DatasourceNutanixVolumeGroupsByCategoryV2 lists the Volume Groups associated
+// with a category, e.g. to import all of them with a for_each import block.
+func DatasourceNutanixVolumeGroupsByCategoryV2() *schema.Resource {
+	return &schema.Resource{
+		Description: "Lists the Volume Groups associated with a category.",
+		ReadContext: DatasourceNutanixVolumeGroupsByCategoryV2Read,
+		Schema: map[string]*schema.Schema{
+			"category_ext_id": {
+				Description: "The external identifier of the category.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"ext_ids": {
+				Description: "The external identifiers of the Volume Groups, sorted.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"volume_groups": {
+				Description: "The names of the Volume Groups by external identifier.",
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func DatasourceNutanixVolumeGroupsByCategoryV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.Client).VolumeAPI
+
+	categoryExtID := d.Get("category_ext_id").(string)
+	associated, err := categoryVolumeGroupExtIDs(meta.(*conns.Client).PrismAPI, categoryExtID)
+	if err != nil {
+		return diag.Errorf("error while fetching the associations of category %s : %v", categoryExtID, err)
+	}
+
+	// the associations are matched against the listed Volume Groups, which
+	// drops the ones deleted since and gives the names
+	volumeGroups := make(map[string]interface{})
+	if len(associated) > 0 {
+		all, err := listAllVolumeGroups(conn)
+		if err != nil {
+			return diag.Errorf("error while fetching volume groups : %v", err)
+		}
+		for _, vg := range all {
+			extID := utils.StringValue(vg.ExtId)
+			if associated[extID] {
+				volumeGroups[extID] = utils.StringValue(vg.Name)
+			}
+		}
+	}
+
+	extIDs := make([]string, 0, len(volumeGroups))
+	for extID := range volumeGroups {
+		extIDs = append(extIDs, extID)
+	}
+	sort.Strings(extIDs)
+
+	if err := d.Set("ext_ids", extIDs); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("volume_groups", volumeGroups); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(categoryExtID)
+	return nil
+}
+
+// categoryVolumeGroupExtIDs returns the external identifiers of the Volume
+// Groups a category is associated with.
+func categoryVolumeGroupExtIDs(conn *prism.Client, categoryExtID string) (map[string]bool, error) {
+	resp, err := conn.CategoriesAPIInstance.GetCategoryById(utils.StringPtr(categoryExtID), utils.StringPtr("detailedAssociations"))
+	if err != nil {
+		return nil, err
+	}
+
+	extIDs := make(map[string]bool)
+	category, ok := resp.Data.GetValue().(prismConfig.Category)
+	if !ok {
+		return extIDs, nil
+	}
+	for _, association := range category.DetailedAssociations {
+		if association.ResourceType != nil && *association.ResourceType == prismConfig.RESOURCETYPE_VOLUMEGROUP && association.ResourceId != nil {
+			extIDs[*association.ResourceId] = true
+		}
+	}
+	return extIDs, nil
+}
+
+// listAllVolumeGroups returns the name and external identifier of every
+// Volume Group.
+func listAllVolumeGroups(conn *volumes.Client) ([]volumesClient.VolumeGroup, error) {
+	entities, err := utils.ListAllPages(nil, nil, func(page, limit *int) ([]interface{}, *int, error) {
+		resp, err := conn.VolumeAPIInstance.ListVolumeGroups(page, limit, nil, nil, nil, utils.StringPtr("extId,name"))
+		if err != nil {
+			return nil, nil, err
+		}
+		var total *int
+		if resp.Metadata != nil {
+			total = resp.Metadata.TotalAvailableResults
+		}
+		if resp.Data == nil {
+			return nil, total, nil
+		}
+		volumeGroups, _ := resp.Data.GetValue().([]volumesClient.VolumeGroup)
+		items := make([]interface{}, len(volumeGroups))
+		for k, v := range volumeGroups {
+			items[k] = v
+		}
+		return items, total, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	volumeGroups := make([]volumesClient.VolumeGroup, len(entities))
+	for k, v := range entities {
+		volumeGroups[k] = v.(volumesClient.VolumeGroup)
+	}
+	return volumeGroups, nil
+}
@@ -0,0 +1,40 @@
+package volumesv2_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	acc "github.com/terraform-providers/terraform-provider-nutanix/nutanix/acctest"
+)
+
+const dataSourceVolumeGroupsByCategory = "data.nutanix_volume_groups_by_category_v2.test"
+
+func TestAccV2NutanixVolumeGroupsByCategoryDataSource_Basic(t *testing.T) {
+	if testVars.Volumes.CategoryExtID == "" || testVars.Volumes.VolumeGroupExtIDWithCategory == "" {
+		t.Skip("volumes.category_ext_id and volumes.vg_ext_id_with_category must be set")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVolumeGroupsByCategoryDataSourceConfig(testVars.Volumes.CategoryExtID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceVolumeGroupsByCategory, "category_ext_id", testVars.Volumes.CategoryExtID),
+					resource.TestCheckTypeSetElemAttr(dataSourceVolumeGroupsByCategory, "ext_ids.*", testVars.Volumes.VolumeGroupExtIDWithCategory),
+					resource.TestCheckResourceAttrSet(dataSourceVolumeGroupsByCategory, fmt.Sprintf("volume_groups.%s", testVars.Volumes.VolumeGroupExtIDWithCategory)),
+				),
+			},
+		},
+	})
+}
+
+func testAccVolumeGroupsByCategoryDataSourceConfig(categoryExtID string) string {
+	return fmt.Sprintf(`
+	data "nutanix_volume_groups_by_category_v2" "test" {
+		category_ext_id = "%s"
+	}
+	`, categoryExtID)
+}
@@ -2,22 +2,41 @@ package volumesv2
 
 import (
 	"context"
+	"log"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	volumesClientResponse "github.com/nutanix/ntnx-api-golang-clients/volumes-go-client/v4/models/common/v1/response"
+	volumesCommonStats "github.com/nutanix/ntnx-api-golang-clients/volumes-go-client/v4/models/common/v1/stats"
 	volumesClient "github.com/nutanix/ntnx-api-golang-clients/volumes-go-client/v4/models/volumes/v4/config"
+	volumesStats "github.com/nutanix/ntnx-api-golang-clients/volumes-go-client/v4/models/volumes/v4/stats"
 	conns "github.com/terraform-providers/terraform-provider-nutanix/nutanix"
+	"github.com/terraform-providers/terraform-provider-nutanix/nutanix/sdks/v4/volumes"
 	"github.com/terraform-providers/terraform-provider-nutanix/utils"
 )
 
+// schemaForRefreshToken is an input the data source does not use. A data source
+// is read during the plan unless one of its arguments is unknown, setting it
+// to an attribute of a resource created in the same apply defers the read
+// until that resource exists, and changing it reads the data source again.
+func schemaForRefreshToken() *schema.Schema {
+	return &schema.Schema{
+		Description: "Any value, e.g. an attribute of a resource created in the same apply. Changing it or leaving it unknown until apply reads the data source again at apply time.",
+		Type:        schema.TypeString,
+		Optional:    true,
+	}
+}
+
 // List all the Volume Groups.
 func DatasourceNutanixVolumeGroupsV2() *schema.Resource {
 	return &schema.Resource{
 		Description: "Query the list of Volume Groups.",
 		ReadContext: DatasourceNutanixVolumeGroupsV2Read,
 		Schema: map[string]*schema.Schema{
+			"refresh_token": schemaForRefreshToken(),
 			"page": {
 				Description: "A URL query parameter that specifies the page number of the result set. It must be a positive integer between 0 and the maximum number of pages that are available for that resource. Any number out of this range might lead to no results.",
 				Type:        schema.TypeInt,
@@ -29,6 +48,11 @@ func DatasourceNutanixVolumeGroupsV2() *schema.Resource {
 				Optional:    true,
 			},
 			"filter": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: utils.ValidateODataFilter,
+			},
+			"tenant_id": {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
@@ -46,6 +70,24 @@ func DatasourceNutanixVolumeGroupsV2() *schema.Resource {
 				Type:        schema.TypeString,
 				Optional:    true,
 			},
+			"count_only": {
+				Description: "Only count the Volume Groups matching the filter, total_count is set and volumes is left empty.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+			"degraded_latency_usecs": {
+				Description:  "Controller average IO latency in microseconds above which a Volume Group is reported as DEGRADED in health_status.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      defaultDegradedLatencyUsecs,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"total_count": {
+				Description: "Total number of Volume Groups matching the filter. Set when count_only is true or when neither page nor limit is set.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
 			"volumes": {
 				Description: "List of Volume Groups.",
 				Type:        schema.TypeList,
@@ -168,6 +210,13 @@ func DatasourceNutanixVolumeGroupsV2() *schema.Resource {
 							Type:        schema.TypeBool,
 							Computed:    true,
 						},
+						"vm_attachments":           schemaForVMAttachments(),
+						"iscsi_client_attachments": schemaForIscsiClientAttachments(),
+						"health_status": {
+							Description: "Health of the Volume Group derived from its controller stats of the last hour: HEALTHY, DEGRADED when the controller average IO latency is above degraded_latency_usecs, or UNKNOWN when no stats were sampled.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
 					},
 				},
 			},
@@ -192,10 +241,12 @@ func DatasourceNutanixVolumeGroupsV2Read(ctx context.Context, d *schema.Resource
 	} else {
 		limit = nil
 	}
-	if filterf, ok := d.GetOk("filter"); ok {
-		filter = utils.StringPtr(filterf.(string))
-	} else {
-		filter = nil
+	filter, err := utils.NormalizeODataFilter(d.Get("filter").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if tenantID, ok := d.GetOk("tenant_id"); ok {
+		filter = utils.AndFilter(filter, utils.TenantFilter(tenantID.(string)))
 	}
 	if order, ok := d.GetOk("order_by"); ok {
 		orderBy = utils.StringPtr(order.(string))
@@ -213,22 +264,79 @@ func DatasourceNutanixVolumeGroupsV2Read(ctx context.Context, d *schema.Resource
 		selects = nil
 	}
 
-	// get the volume groups response
-	resp, err := conn.VolumeAPIInstance.ListVolumeGroups(page, limit, filter, orderBy, expand, selects)
+	// a single minimal object is enough to read the total from the metadata
+	if d.Get("count_only").(bool) {
+		resp, err := conn.VolumeAPIInstance.ListVolumeGroups(nil, utils.IntPtr(1), filter, nil, nil, utils.StringPtr("extId"))
+		if err != nil {
+			return diag.Errorf("error while counting volumes : %v", err)
+		}
+		totalCount := 0
+		if resp.Metadata != nil && resp.Metadata.TotalAvailableResults != nil {
+			totalCount = *resp.Metadata.TotalAvailableResults
+		}
+		if err := d.Set("total_count", totalCount); err != nil {
+			return diag.FromErr(err)
+		}
+		if err := d.Set("volumes", nil); err != nil {
+			return diag.FromErr(err)
+		}
+		d.SetId(resource.UniqueId())
+		return nil
+	}
+
+	// get the volume groups response, walking every page unless page or limit is set
+	entities, err := utils.ListAllPages(page, limit, func(page, limit *int) ([]interface{}, *int, error) {
+		resp, err := conn.VolumeAPIInstance.ListVolumeGroups(page, limit, filter, orderBy, expand, selects)
+		if err != nil {
+			return nil, nil, err
+		}
+		var total *int
+		if resp.Metadata != nil {
+			total = resp.Metadata.TotalAvailableResults
+		}
+		if resp.Data == nil {
+			return nil, total, nil
+		}
+		volumeGroups := resp.Data.GetValue().([]volumesClient.VolumeGroup)
+		items := make([]interface{}, len(volumeGroups))
+		for k, v := range volumeGroups {
+			items[k] = v
+		}
+		return items, total, nil
+	})
 	if err != nil {
 		return diag.Errorf("error while fetching volumes : %v", err)
 	}
 
-	volumesResp := resp.Data
+	volumeGroups := make([]volumesClient.VolumeGroup, len(entities))
+	for k, v := range entities {
+		volumeGroups[k] = v.(volumesClient.VolumeGroup)
+	}
 
-	if volumesResp != nil {
-		// set the volume groups data in the terraform resource
-		if err := d.Set("volumes", flattenVolumesEntities(volumesResp.GetValue().([]volumesClient.VolumeGroup))); err != nil {
+	volumeGroupList := flattenVolumesEntities(volumeGroups)
+	for k, v := range volumeGroups {
+		if v.ExtId == nil {
+			continue
+		}
+		vmAttachments, iscsiAttachments, err := listVolumeGroupAttachments(conn, *v.ExtId)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		volumeGroup := volumeGroupList[k].(map[string]interface{})
+		volumeGroup["vm_attachments"] = flattenVMAttachments(vmAttachments)
+		volumeGroup["iscsi_client_attachments"] = flattenIscsiClientAttachments(iscsiAttachments)
+		volumeGroup["health_status"] = volumeGroupHealthStatus(conn, *v.ExtId, int64(d.Get("degraded_latency_usecs").(int)))
+	}
+
+	// set the volume groups data in the terraform resource
+	if err := d.Set("volumes", volumeGroupList); err != nil {
+		return diag.FromErr(err)
+	}
+	// every page was walked, the list holds all the matching volume groups
+	if page == nil && limit == nil {
+		if err := d.Set("total_count", len(volumeGroupList)); err != nil {
 			return diag.FromErr(err)
 		}
-	} else {
-		// set the volume groups data in the terraform resource
-		d.Set("volumes", make([]volumesClient.VolumeGroup, 0))
 	}
 
 	d.SetId(resource.UniqueId())
@@ -393,3 +501,52 @@ func flattenUsageType(usageType *volumesClient.UsageType) string {
 	}
 	return usageTypeStr
 }
+
+// default degraded_latency_usecs, 100 ms
+const defaultDegradedLatencyUsecs = 100000
+
+// volumeGroupHealthStatus derives the health of a Volume Group from the last
+// hour of its controller stats. The v4 volumes API reports no alerts nor
+// error counters, a latest average IO latency above degradedLatencyUsecs is
+// what marks a Volume Group as degraded. Stats that can not be read leave the
+// health UNKNOWN instead of failing the read.
+func volumeGroupHealthStatus(conn *volumes.Client, volumeGroupExtID string, degradedLatencyUsecs int64) string {
+	const samplingIntervalSecs = 300
+	endTime := time.Now().UTC()
+	startTime := endTime.Add(-1 * time.Hour)
+	statType := volumesCommonStats.DownSamplingOperator(7) // LAST
+
+	resp, err := conn.VolumeAPIInstance.GetVolumeGroupStats(utils.StringPtr(volumeGroupExtID), &startTime, &endTime, utils.IntPtr(samplingIntervalSecs), &statType, nil)
+	if err != nil {
+		log.Printf("[DEBUG] could not read the stats of Volume Group %s : %v", volumeGroupExtID, err)
+		return "UNKNOWN"
+	}
+	if resp.Data == nil {
+		return "UNKNOWN"
+	}
+	stats, ok := resp.Data.GetValue().(volumesStats.VolumeGroupStats)
+	if !ok {
+		return "UNKNOWN"
+	}
+
+	var latency *int64
+	var sampledAt time.Time
+	for _, v := range stats.ControllerAvgIOLatencyUsecs {
+		if v.Value == nil {
+			continue
+		}
+		if latency == nil || (v.Timestamp != nil && v.Timestamp.After(sampledAt)) {
+			latency = v.Value
+			if v.Timestamp != nil {
+				sampledAt = *v.Timestamp
+			}
+		}
+	}
+	if latency == nil {
+		return "UNKNOWN"
+	}
+	if *latency > degradedLatencyUsecs {
+		return "DEGRADED"
+	}
+	return "HEALTHY"
+}
@@ -2,6 +2,7 @@ package volumesv2_test
 
 import (
 	"fmt"
+	"regexp"
 	"strconv"
 	"testing"
 
@@ -53,6 +54,29 @@ func TestAccV2NutanixVolumeGroupsV4DataSource_WithFilter(t *testing.T) {
 					resource.TestCheckResourceAttr(dataSourceVolumeGroups, "volumes.0.storage_features.0.flash_mode.0.is_enabled", "true"),
 					resource.TestCheckResourceAttr(dataSourceVolumeGroups, "volumes.0.usage_type", "USER"),
 					resource.TestCheckResourceAttr(dataSourceVolumeGroups, "volumes.0.is_hidden", "false"),
+					resource.TestCheckResourceAttr(dataSourceVolumeGroups, "degraded_latency_usecs", "100000"),
+					resource.TestMatchResourceAttr(dataSourceVolumeGroups, "volumes.0.health_status", regexp.MustCompile("^(HEALTHY|DEGRADED|UNKNOWN)$")),
+				),
+			},
+		},
+	})
+}
+
+func TestAccV2NutanixVolumeGroupsDataSource_RefreshToken(t *testing.T) {
+	r := acctest.RandInt()
+	name := fmt.Sprintf("terraform-test-volume-group-%d", r)
+	desc := "terraform test volume group description"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVolumeGroupsDataSourceWithRefreshToken(name, desc),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceVolumeGroups, "volumes.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceVolumeGroups, "volumes.0.name", name),
+					resource.TestCheckResourceAttrPair(dataSourceVolumeGroups, "refresh_token", resourceNameVolumeGroup, "id"),
 				),
 			},
 		},
@@ -79,6 +103,26 @@ func TestAccV2NutanixVolumeGroupsV4DataSource_WithLimit(t *testing.T) {
 	})
 }
 
+func TestAccV2NutanixVolumeGroupsV4DataSource_CountOnly(t *testing.T) {
+	r := acctest.RandInt()
+	name := fmt.Sprintf("terraform-test-volume-group-%d", r)
+	desc := "terraform test volume group description"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVolumeGroupsDataSourceCountOnly(name, desc),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceVolumeGroups, "total_count", "1"),
+					resource.TestCheckResourceAttr(dataSourceVolumeGroups, "volumes.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 func testAccVolumeGroupsDataSourceConfig(filepath, name, desc string) string {
 	return testAccVolumeGroupResourceConfig(name, desc) + `
 		data "nutanix_volume_groups_v2" "test" {
@@ -87,6 +131,15 @@ func testAccVolumeGroupsDataSourceConfig(filepath, name, desc string) string {
 	`
 }
 
+func testAccVolumeGroupsDataSourceWithRefreshToken(name, desc string) string {
+	return testAccVolumeGroupResourceConfig(name, desc) + fmt.Sprintf(`
+	data "nutanix_volume_groups_v2" "test" {
+		filter        = "name eq '%s'"
+		refresh_token = nutanix_volume_group_v2.test.id
+	}
+	`, name)
+}
+
 func testAccVolumeGroupsDataSourceWithFilter(filepath, name, desc string) string {
 	return testAccVolumeGroupResourceConfig(name, desc) + fmt.Sprintf(`		
 	data "nutanix_volume_groups_v2" "test" {
@@ -96,6 +149,16 @@ func testAccVolumeGroupsDataSourceWithFilter(filepath, name, desc string) string
 	`, name)
 }
 
+func testAccVolumeGroupsDataSourceCountOnly(name, desc string) string {
+	return testAccVolumeGroupResourceConfig(name, desc) + fmt.Sprintf(`
+	data "nutanix_volume_groups_v2" "test" {
+		filter     = "name eq '%s'"
+		count_only = true
+		depends_on = [resource.nutanix_volume_group_v2.test]
+	}
+	`, name)
+}
+
 func testAccVolumeGroupsDataSourceWithLimit(name, desc string, limit int) string {
 	return fmt.Sprintf(
 		`
@@ -135,3 +198,34 @@ func testAccVolumeGroupsDataSourceWithLimit(name, desc string, limit int) string
 			}
 		`, name, desc, limit)
 }
+
+func TestAccV2NutanixVolumeGroupsV4DataSource_MalformedFilter(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccVolumeGroupsDataSourceFilterConfig(`name eq 'O'Brien'`),
+				ExpectError: regexp.MustCompile("unterminated string value"),
+			},
+			{
+				Config:      testAccVolumeGroupsDataSourceFilterConfig(`name == 'vg'`),
+				ExpectError: regexp.MustCompile("== is not an OData operator, use eq"),
+			},
+			{
+				Config: testAccVolumeGroupsDataSourceFilterConfig(`name eq 'O''Brien'`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.nutanix_volume_groups_v2.test", "volumes.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccVolumeGroupsDataSourceFilterConfig(filter string) string {
+	return fmt.Sprintf(`
+	data "nutanix_volume_groups_v2" "test" {
+		filter = %q
+	}
+`, filter)
+}
@@ -18,6 +18,7 @@ func DatasourceNutanixVolumeIscsiClientsV2() *schema.Resource {
 		Description: "Fetches the list of iSCSI clients.",
 		ReadContext: DatasourceNutanixVolumeIscsiClientsV2Read,
 		Schema: map[string]*schema.Schema{
+			"refresh_token": schemaForRefreshToken(),
 			"page": {
 				Description: "A URL query parameter that specifies the page number of the result set. It must be a positive integer between 0 and the maximum number of pages that are available for that resource. Any number out of this range might lead to no results.",
 				Type:        schema.TypeInt,
@@ -0,0 +1,59 @@
+package volumesv2
+
+import (
+	"strings"
+	"testing"
+
+	clustermgmtConfig "github.com/nutanix/ntnx-api-golang-clients/clustermgmt-go-client/v4/models/clustermgmt/v4/config"
+)
+
+func testHost(tiers ...clustermgmtConfig.StorageTierReference) interface{} {
+	host := clustermgmtConfig.Host{}
+	for i := range tiers {
+		host.Disk = append(host.Disk, clustermgmtConfig.DiskReference{StorageTier: &tiers[i]})
+	}
+	return host
+}
+
+func TestHddOnlyClusterMessage(t *testing.T) {
+	hdd, ssd := clustermgmtConfig.STORAGETIERREFERENCE_HDD, clustermgmtConfig.STORAGETIERREFERENCE_SATA_SSD
+	cases := []struct {
+		name  string
+		hosts []interface{}
+		warn  string
+	}{
+		{"all HDD", []interface{}{testHost(hdd, hdd), testHost(hdd)}, "its 3 disks are all HDD"},
+		{"one SSD", []interface{}{testHost(hdd, hdd), testHost(ssd)}, ""},
+		{"no disk reported", []interface{}{testHost()}, ""},
+		{"no host", nil, ""},
+	}
+	for _, c := range cases {
+		msg := hddOnlyClusterMessage("cluster-1", c.hosts)
+		if c.warn == "" && msg != "" {
+			t.Errorf("%s: expected no warning, got %q", c.name, msg)
+		}
+		if c.warn != "" && !strings.Contains(msg, c.warn) {
+			t.Errorf("%s: expected a warning containing %q, got %q", c.name, c.warn, msg)
+		}
+	}
+}
+
+func TestFlashModeEnabled(t *testing.T) {
+	flashMode := func(enabled bool) []interface{} {
+		return []interface{}{map[string]interface{}{
+			"flash_mode": []interface{}{map[string]interface{}{"is_enabled": enabled}},
+		}}
+	}
+	if !flashModeEnabled(flashMode(true)) {
+		t.Error("expected flash mode enabled")
+	}
+	if flashModeEnabled(flashMode(false)) {
+		t.Error("expected flash mode disabled")
+	}
+	if flashModeEnabled(nil) {
+		t.Error("expected flash mode disabled without storage_features")
+	}
+	if flashModeEnabled([]interface{}{map[string]interface{}{"flash_mode": []interface{}{}}}) {
+		t.Error("expected flash mode disabled without flash_mode")
+	}
+}
@@ -1,9 +1,11 @@
 package volumesv2_test
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	acc "github.com/terraform-providers/terraform-provider-nutanix/nutanix/acctest"
 
@@ -173,3 +175,230 @@ func testAccVolumeGroupDiskResourceConfig(name, desc string) string {
 	  }	  
 	`, filepath, name, desc, diskSizeBytes)
 }
+
+// testAccCheckNutanixVolumeGroupV2Disappears deletes the volume group out of band,
+// the next refresh must then drop it from state and plan to recreate it.
+func testAccCheckNutanixVolumeGroupV2Disappears(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource %s not found", resourceName)
+		}
+		conn := acc.TestAccProvider.Meta().(*conns.Client)
+
+		if _, err := conn.VolumeAPI.VolumeAPIInstance.DeleteVolumeGroupById(utils.StringPtr(rs.Primary.ID)); err != nil {
+			return err
+		}
+		// delete is asynchronous, wait for the volume group to be gone
+		const attempts = 24
+		for i := 0; i < attempts; i++ {
+			_, err := conn.VolumeAPI.VolumeAPIInstance.GetVolumeGroupById(utils.StringPtr(rs.Primary.ID))
+			if utils.IsV4NotFound(err) {
+				return nil
+			}
+			time.Sleep(5 * time.Second)
+		}
+		return fmt.Errorf("volume group %s still exists after delete", rs.Primary.ID)
+	}
+}
+
+// testAccVolumeGroupImportStateID returns an import id built from the ids of
+// the given resources, joined with "/" and followed by suffix.
+func testAccVolumeGroupImportStateID(suffix string, resourceNames ...string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		ids := make([]string, 0, len(resourceNames))
+		for _, resourceName := range resourceNames {
+			rs, ok := s.RootModule().Resources[resourceName]
+			if !ok {
+				return "", fmt.Errorf("resource %s not found", resourceName)
+			}
+			ids = append(ids, rs.Primary.ID)
+		}
+		return strings.Join(ids, "/") + suffix, nil
+	}
+}
+
+// testAccCheckVolumeGroupCreateTaskDone waits for the create task of a Volume
+// Group submitted with wait_for_task = false, if it was still running.
+func testAccCheckVolumeGroupCreateTaskDone(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource %s not found", resourceName)
+		}
+		taskUUID := rs.Primary.Attributes["task_ext_id"]
+		if taskUUID == "" {
+			return nil
+		}
+		conn := acc.TestAccProvider.Meta().(*conns.Client)
+
+		const attempts = 24
+		for i := 0; i < attempts; i++ {
+			task, err := conn.PrismAPI.GetTask(context.Background(), taskUUID)
+			if err != nil {
+				return err
+			}
+			if status := utils.TaskStatusName(task.Status); status == "SUCCEEDED" || utils.IsTaskFailed(status) {
+				return nil
+			}
+			time.Sleep(5 * time.Second)
+		}
+		return fmt.Errorf("create task %s of volume group is still running", taskUUID)
+	}
+}
+
+// testAccCheckVolumeGroupExtID records the ext id of a Volume Group in extID,
+// or when same is set checks that it is still the recorded one, i.e. that the
+// Volume Group was updated in place and not replaced.
+func testAccCheckVolumeGroupExtID(resourceName string, extID *string, same bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource %s not found", resourceName)
+		}
+		if !same {
+			*extID = rs.Primary.ID
+			return nil
+		}
+		if rs.Primary.ID != *extID {
+			return fmt.Errorf("volume group %s was replaced by %s", *extID, rs.Primary.ID)
+		}
+		return nil
+	}
+}
+
+// testAccCheckVolumeGroupAuthentication checks the authentication the Volume
+// Group reports through the API, not the one kept in state.
+func testAccCheckVolumeGroupAuthentication(resourceName, authentication string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource %s not found", resourceName)
+		}
+		conn := acc.TestAccProvider.Meta().(*conns.Client)
+		resp, err := conn.VolumeAPI.VolumeAPIInstance.GetVolumeGroupById(utils.StringPtr(rs.Primary.ID))
+		if err != nil {
+			return err
+		}
+		vg := resp.Data.GetValue().(volumesClient.VolumeGroup)
+		if vg.EnabledAuthentications == nil || vg.EnabledAuthentications.GetName() != authentication {
+			return fmt.Errorf("Volume Group %s enables authentication %v, expected %s", rs.Primary.ID, vg.EnabledAuthentications, authentication)
+		}
+		return nil
+	}
+}
+
+// testAccCheckVolumeGroupCategories checks that the categories associated with
+// the Volume Group through the API are exactly the given category resources.
+func testAccCheckVolumeGroupCategories(resourceName string, categoryResourceNames ...string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource %s not found", resourceName)
+		}
+		expected := make(map[string]bool)
+		for _, name := range categoryResourceNames {
+			category, ok := s.RootModule().Resources[name]
+			if !ok {
+				return fmt.Errorf("resource %s not found", name)
+			}
+			expected[category.Primary.ID] = true
+		}
+
+		conn := acc.TestAccProvider.Meta().(*conns.Client)
+		resp, err := conn.VolumeAPI.VolumeAPIInstance.ListCategoryAssociationsByVolumeGroupId(utils.StringPtr(rs.Primary.ID), nil, nil)
+		if err != nil {
+			return err
+		}
+		associated := make([]string, 0)
+		if resp.Data != nil {
+			categories, _ := resp.Data.GetValue().([]volumesClient.CategoryDetails)
+			for _, category := range categories {
+				associated = append(associated, utils.StringValue(category.ExtId))
+			}
+		}
+		if len(associated) != len(expected) {
+			return fmt.Errorf("Volume Group %s is associated with categories %v, expected %d categories", rs.Primary.ID, associated, len(expected))
+		}
+		for _, extID := range associated {
+			if !expected[extID] {
+				return fmt.Errorf("Volume Group %s is associated with unexpected category %s", rs.Primary.ID, extID)
+			}
+		}
+		return nil
+	}
+}
+
+// testAccCheckVolumeGroupDisksClonedFrom checks through the API that every disk
+// of the source Volume Group has a disk of the same index and size in the
+// clone, whose data source reference points at the source disk.
+func testAccCheckVolumeGroupDisksClonedFrom(resourceName, sourceResourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource %s not found", resourceName)
+		}
+		source, ok := s.RootModule().Resources[sourceResourceName]
+		if !ok {
+			return fmt.Errorf("resource %s not found", sourceResourceName)
+		}
+
+		conn := acc.TestAccProvider.Meta().(*conns.Client)
+		listDisks := func(volumeGroupExtID string) (map[int]volumesClient.VolumeDisk, error) {
+			resp, err := conn.VolumeAPI.VolumeAPIInstance.ListVolumeDisksByVolumeGroupId(utils.StringPtr(volumeGroupExtID), nil, nil, nil, nil, nil)
+			if err != nil {
+				return nil, err
+			}
+			disks := make(map[int]volumesClient.VolumeDisk)
+			if resp.Data != nil {
+				list, _ := resp.Data.GetValue().([]volumesClient.VolumeDisk)
+				for _, disk := range list {
+					disks[utils.IntValue(disk.Index)] = disk
+				}
+			}
+			return disks, nil
+		}
+		sourceDisks, err := listDisks(source.Primary.ID)
+		if err != nil {
+			return err
+		}
+		clonedDisks, err := listDisks(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if len(clonedDisks) != len(sourceDisks) {
+			return fmt.Errorf("Volume Group %s has %d disks, expected the %d disks of %s", rs.Primary.ID, len(clonedDisks), len(sourceDisks), source.Primary.ID)
+		}
+		for index, sourceDisk := range sourceDisks {
+			cloned, ok := clonedDisks[index]
+			if !ok {
+				return fmt.Errorf("Volume Group %s has no disk at index %d", rs.Primary.ID, index)
+			}
+			if utils.Int64Value(cloned.DiskSizeBytes) != utils.Int64Value(sourceDisk.DiskSizeBytes) {
+				return fmt.Errorf("disk %d of Volume Group %s is %d bytes, expected %d bytes", index, rs.Primary.ID, utils.Int64Value(cloned.DiskSizeBytes), utils.Int64Value(sourceDisk.DiskSizeBytes))
+			}
+			if cloned.DiskDataSourceReference == nil || utils.StringValue(cloned.DiskDataSourceReference.ExtId) != utils.StringValue(sourceDisk.ExtId) {
+				return fmt.Errorf("disk %d of Volume Group %s is not cloned from source disk %s", index, rs.Primary.ID, utils.StringValue(sourceDisk.ExtId))
+			}
+		}
+		return nil
+	}
+}
+
+// testAccCaptureOutput stores the value of a string output in value, for the
+// steps that follow.
+func testAccCaptureOutput(name string, value *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		output, ok := s.RootModule().Outputs[name]
+		if !ok {
+			return fmt.Errorf("output %s not found", name)
+		}
+		v, ok := output.Value.(string)
+		if !ok || v == "" {
+			return fmt.Errorf("output %s is not a string, got %v", name, output.Value)
+		}
+		*value = v
+		return nil
+	}
+}
@@ -11,6 +11,7 @@ type TestConfig struct {
 	// Volumes config
 	Volumes struct {
 		VolumeGroupExtIDWithCategory string `json:"vg_ext_id_with_category"`
+		CategoryExtID                string `json:"category_ext_id"`
 	} `json:"volumes"`
 }
 
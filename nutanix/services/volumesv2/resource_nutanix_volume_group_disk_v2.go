@@ -2,7 +2,9 @@ package volumesv2
 
 import (
 	"context"
+	"fmt"
 	"log"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -13,6 +15,7 @@ import (
 	volumesPrism "github.com/nutanix/ntnx-api-golang-clients/volumes-go-client/v4/models/prism/v4/config"
 	volumesClient "github.com/nutanix/ntnx-api-golang-clients/volumes-go-client/v4/models/volumes/v4/config"
 	conns "github.com/terraform-providers/terraform-provider-nutanix/nutanix"
+	"github.com/terraform-providers/terraform-provider-nutanix/nutanix/sdks/v4/volumes"
 	"github.com/terraform-providers/terraform-provider-nutanix/utils"
 )
 
@@ -24,6 +27,10 @@ func ResourceNutanixVolumeGroupDiskV2() *schema.Resource {
 		ReadContext:   ResourceNutanixVolumeGroupDiskV2Read,
 		UpdateContext: ResourceNutanixVolumeGroupDiskV2Update,
 		DeleteContext: ResourceNutanixVolumeGroupDiskV2Delete,
+		CustomizeDiff: resourceNutanixVolumeGroupDiskV2Diff,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceNutanixVolumeGroupDiskV2Import,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"volume_group_ext_id": {
@@ -47,6 +54,12 @@ func ResourceNutanixVolumeGroupDiskV2() *schema.Resource {
 				Type:        schema.TypeInt,
 				Required:    true,
 			},
+			"allow_online_resize": {
+				Description: "Whether the disk may be grown while the Volume Group is attached to VMs or iSCSI clients. When false, a resize of an attached Volume Group fails instead of growing the disk online.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+			},
 			"description": {
 				Description: "Volume Disk description. This is an optional field.",
 				Type:        schema.TypeString,
@@ -140,7 +153,7 @@ func ResourceNutanixVolumeGroupDiskV2Create(ctx context.Context, d *schema.Resou
 	log.Printf("[DEBUG] Volume Disk Body body.DiskDataSourceReference.Uris : %v", body.DiskDataSourceReference.Uris)
 	resp, err := conn.VolumeAPIInstance.CreateVolumeDisk(utils.StringPtr(volumeGroupExtID.(string)), &body)
 	if err != nil {
-		return diag.Errorf("error while creating Volume Disk : %v", err)
+		return utils.WithRemediationHint(diag.Errorf("error while creating Volume Disk : %v", err), err)
 	}
 
 	TaskRef := resp.Data.GetValue().(volumesPrism.TaskReference)
@@ -149,9 +162,9 @@ func ResourceNutanixVolumeGroupDiskV2Create(ctx context.Context, d *schema.Resou
 	taskconn := meta.(*conns.Client).PrismAPI
 	// Wait for the VM to be available
 	stateConf := &resource.StateChangeConf{
-		Pending: []string{"PENDING", "RUNNING", "QUEUED"},
-		Target:  []string{"SUCCEEDED"},
-		Refresh: taskStateRefreshPrismTaskGroupFunc(ctx, taskconn, utils.StringValue(taskUUID)),
+		Pending: utils.TaskPendingStates,
+		Target:  utils.TaskTargetStates,
+		Refresh: taskStateRefreshPrismTaskGroupFunc(ctx, taskconn, utils.StringValue(taskUUID), utils.TaskPollRetry{}),
 		Timeout: d.Timeout(schema.TimeoutCreate),
 	}
 
@@ -184,6 +197,11 @@ func ResourceNutanixVolumeGroupDiskV2Read(ctx context.Context, d *schema.Resourc
 
 	resp, err := conn.VolumeAPIInstance.GetVolumeDiskById(utils.StringPtr(volumeGroupExtID.(string)), utils.StringPtr(volumeDiskExtID))
 	if err != nil {
+		if utils.IsV4NotFound(err) {
+			log.Printf("[DEBUG] volume group disk %s not found, removing it from state", d.Id())
+			d.SetId("")
+			return nil
+		}
 		return diag.Errorf("error while fetching volume Disk : %v", err)
 	}
 	getResp := resp.Data.GetValue().(volumesClient.VolumeDisk)
@@ -230,7 +248,17 @@ func ResourceNutanixVolumeGroupDiskV2Update(ctx context.Context, d *schema.Resou
 		updateSpec.Index = nil
 	}
 	if d.HasChange("disk_size_bytes") {
-		diskSizeBytes := d.Get("disk_size_bytes").(int64)
+		if !d.Get("allow_online_resize").(bool) {
+			vmAttachments, iscsiAttachments, err := listVolumeGroupAttachments(conn, volumeGroupExtID.(string))
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			if len(vmAttachments)+len(iscsiAttachments) > 0 {
+				return diag.Errorf("error while resizing Volume Disk %s : Volume Group %s is attached to %s and allow_online_resize is false, detach it during a maintenance window or set allow_online_resize = true",
+					volumeDiskExtID, volumeGroupExtID, strings.Join(describeVolumeGroupAttachments(vmAttachments, iscsiAttachments), ", "))
+			}
+		}
+		diskSizeBytes := int64(d.Get("disk_size_bytes").(int))
 		updateSpec.DiskSizeBytes = &diskSizeBytes
 	}
 	if d.HasChange("description") {
@@ -250,7 +278,7 @@ func ResourceNutanixVolumeGroupDiskV2Update(ctx context.Context, d *schema.Resou
 
 	updateResp, err := conn.VolumeAPIInstance.UpdateVolumeDiskById(utils.StringPtr(volumeGroupExtID.(string)), utils.StringPtr(volumeDiskExtID), &updateSpec)
 	if err != nil {
-		return diag.Errorf("error while updating Volume Disk : %v", err)
+		return utils.WithRemediationHint(diag.Errorf("error while updating Volume Disk : %v", err), err)
 	}
 
 	TaskRef := updateResp.Data.GetValue().(volumesPrism.TaskReference)
@@ -261,9 +289,9 @@ func ResourceNutanixVolumeGroupDiskV2Update(ctx context.Context, d *schema.Resou
 	taskconn := meta.(*conns.Client).PrismAPI
 	// Wait for the Image to be available
 	stateConf := &resource.StateChangeConf{
-		Pending: []string{"PENDING", "RUNNING", "QUEUED"},
-		Target:  []string{"SUCCEEDED"},
-		Refresh: taskStateRefreshPrismTaskGroupFunc(ctx, taskconn, utils.StringValue(taskUUID)),
+		Pending: utils.TaskPendingStates,
+		Target:  utils.TaskTargetStates,
+		Refresh: taskStateRefreshPrismTaskGroupFunc(ctx, taskconn, utils.StringValue(taskUUID), utils.TaskPollRetry{}),
 		Timeout: d.Timeout(schema.TimeoutCreate),
 	}
 
@@ -274,6 +302,96 @@ func ResourceNutanixVolumeGroupDiskV2Update(ctx context.Context, d *schema.Resou
 	return ResourceNutanixVolumeGroupDiskV2Read(ctx, d, meta)
 }
 
+// resourceNutanixVolumeGroupDiskV2Diff rejects an index already used by
+// another disk of the Volume Group at plan time. Only an index set in the
+// configuration is checked, the one picked by the backend is always free.
+func resourceNutanixVolumeGroupDiskV2Diff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	rawConfig := d.GetRawConfig()
+	if rawConfig.IsNull() || !rawConfig.IsKnown() {
+		return nil
+	}
+	if rawIndex := rawConfig.GetAttr("index"); rawIndex.IsNull() || !rawIndex.IsKnown() {
+		return nil
+	}
+	if d.Id() != "" && !d.HasChange("index") {
+		return nil
+	}
+	if !d.NewValueKnown("volume_group_ext_id") {
+		return nil
+	}
+
+	volumeGroupExtID := d.Get("volume_group_ext_id").(string)
+	index := d.Get("index").(int)
+
+	conn := meta.(*conns.Client).VolumeAPI
+	disks, err := listVolumeGroupDisks(conn, volumeGroupExtID)
+	if err != nil {
+		// the Volume Group may not exist yet, leave the error to apply
+		log.Printf("[DEBUG] could not list the disks of Volume Group %s to check index %d : %v", volumeGroupExtID, index, err)
+		return nil
+	}
+
+	for _, disk := range disks {
+		if disk.Index != nil && *disk.Index == index && utils.StringValue(disk.ExtId) != d.Id() {
+			return fmt.Errorf("disk index %d is already used by disk %s of Volume Group %s, disk indexes must be unique",
+				index, utils.StringValue(disk.ExtId), volumeGroupExtID)
+		}
+	}
+	return nil
+}
+
+// resourceNutanixVolumeGroupDiskV2Import imports a disk by
+// `<volume_group_ext_id>/<ext_id>`, the disk alone does not identify it.
+// allow_online_resize is not returned by the API and takes its default.
+func resourceNutanixVolumeGroupDiskV2Import(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("invalid Volume Group disk import id %q, expected <volume_group_ext_id>/<ext_id>", d.Id())
+	}
+	log.Printf("[DEBUG] Importing disk %s of Volume Group %s", parts[1], parts[0])
+
+	if err := d.Set("volume_group_ext_id", parts[0]); err != nil {
+		return nil, err
+	}
+	if err := d.Set("allow_online_resize", true); err != nil {
+		return nil, err
+	}
+	d.SetId(parts[1])
+	return []*schema.ResourceData{d}, nil
+}
+
+// listVolumeGroupDisks returns every disk of the Volume Group, across pages.
+func listVolumeGroupDisks(conn *volumes.Client, volumeGroupExtID string) ([]volumesClient.VolumeDisk, error) {
+	items, err := utils.ListAllPages(nil, nil, func(page, limit *int) ([]interface{}, *int, error) {
+		resp, err := conn.VolumeAPIInstance.ListVolumeDisksByVolumeGroupId(utils.StringPtr(volumeGroupExtID), page, limit, nil, nil, nil)
+		if err != nil {
+			return nil, nil, err
+		}
+		var total *int
+		if resp.Metadata != nil {
+			total = resp.Metadata.TotalAvailableResults
+		}
+		if resp.Data == nil {
+			return nil, total, nil
+		}
+		volumeDisks, _ := resp.Data.GetValue().([]volumesClient.VolumeDisk)
+		items := make([]interface{}, len(volumeDisks))
+		for k, v := range volumeDisks {
+			items[k] = v
+		}
+		return items, total, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	disks := make([]volumesClient.VolumeDisk, len(items))
+	for k, v := range items {
+		disks[k] = v.(volumesClient.VolumeDisk)
+	}
+	return disks, nil
+}
+
 func ResourceNutanixVolumeGroupDiskV2Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.Client).VolumeAPI
 
@@ -292,9 +410,9 @@ func ResourceNutanixVolumeGroupDiskV2Delete(ctx context.Context, d *schema.Resou
 	taskconn := meta.(*conns.Client).PrismAPI
 	// Wait for the VM to be available
 	stateConf := &resource.StateChangeConf{
-		Pending: []string{"PENDING", "RUNNING", "QUEUED"},
-		Target:  []string{"SUCCEEDED"},
-		Refresh: taskStateRefreshPrismTaskGroupFunc(ctx, taskconn, utils.StringValue(taskUUID)),
+		Pending: utils.TaskPendingStates,
+		Target:  utils.TaskTargetStates,
+		Refresh: taskStateRefreshPrismTaskGroupFunc(ctx, taskconn, utils.StringValue(taskUUID), utils.TaskPollRetry{}),
 		Timeout: d.Timeout(schema.TimeoutCreate),
 	}
 
@@ -2,6 +2,7 @@ package volumesv2_test
 
 import (
 	"fmt"
+	"regexp"
 	"strconv"
 	"testing"
 
@@ -30,11 +31,126 @@ func TestAccV2NutanixVolumeGroupDiskResource_Basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceVolumeGroupDisk, "disk_storage_features.0.flash_mode.0.is_enabled", "false"),
 				),
 			},
+			{
+				ResourceName:      resourceVolumeGroupDisk,
+				ImportState:       true,
+				ImportStateIdFunc: testAccVolumeGroupImportStateID("", resourceNameVolumeGroup, resourceVolumeGroupDisk),
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"disk_data_source_reference.0.name",
+					"disk_data_source_reference.0.uris",
+				},
+			},
+		},
+	})
+}
+
+func TestAccV2NutanixVolumeGroupDiskResource_DuplicateIndex(t *testing.T) {
+	r := acctest.RandInt()
+	name := fmt.Sprintf("tf-test-volume-group-%d", r)
+	desc := "test volume group disk description"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVolumeGroupsDiskResourceConfig(filepath, name, desc),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceVolumeGroupDisk, "index", "1"),
+				),
+			},
+			// the index must be read back unchanged
+			{
+				Config:   testAccVolumeGroupsDiskResourceConfig(filepath, name, desc),
+				PlanOnly: true,
+			},
+			{
+				Config:      testAccVolumeGroupsDiskResourceConfig(filepath, name, desc) + testAccVolumeGroupDuplicateIndexDiskConfig(),
+				ExpectError: regexp.MustCompile("disk index 1 is already used by disk"),
+			},
 		},
 	})
 }
 
+func TestAccV2NutanixVolumeGroupDiskResource_AllowOnlineResize(t *testing.T) {
+	r := acctest.RandInt()
+	name := fmt.Sprintf("tf-test-volume-group-%d", r)
+	desc := "test volume group disk description"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVolumeGroupResourceConfig(name, desc) + testAccVolumeGroupIscsiClientResourceConfig() +
+					testAccVolumeGroupAttachedDiskConfig(diskSizeBytes, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceVolumeGroupDisk, "allow_online_resize", "false"),
+					resource.TestCheckResourceAttr(resourceVolumeGroupDisk, "disk_size_bytes", strconv.Itoa(int(diskSizeBytes))),
+				),
+			},
+			// the Volume Group is attached, growing the disk must wait for a maintenance window
+			{
+				Config: testAccVolumeGroupResourceConfig(name, desc) + testAccVolumeGroupIscsiClientResourceConfig() +
+					testAccVolumeGroupAttachedDiskConfig(2*diskSizeBytes, false),
+				ExpectError: regexp.MustCompile("allow_online_resize is false"),
+			},
+			{
+				Config: testAccVolumeGroupResourceConfig(name, desc) + testAccVolumeGroupIscsiClientResourceConfig() +
+					testAccVolumeGroupAttachedDiskConfig(2*diskSizeBytes, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceVolumeGroupDisk, "allow_online_resize", "true"),
+					resource.TestCheckResourceAttr(resourceVolumeGroupDisk, "disk_size_bytes", strconv.Itoa(int(2*diskSizeBytes))),
+				),
+			},
+		},
+	})
+}
+
+func testAccVolumeGroupDuplicateIndexDiskConfig() string {
+	return fmt.Sprintf(`
+	resource "nutanix_volume_group_disk_v2" "duplicate" {
+		volume_group_ext_id = resource.nutanix_volume_group_v2.test.id
+		index               = 1
+		disk_size_bytes     = %d
+
+		disk_data_source_reference {
+			ext_id      = data.nutanix_storage_containers_v2.test.storage_containers[0].ext_id
+			entity_type = "STORAGE_CONTAINER"
+		}
+	}
+`, diskSizeBytes)
+}
+
 func testAccVolumeGroupsDiskResourceConfig(filepath, name, desc string) string {
 	return testAccVolumeGroupResourceConfig(name, desc) +
 		testAccVolumeGroupDiskResourceConfig(name, desc)
 }
+
+func testAccVolumeGroupAttachedDiskConfig(size int64, allowOnlineResize bool) string {
+	return fmt.Sprintf(`
+	data "nutanix_storage_containers_v2" "test" {
+		filter = "clusterExtId eq '${local.cluster1}'"
+		limit  = 1
+	}
+	resource "nutanix_volume_group_disk_v2" "test" {
+		volume_group_ext_id = resource.nutanix_volume_group_v2.test.id
+		disk_size_bytes     = %d
+		allow_online_resize = %t
+
+		disk_data_source_reference {
+			ext_id      = data.nutanix_storage_containers_v2.test.storage_containers[0].ext_id
+			entity_type = "STORAGE_CONTAINER"
+		}
+
+		lifecycle {
+			ignore_changes = [
+				disk_data_source_reference
+			]
+		}
+
+		depends_on = [resource.nutanix_volume_group_iscsi_client_v2.test]
+	}
+`, size, allowOnlineResize)
+}
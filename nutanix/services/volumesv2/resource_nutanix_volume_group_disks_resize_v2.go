@@ -0,0 +1,198 @@
+package volumesv2
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	volumesPrism "github.com/nutanix/ntnx-api-golang-clients/volumes-go-client/v4/models/prism/v4/config"
+	volumesClient "github.com/nutanix/ntnx-api-golang-clients/volumes-go-client/v4/models/volumes/v4/config"
+	conns "github.com/terraform-providers/terraform-provider-nutanix/nutanix"
+	"github.com/terraform-providers/terraform-provider-nutanix/utils"
+)
+
+// proportional growth is rounded up to a whole MiB
+const volumeDiskResizeAlignmentBytes int64 = 1024 * 1024
+
+var volumeGroupDisksResizeArgs = []string{"increase_bytes", "increase_percent", "disk_size_bytes"}
+
+// ResourceNutanixVolumeGroupDisksResizeV2 grows every disk of a Volume Group
+// when it is created. It is an action, destroying it does not shrink anything.
+func ResourceNutanixVolumeGroupDisksResizeV2() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Resizes all the disks of a Volume Group.",
+		CreateContext: ResourceNutanixVolumeGroupDisksResizeV2Create,
+		ReadContext:   ResourceNutanixVolumeGroupDisksResizeV2Read,
+		DeleteContext: ResourceNutanixVolumeGroupDisksResizeV2Delete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"volume_group_ext_id": {
+				Description: "The external identifier of the volume group.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"increase_bytes": {
+				Description:  "Number of bytes added to the size of every disk.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: volumeGroupDisksResizeArgs,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"increase_percent": {
+				Description:  "Percentage of its current size added to every disk, rounded up to a whole MiB.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: volumeGroupDisksResizeArgs,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"disk_size_bytes": {
+				Description:  "Size in bytes every disk is grown to. Disks already of this size are left unchanged, a disk larger than this size fails the resize.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: volumeGroupDisksResizeArgs,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"disks": {
+				Description: "The disks of the volume group with their size before and after the resize.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ext_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"index": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"previous_disk_size_bytes": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"disk_size_bytes": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func ResourceNutanixVolumeGroupDisksResizeV2Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.Client).VolumeAPI
+
+	volumeGroupExtID := d.Get("volume_group_ext_id").(string)
+	disks, err := listVolumeGroupDisks(conn, volumeGroupExtID)
+	if err != nil {
+		return diag.Errorf("error while listing the disks of Volume Group %s : %v", volumeGroupExtID, err)
+	}
+	if len(disks) == 0 {
+		return diag.Errorf("Volume Group %s has no disks to resize", volumeGroupExtID)
+	}
+
+	// every new size is checked before the first resize is issued, so that a
+	// disk which would shrink leaves the whole Volume Group untouched
+	newSizes := make([]int64, len(disks))
+	shrinking := make([]string, 0)
+	for k, disk := range disks {
+		size := utils.Int64Value(disk.DiskSizeBytes)
+		newSizes[k] = volumeDiskResizedBytes(d, size)
+		if newSizes[k] < size {
+			shrinking = append(shrinking, fmt.Sprintf("%s (%d < %d bytes)", utils.StringValue(disk.ExtId), newSizes[k], size))
+		}
+	}
+	if len(shrinking) > 0 {
+		return diag.Errorf("disks can not be shrunk, resizing Volume Group %s would shrink disks %s", volumeGroupExtID, strings.Join(shrinking, ", "))
+	}
+
+	d.SetId(resource.UniqueId())
+
+	resized := make([]interface{}, 0, len(disks))
+	for k, disk := range disks {
+		diskExtID := utils.StringValue(disk.ExtId)
+		size := utils.Int64Value(disk.DiskSizeBytes)
+		if newSizes[k] == size {
+			log.Printf("[DEBUG] disk %s of Volume Group %s is already %d bytes", diskExtID, volumeGroupExtID, size)
+		} else if err := resizeVolumeDisk(ctx, meta, volumeGroupExtID, disk, newSizes[k], d.Timeout(schema.TimeoutCreate)); err != nil {
+			d.SetId("")
+			return diag.Errorf("error while resizing disk %s of Volume Group %s, %d of %d disks resized : %v", diskExtID, volumeGroupExtID, len(resized), len(disks), err)
+		}
+		resized = append(resized, map[string]interface{}{
+			"ext_id":                   diskExtID,
+			"index":                    utils.IntValue(disk.Index),
+			"previous_disk_size_bytes": size,
+			"disk_size_bytes":          newSizes[k],
+		})
+	}
+
+	if err := d.Set("disks", resized); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func ResourceNutanixVolumeGroupDisksResizeV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return nil
+}
+
+func ResourceNutanixVolumeGroupDisksResizeV2Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return nil
+}
+
+// volumeDiskResizedBytes returns the size a disk of size bytes is resized to.
+func volumeDiskResizedBytes(d *schema.ResourceData, size int64) int64 {
+	if increase, ok := d.GetOk("increase_bytes"); ok {
+		return size + int64(increase.(int))
+	}
+	if percent, ok := d.GetOk("increase_percent"); ok {
+		increase := size * int64(percent.(int)) / 100
+		if remainder := increase % volumeDiskResizeAlignmentBytes; remainder != 0 || increase == 0 {
+			increase += volumeDiskResizeAlignmentBytes - remainder
+		}
+		return size + increase
+	}
+	return int64(d.Get("disk_size_bytes").(int))
+}
+
+// resizeVolumeDisk sets the size of a Volume Group disk and waits for the
+// update task. Like the disk resource update, the index and data source are
+// not sent back.
+func resizeVolumeDisk(ctx context.Context, meta interface{}, volumeGroupExtID string, disk volumesClient.VolumeDisk, sizeBytes int64, timeout time.Duration) error {
+	conn := meta.(*conns.Client).VolumeAPI
+
+	disk.Index = nil
+	disk.DiskDataSourceReference = nil
+	disk.DiskSizeBytes = utils.Int64Ptr(sizeBytes)
+
+	resp, err := conn.VolumeAPIInstance.UpdateVolumeDiskById(utils.StringPtr(volumeGroupExtID), disk.ExtId, &disk)
+	if err != nil {
+		return err
+	}
+	taskUUID := resp.Data.GetValue().(volumesPrism.TaskReference).ExtId
+
+	stateConf := &resource.StateChangeConf{
+		Pending: utils.TaskPendingStates,
+		Target:  utils.TaskTargetStates,
+		Refresh: taskStateRefreshPrismTaskGroupFunc(ctx, meta.(*conns.Client).PrismAPI, utils.StringValue(taskUUID), utils.TaskPollRetry{}),
+		Timeout: timeout,
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("error waiting for Volume Disk (%s) to resize: %w", utils.StringValue(taskUUID), err)
+	}
+	return nil
+}
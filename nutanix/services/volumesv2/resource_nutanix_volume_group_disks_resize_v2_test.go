@@ -0,0 +1,65 @@
+package volumesv2_test
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	acc "github.com/terraform-providers/terraform-provider-nutanix/nutanix/acctest"
+)
+
+const resourceVolumeGroupDisksResize = "nutanix_volume_group_disks_resize_v2.test"
+
+func TestAccV2NutanixVolumeGroupDisksResizeResource_Basic(t *testing.T) {
+	r := acctest.RandInt()
+	name := fmt.Sprintf("tf-test-volume-group-%d", r)
+	desc := "test volume group disks resize description"
+	increase := int64(1073741824)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVolumeGroupsDiskResourceConfig(filepath, name, desc) +
+					testAccVolumeGroupDisksResizeResourceConfig(fmt.Sprintf("increase_bytes = %d", increase)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceVolumeGroupDisksResize, "disks.#", "1"),
+					resource.TestCheckResourceAttr(resourceVolumeGroupDisksResize, "disks.0.previous_disk_size_bytes", strconv.Itoa(int(diskSizeBytes))),
+					resource.TestCheckResourceAttr(resourceVolumeGroupDisksResize, "disks.0.disk_size_bytes", strconv.Itoa(int(diskSizeBytes+increase))),
+				),
+			},
+		},
+	})
+}
+
+func TestAccV2NutanixVolumeGroupDisksResizeResource_RejectShrink(t *testing.T) {
+	r := acctest.RandInt()
+	name := fmt.Sprintf("tf-test-volume-group-%d", r)
+	desc := "test volume group disks resize description"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVolumeGroupsDiskResourceConfig(filepath, name, desc) +
+					testAccVolumeGroupDisksResizeResourceConfig(fmt.Sprintf("disk_size_bytes = %d", diskSizeBytes/2)),
+				ExpectError: regexp.MustCompile("disks can not be shrunk"),
+			},
+		},
+	})
+}
+
+func testAccVolumeGroupDisksResizeResourceConfig(resize string) string {
+	return fmt.Sprintf(`
+	resource "nutanix_volume_group_disks_resize_v2" "test" {
+		volume_group_ext_id = resource.nutanix_volume_group_v2.test.id
+		%s
+		depends_on          = [resource.nutanix_volume_group_disk_v2.test]
+	}
+`, resize)
+}
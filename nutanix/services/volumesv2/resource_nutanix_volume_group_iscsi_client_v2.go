@@ -2,6 +2,7 @@ package volumesv2
 
 import (
 	"context"
+	"fmt"
 	"log"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -24,6 +25,7 @@ func ResourceNutanixVolumeGroupIscsiClientV2() *schema.Resource {
 		ReadContext:   ResourceNutanixVolumeGroupIscsiClientV2Read,
 		UpdateContext: ResourceNutanixVolumeGroupIscsiClientV2Update,
 		DeleteContext: ResourceNutanixVVolumeGroupIscsiClientV2Delete,
+		CustomizeDiff: resourceNutanixVolumeGroupIscsiClientV2Diff,
 		Schema: map[string]*schema.Schema{
 			"vg_ext_id": {
 				Description: "The external identifier of the Volume Group.",
@@ -69,9 +71,24 @@ func ResourceNutanixVolumeGroupIscsiClientV2() *schema.Resource {
 				},
 			},
 			"client_secret": {
-				Description: "iSCSI initiator client secret in case of CHAP authentication. This field should not be provided in case the authentication type is not set to CHAP..",
+				Description:   "iSCSI initiator client secret in case of CHAP authentication. This field should not be provided in case the authentication type is not set to CHAP..",
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				ConflictsWith: []string{"initiator_secret"},
+			},
+			"initiator_secret": {
+				Description:   "Initiator side secret used for mutual CHAP authentication. It is sent to the API as the iSCSI client secret. This field should not be provided in case the authentication type is not set to CHAP.",
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				ConflictsWith: []string{"client_secret"},
+			},
+			"target_secret": {
+				Description: "Target secret configured on the Volume Group. When provided, mutual CHAP is validated at plan time: the initiator secret must be set and must differ from the target secret. This field is only used for validation and is not sent with the attachment.",
 				Type:        schema.TypeString,
 				Optional:    true,
+				Sensitive:   true,
 			},
 			"enabled_authentications": {
 				Description:  "The authentication type enabled for the Volume Group. This is an optional field. If omitted, authentication is not configured for the Volume Group. If this is set to CHAP, the target/client secret must be provided.",
@@ -161,6 +178,9 @@ func ResourceNutanixVolumeGroupIscsiClientV2Create(ctx context.Context, d *schem
 	if clientSecret, ok := d.GetOk("client_secret"); ok {
 		body.ClientSecret = utils.StringPtr(clientSecret.(string))
 	}
+	if initiatorSecret, ok := d.GetOk("initiator_secret"); ok {
+		body.ClientSecret = utils.StringPtr(initiatorSecret.(string))
+	}
 	if enabledAuthentications, ok := d.GetOk("enabled_authentications"); ok {
 		const two, three = 2, 3
 		enabledAuthenticationsMap := map[string]interface{}{
@@ -187,7 +207,7 @@ func ResourceNutanixVolumeGroupIscsiClientV2Create(ctx context.Context, d *schem
 
 	resp, err := conn.VolumeAPIInstance.AttachIscsiClient(utils.StringPtr(volumeGroupExtID.(string)), &body)
 	if err != nil {
-		return diag.Errorf("error while Attaching Iscsi Client to Volume Group: %v", err)
+		return utils.WithRemediationHint(diag.Errorf("error while Attaching Iscsi Client to Volume Group: %v", err), err)
 	}
 
 	TaskRef := resp.Data.GetValue().(volumesPrism.TaskReference)
@@ -196,9 +216,9 @@ func ResourceNutanixVolumeGroupIscsiClientV2Create(ctx context.Context, d *schem
 	taskconn := meta.(*conns.Client).PrismAPI
 	// Wait for the VM to be available
 	stateConf := &resource.StateChangeConf{
-		Pending: []string{"PENDING", "RUNNING", "QUEUED"},
-		Target:  []string{"SUCCEEDED"},
-		Refresh: taskStateRefreshPrismTaskGroupFunc(ctx, taskconn, utils.StringValue(taskUUID)),
+		Pending: utils.TaskPendingStates,
+		Target:  utils.TaskTargetStates,
+		Refresh: taskStateRefreshPrismTaskGroupFunc(ctx, taskconn, utils.StringValue(taskUUID), utils.TaskPollRetry{}),
 		Timeout: d.Timeout(schema.TimeoutCreate),
 	}
 
@@ -244,7 +264,7 @@ func ResourceNutanixVVolumeGroupIscsiClientV2Delete(ctx context.Context, d *sche
 
 	resp, err := conn.VolumeAPIInstance.DetachIscsiClient(utils.StringPtr(volumeGroupExtID.(string)), &body)
 	if err != nil {
-		return diag.Errorf("error while Detaching Iscsi Client to Volume Group: %v", err)
+		return utils.WithRemediationHint(diag.Errorf("error while Detaching Iscsi Client to Volume Group: %v", err), err)
 	}
 
 	TaskRef := resp.Data.GetValue().(volumesPrism.TaskReference)
@@ -253,9 +273,9 @@ func ResourceNutanixVVolumeGroupIscsiClientV2Delete(ctx context.Context, d *sche
 	taskconn := meta.(*conns.Client).PrismAPI
 	// Wait for the VM to be available
 	stateConf := &resource.StateChangeConf{
-		Pending: []string{"PENDING", "RUNNING", "QUEUED"},
-		Target:  []string{"SUCCEEDED"},
-		Refresh: taskStateRefreshPrismTaskGroupFunc(ctx, taskconn, utils.StringValue(taskUUID)),
+		Pending: utils.TaskPendingStates,
+		Target:  utils.TaskTargetStates,
+		Refresh: taskStateRefreshPrismTaskGroupFunc(ctx, taskconn, utils.StringValue(taskUUID), utils.TaskPollRetry{}),
 		Timeout: d.Timeout(schema.TimeoutCreate),
 	}
 
@@ -280,6 +300,37 @@ func ResourceNutanixVVolumeGroupIscsiClientV2Delete(ctx context.Context, d *sche
 	return nil
 }
 
+func resourceNutanixVolumeGroupIscsiClientV2Diff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	// skip the validation while the secrets are not known yet
+	for _, key := range []string{"client_secret", "initiator_secret", "target_secret", "enabled_authentications"} {
+		if !d.NewValueKnown(key) {
+			return nil
+		}
+	}
+
+	initiatorSecret := d.Get("initiator_secret").(string)
+	if initiatorSecret == "" {
+		initiatorSecret = d.Get("client_secret").(string)
+	}
+	targetSecret := d.Get("target_secret").(string)
+
+	// the secrets are not read back, an existing attachment does not need them
+	// in the configuration
+	if d.Id() == "" && d.Get("enabled_authentications").(string) == "CHAP" && initiatorSecret == "" {
+		return fmt.Errorf("initiator_secret (or client_secret) must be provided when enabled_authentications is CHAP")
+	}
+	// mutual CHAP needs both secrets and they must differ
+	if targetSecret != "" {
+		if initiatorSecret == "" {
+			return fmt.Errorf("mutual CHAP requires both target_secret and initiator_secret to be provided")
+		}
+		if initiatorSecret == targetSecret {
+			return fmt.Errorf("mutual CHAP requires initiator_secret and target_secret to be different")
+		}
+	}
+	return nil
+}
+
 func expandiscsiInitiatorNetworkID(ipAddressOrFQDN interface{}) *config.IPAddressOrFQDN {
 	if ipAddressOrFQDN != nil {
 		fip := &config.IPAddressOrFQDN{}
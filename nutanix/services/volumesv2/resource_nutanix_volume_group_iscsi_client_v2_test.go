@@ -2,6 +2,7 @@ package volumesv2_test
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -30,6 +31,40 @@ func TestAccV2NutanixVolumeGroupIscsiClientResource_Basic(t *testing.T) {
 	})
 }
 
+func TestAccV2NutanixVolumeGroupIscsiClientResource_MutualChapSameSecrets(t *testing.T) {
+	r := acctest.RandInt()
+	name := fmt.Sprintf("tf-test-volume-group-%d", r)
+	desc := "test volume group Iscsi Client description"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccVolumeGroupResourceConfig(name, desc) + testAccVolumeGroupIscsiClientResourceMutualChapConfig("1234567891011", "1234567891011"),
+				ExpectError: regexp.MustCompile("mutual CHAP requires initiator_secret and target_secret to be different"),
+			},
+		},
+	})
+}
+
+func TestAccV2NutanixVolumeGroupIscsiClientResource_MutualChapWithoutInitiatorSecret(t *testing.T) {
+	r := acctest.RandInt()
+	name := fmt.Sprintf("tf-test-volume-group-%d", r)
+	desc := "test volume group Iscsi Client description"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccVolumeGroupResourceConfig(name, desc) + testAccVolumeGroupIscsiClientResourceMutualChapConfig("1234567891011", ""),
+				ExpectError: regexp.MustCompile("must be provided when enabled_authentications is CHAP"),
+			},
+		},
+	})
+}
+
 func testAccVolumeGroupIscsiClientResourceConfig() string {
 	return `	
 		data "nutanix_volume_iscsi_clients_v2" "test" {}
@@ -41,3 +76,18 @@ func testAccVolumeGroupIscsiClientResourceConfig() string {
 		}		
 	`
 }
+
+func testAccVolumeGroupIscsiClientResourceMutualChapConfig(targetSecret, initiatorSecret string) string {
+	return fmt.Sprintf(`
+		data "nutanix_volume_iscsi_clients_v2" "test" {}
+		resource "nutanix_volume_group_iscsi_client_v2" "test" {
+			vg_ext_id               = resource.nutanix_volume_group_v2.test.id
+			ext_id                  = data.nutanix_volume_iscsi_clients_v2.test.iscsi_clients.0.ext_id
+			iscsi_initiator_name    = data.nutanix_volume_iscsi_clients_v2.test.iscsi_clients.0.iscsi_initiator_name
+			enabled_authentications = "CHAP"
+			target_secret           = "%s"
+			initiator_secret        = "%s"
+			depends_on = [ resource.nutanix_volume_group_v2.test ]
+		}
+	`, targetSecret, initiatorSecret)
+}
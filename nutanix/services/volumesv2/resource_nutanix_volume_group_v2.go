@@ -2,21 +2,40 @@ package volumesv2
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	clustermgmtConfig "github.com/nutanix/ntnx-api-golang-clients/clustermgmt-go-client/v4/models/clustermgmt/v4/config"
 	taskPoll "github.com/nutanix/ntnx-api-golang-clients/prism-go-client/v4/models/prism/v4/config"
+	"github.com/nutanix/ntnx-api-golang-clients/volumes-go-client/v4/models/common/v1/config"
 	volumesPrism "github.com/nutanix/ntnx-api-golang-clients/volumes-go-client/v4/models/prism/v4/config"
 	volumesClient "github.com/nutanix/ntnx-api-golang-clients/volumes-go-client/v4/models/volumes/v4/config"
 	conns "github.com/terraform-providers/terraform-provider-nutanix/nutanix"
+	"github.com/terraform-providers/terraform-provider-nutanix/nutanix/sdks/v4/clusters"
 	"github.com/terraform-providers/terraform-provider-nutanix/nutanix/sdks/v4/prism"
+	"github.com/terraform-providers/terraform-provider-nutanix/nutanix/sdks/v4/volumes"
 	"github.com/terraform-providers/terraform-provider-nutanix/utils"
 )
 
+// volumeGroupProviderArgs only tune the provider, changing them alone sends
+// nothing to the API.
+var volumeGroupProviderArgs = []string{"force_detach", "check_name_uniqueness", "check_flash_tier", "rollback_on_failure", "task_poll_retry", "wait_for_task"}
+
+// volumeGroupCreateOnlyArgs are only sent by the create, the update spec
+// can not change them.
+var volumeGroupCreateOnlyArgs = []string{"disks", "attachment_type", "protocol", "created_by"}
+
 // CRUD for Volume Group.
 func ResourceNutanixVolumeGroupV2() *schema.Resource {
 	return &schema.Resource{
@@ -25,6 +44,10 @@ func ResourceNutanixVolumeGroupV2() *schema.Resource {
 		ReadContext:   ResourceNutanixVolumeGroupV2Read,
 		UpdateContext: ResourceNutanixVolumeGroupV2Update,
 		DeleteContext: ResourceNutanixVolumeGroupV2Delete,
+		CustomizeDiff: resourceNutanixVolumeGroupV2Diff,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceNutanixVolumeGroupV2Import,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"ext_id": {
@@ -64,10 +87,36 @@ func ResourceNutanixVolumeGroupV2() *schema.Resource {
 				Optional:    true,
 				Computed:    true,
 			},
+			"target_portals": {
+				Description: "The iSCSI portals the Volume Group target is reached at, the external data services IP addresses of its cluster. A dual-stack cluster has one portal per address family.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"port": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"family": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"portal": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"enabled_authentications": {
-				Description:  "The authentication type enabled for the Volume Group. This is an optional field. If omitted, authentication is not configured for the Volume Group. If this is set to CHAP, the target/client secret must be provided.",
+				Description:  "The authentication type enabled for the Volume Group. This is an optional field. If omitted, authentication is not configured for the Volume Group and NONE is reported. If this is set to CHAP, the target/client secret must be provided.",
 				Type:         schema.TypeString,
 				Optional:     true,
+				Computed:     true,
 				ValidateFunc: validation.StringInSlice([]string{"CHAP", "NONE"}, false),
 			},
 			"iscsi_features": {
@@ -81,25 +130,39 @@ func ResourceNutanixVolumeGroupV2() *schema.Resource {
 							Description: "Target secret in case of a CHAP authentication. This field must only be provided in case the authentication type is not set to CHAP. This is an optional field and it cannot be retrieved once configured.",
 							Type:        schema.TypeString,
 							Optional:    true,
+							Sensitive:   true,
 						},
 						"enabled_authentications": {
 							Description:  "The authentication type enabled for the Volume Group. This is an optional field. If omitted, authentication is not configured for the Volume Group. If this is set to CHAP, the target/client secret must be provided.",
 							Type:         schema.TypeString,
 							Optional:     true,
+							Computed:     true,
 							ValidateFunc: validation.StringInSlice([]string{"CHAP", "NONE"}, false),
 						},
 					},
 				},
 			},
+			"categories": {
+				Description: "The ext_ids of the categories associated with the Volume Group. Categories removed from the set are disassociated, when omitted the categories associated outside of Terraform are kept.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
 			"created_by": {
-				Description: "Service/user who created this Volume Group. This is an optional field.",
+				Description: "Service/user who created this Volume Group. Set by the API to the calling user when omitted.",
 				Type:        schema.TypeString,
 				Optional:    true,
+				Computed:    true,
 			},
 			"cluster_reference": {
-				Description: "The UUID of the cluster that will host the Volume Group. This is a mandatory field for creating a Volume Group on Prism Central.",
+				Description: "The UUID of the cluster that will host the Volume Group. Defaults to the provider default_cluster_ext_id when not set. Changing it recreates the Volume Group.",
 				Type:        schema.TypeString,
-				Required:    true,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
 			},
 			"storage_features": {
 				Description: "Storage optimization features which must be enabled on the Volume Group. This is an optional field.",
@@ -145,6 +208,54 @@ func ResourceNutanixVolumeGroupV2() *schema.Resource {
 				Optional: true,
 				Default:  false,
 			},
+			"force_detach": {
+				Description: "Detach all the VMs and iSCSI clients attached to the Volume Group before deleting it.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+			"check_name_uniqueness": {
+				Description: "Fail at plan time if another Volume Group with the same name already exists on the cluster. This costs an extra list call on every plan changing the name.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+			"check_flash_tier": {
+				Description: "Warn when flash mode is enabled on a cluster without an SSD tier, where it has no effect. This costs an extra list of the cluster hosts on every plan enabling flash mode.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+			"rollback_on_failure": {
+				Description: "Delete the Volume Group and the disks already created when its create task fails, instead of keeping it in state as tainted.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+			"task_poll_retry": utils.TaskPollRetrySchema(),
+			"wait_for_task": {
+				Description: "Wait for the create task to complete. When false the create returns once the task is submitted and the Volume Group is reconciled by a later refresh.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+			},
+			"task_ext_id": {
+				Description: "The ext_id of the create task submitted with wait_for_task = false, until a refresh has seen it complete.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"last_task_ext_id": {
+				Description: "The ext_id of the last task that was polled to completion for this Volume Group.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"source_volume_group_ext_id": {
+				Description:   "The ext_id of a Volume Group on the same cluster whose disks are cloned into the new Volume Group at create.",
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"disks"},
+			},
 			"disks": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -219,7 +330,7 @@ func ResourceNutanixVolumeGroupV2() *schema.Resource {
 }
 
 func ResourceNutanixVolumeGroupV2Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	log.Printf("[INFO_VG] Creating Volume Group")
+	utils.LogEvent(meta.(*conns.Client).LogFormat, "INFO", "creating volume group", utils.LogFields{"resource": "nutanix_volume_group_v2"})
 	conn := meta.(*conns.Client).VolumeAPI
 
 	body := volumesClient.VolumeGroup{}
@@ -231,6 +342,8 @@ func ResourceNutanixVolumeGroupV2Create(ctx context.Context, d *schema.ResourceD
 	if desc, ok := d.GetOk("description"); ok {
 		body.Description = utils.StringPtr(desc.(string))
 	}
+	// load balancing conflicts with iSCSI client attachments, it is part of the
+	// create payload so that nothing can be attached before it is enabled
 	if shouldLoadBalanceVMAttachments, ok := d.GetOk("should_load_balance_vm_attachments"); ok {
 		body.ShouldLoadBalanceVmAttachments = utils.BoolPtr(shouldLoadBalanceVMAttachments.(bool))
 	}
@@ -270,13 +383,16 @@ func ResourceNutanixVolumeGroupV2Create(ctx context.Context, d *schema.ResourceD
 	if createdBy, ok := d.GetOk("created_by"); ok {
 		body.CreatedBy = utils.StringPtr(createdBy.(string))
 	}
-	// Required field
-	if clusterReference, ok := d.GetOk("cluster_reference"); ok {
-		body.ClusterReference = utils.StringPtr(clusterReference.(string))
+	// Required field, falls back to the provider default cluster
+	clusterReference, err := meta.(*conns.Client).ClusterExtIDOrDefault(d.Get("cluster_reference").(string), "cluster_reference")
+	if err != nil {
+		return diag.FromErr(err)
 	}
+	body.ClusterReference = utils.StringPtr(clusterReference)
 	if storageFeatures, ok := d.GetOk("storage_features"); ok {
 		body.StorageFeatures = expandStorageFeatures(storageFeatures.([]interface{}))
 	}
+	flashTierDiags := volumeGroupFlashTierDiags(d, meta, clusterReference)
 	if usageType, ok := d.GetOk("usage_type"); ok {
 		const two, three, four, five = 2, 3, 4, 5
 		usageTypeMap := map[string]interface{}{
@@ -324,25 +440,49 @@ func ResourceNutanixVolumeGroupV2Create(ctx context.Context, d *schema.ResourceD
 	}
 	if disks, ok := d.GetOk("disks"); ok {
 		body.Disks = expandDisks(disks.([]interface{}))
+		// an unset index reads as 0, leave it to the backend instead
+		for k, index := range configuredDiskIndexes(d.GetRawConfig()) {
+			if index == nil && k < len(body.Disks) {
+				body.Disks[k].Index = nil
+			}
+		}
+	}
+	if sourceExtID, ok := d.GetOk("source_volume_group_ext_id"); ok {
+		body.Disks, err = cloneVolumeGroupDisks(conn, sourceExtID.(string), clusterReference)
+		if err != nil {
+			return diag.FromErr(err)
+		}
 	}
 	resp, err := conn.VolumeAPIInstance.CreateVolumeGroup(&body)
 	if err != nil {
-		return diag.Errorf("error while creating Volume Group : %v", err)
+		return utils.WithRemediationHint(diag.Errorf("error while creating Volume Group : %v", err), err)
 	}
 
 	TaskRef := resp.Data.GetValue().(volumesPrism.TaskReference)
 	taskUUID := TaskRef.ExtId
 
+	// the id stays the task one until the task reports the Volume Group, see
+	// reconcileVolumeGroupCreateTask
+	if !d.Get("wait_for_task").(bool) {
+		d.SetId(utils.StringValue(taskUUID))
+		d.Set("task_ext_id", utils.StringValue(taskUUID))
+		return append(ResourceNutanixVolumeGroupV2Read(ctx, d, meta), flashTierDiags...)
+	}
+
 	taskconn := meta.(*conns.Client).PrismAPI
 	// Wait for the VM to be available
 	stateConf := &resource.StateChangeConf{
-		Pending: []string{"PENDING", "RUNNING", "QUEUED"},
-		Target:  []string{"SUCCEEDED"},
-		Refresh: taskStateRefreshPrismTaskGroupFunc(ctx, taskconn, utils.StringValue(taskUUID)),
+		Pending: utils.TaskPendingStates,
+		Target:  utils.TaskTargetStates,
+		Refresh: taskStateRefreshPrismTaskGroupFunc(ctx, taskconn, utils.StringValue(taskUUID), utils.ExpandTaskPollRetry(d.Get("task_poll_retry"))),
 		Timeout: d.Timeout(schema.TimeoutCreate),
 	}
 
 	if _, errWaitTask := stateConf.WaitForStateContext(ctx); errWaitTask != nil {
+		var taskFailed *utils.TaskFailedError
+		if errors.As(errWaitTask, &taskFailed) {
+			return handleFailedVolumeGroupCreate(ctx, d, meta, taskFailed)
+		}
 		return diag.Errorf("error waiting for template (%s) to create: %s", utils.StringValue(taskUUID), errWaitTask)
 	}
 
@@ -354,18 +494,58 @@ func ResourceNutanixVolumeGroupV2Create(ctx context.Context, d *schema.ResourceD
 	}
 	rUUID := resourceUUID.Data.GetValue().(taskPoll.Task)
 
-	uuid := rUUID.EntitiesAffected[0].ExtId
-	d.SetId(*uuid)
-	d.Set("ext_id", *uuid)
+	uuid, err := volumeGroupExtIDFromCreateTask(conn, rUUID, utils.StringValue(body.Name), clusterReference)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(uuid)
+	d.Set("ext_id", uuid)
+	d.Set("last_task_ext_id", utils.StringValue(taskUUID))
 
-	return nil
+	// a busy Prism Central may not return the Volume Group right after its
+	// create task, the read would then drop it from state
+	err = utils.CreateNotFoundRetry.Do(ctx, func() error {
+		_, err := conn.VolumeAPIInstance.GetVolumeGroupById(utils.StringPtr(uuid))
+		return err
+	})
+	if utils.IsV4NotFound(err) {
+		return diag.Errorf("Volume Group %s created by task %s is still not found after %s : %v", uuid, utils.StringValue(taskUUID), utils.CreateNotFoundRetry.Timeout, err)
+	}
+
+	if categories := d.Get("categories").(*schema.Set); categories.Len() > 0 {
+		err := updateVolumeGroupCategories(ctx, meta, uuid, expandCategoryExtIDs(categories), true, d.Timeout(schema.TimeoutCreate), utils.ExpandTaskPollRetry(d.Get("task_poll_retry")))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	// read back the computed fields, such as the target name generated by the
+	// cluster, so they can be referenced within the same apply
+	return append(ResourceNutanixVolumeGroupV2Read(ctx, d, meta), flashTierDiags...)
 }
 
 func ResourceNutanixVolumeGroupV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.Client).VolumeAPI
 
+	var diags diag.Diagnostics
+	if d.Get("task_ext_id").(string) != "" {
+		pending, taskDiags, err := reconcileVolumeGroupCreateTask(ctx, d, meta)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		diags = taskDiags
+		if pending || d.Id() == "" {
+			return diags
+		}
+	}
+
 	resp, err := conn.VolumeAPIInstance.GetVolumeGroupById(utils.StringPtr(d.Id()))
 	if err != nil {
+		if utils.IsV4NotFound(err) {
+			log.Printf("[DEBUG] volume group %s not found, removing it from state", d.Id())
+			d.SetId("")
+			return nil
+		}
 		return diag.Errorf("error while fetching Volume Group : %v", err)
 	}
 
@@ -389,10 +569,34 @@ func ResourceNutanixVolumeGroupV2Read(ctx context.Context, d *schema.ResourceDat
 	if err := d.Set("target_name", getResp.TargetName); err != nil {
 		return diag.FromErr(err)
 	}
-	if err := d.Set("enabled_authentications", flattenEnabledAuthentications(getResp.EnabledAuthentications)); err != nil {
+	// a failure to list the categories keeps the previous ones instead of
+	// failing the refresh
+	if categories, err := listVolumeGroupCategories(conn, d.Id()); err != nil {
+		log.Printf("[DEBUG] unable to fetch the categories of Volume Group %s: %v", d.Id(), err)
+	} else if err := d.Set("categories", categories); err != nil {
+		return diag.FromErr(err)
+	}
+	// the portals are read from the cluster, which the credentials may not be
+	// allowed to read, keep the previous portals when it can not be fetched.
+	// They only change with the cluster network, each cluster is read once per
+	// run of the provider.
+	clusterRef := utils.StringValue(getResp.ClusterReference)
+	targetPortals, err := meta.(*conns.Client).TargetPortalCache.Get(clusterRef, func() (interface{}, error) {
+		return volumeGroupTargetPortals(meta.(*conns.Client).ClusterAPI, clusterRef)
+	})
+	if err != nil {
+		log.Printf("[DEBUG] unable to fetch the target portals of Volume Group %s: %v", d.Id(), err)
+	} else if err := d.Set("target_portals", targetPortals); err != nil {
+		return diag.FromErr(err)
+	}
+	// the authentication type is reported both at the top level and inside
+	// iscsi_features, reconcile them so that configuring either one does not
+	// produce a diff on the other.
+	enabledAuthentications := reconcileEnabledAuthentications(getResp.EnabledAuthentications, getResp.IscsiFeatures)
+	if err := d.Set("enabled_authentications", enabledAuthentications); err != nil {
 		return diag.FromErr(err)
 	}
-	if err := d.Set("iscsi_features", flattenIscsiFeatures(getResp.IscsiFeatures)); err != nil {
+	if err := d.Set("iscsi_features", flattenIscsiFeaturesPreservingSecret(d, enabledAuthentications)); err != nil {
 		return diag.FromErr(err)
 	}
 	if err := d.Set("created_by", getResp.CreatedBy); err != nil {
@@ -411,19 +615,582 @@ func ResourceNutanixVolumeGroupV2Read(ctx context.Context, d *schema.ResourceDat
 		return diag.FromErr(err)
 	}
 
-	return nil
+	return diags
 }
 
 func ResourceNutanixVolumeGroupV2Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.Client).VolumeAPI
+
+	// these arguments only tune the provider, there is nothing to send
+	if !d.HasChangesExcept(volumeGroupProviderArgs...) {
+		return ResourceNutanixVolumeGroupV2Read(ctx, d, meta)
+	}
+	if taskUUID := d.Get("task_ext_id").(string); taskUUID != "" {
+		return diag.Errorf("Volume Group is still being created by task %s, apply again once it has completed", taskUUID)
+	}
+	var createOnly []string
+	for _, arg := range volumeGroupCreateOnlyArgs {
+		if d.HasChange(arg) {
+			createOnly = append(createOnly, arg)
+		}
+	}
+	if len(createOnly) > 0 {
+		return diag.Errorf("%s can not be updated on an existing Volume Group, use nutanix_volume_group_disk_v2 to manage its disks", strings.Join(createOnly, ", "))
+	}
+
+	// categories are associated through their own calls, not the update spec
+	if d.HasChange("categories") {
+		oldCategories, newCategories := d.GetChange("categories")
+		removed := expandCategoryExtIDs(oldCategories.(*schema.Set).Difference(newCategories.(*schema.Set)))
+		added := expandCategoryExtIDs(newCategories.(*schema.Set).Difference(oldCategories.(*schema.Set)))
+		retry := utils.ExpandTaskPollRetry(d.Get("task_poll_retry"))
+		if len(removed) > 0 {
+			if err := updateVolumeGroupCategories(ctx, meta, d.Id(), removed, false, d.Timeout(schema.TimeoutUpdate), retry); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+		if len(added) > 0 {
+			if err := updateVolumeGroupCategories(ctx, meta, d.Id(), added, true, d.Timeout(schema.TimeoutUpdate), retry); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+		if !d.HasChangesExcept(append([]string{"categories"}, volumeGroupProviderArgs...)...) {
+			return ResourceNutanixVolumeGroupV2Read(ctx, d, meta)
+		}
+	}
+
+	if d.HasChange("sharing_status") {
+		oldSharingStatus, newSharingStatus := d.GetChange("sharing_status")
+		if err := checkSharingStatusTransition(conn, d.Id(), oldSharingStatus.(string), newSharingStatus.(string)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	resp, err := conn.VolumeAPIInstance.GetVolumeGroupById(utils.StringPtr(d.Id()))
+	if err != nil {
+		return diag.Errorf("error while fetching Volume Group : %v", err)
+	}
+
+	// Extract E-Tag Header
+	etagValue := conn.VolumeAPIInstance.ApiClient.GetEtag(resp)
+
+	args := make(map[string]interface{})
+	args["If-Match"] = utils.StringPtr(etagValue)
+
+	updateSpec := resp.Data.GetValue().(volumesClient.VolumeGroup)
+
+	if d.HasChange("name") {
+		updateSpec.Name = utils.StringPtr(d.Get("name").(string))
+	}
+	if d.HasChange("description") {
+		updateSpec.Description = utils.StringPtr(d.Get("description").(string))
+	}
+	if d.HasChange("should_load_balance_vm_attachments") {
+		updateSpec.ShouldLoadBalanceVmAttachments = utils.BoolPtr(d.Get("should_load_balance_vm_attachments").(bool))
+	}
+	if d.HasChange("sharing_status") {
+		const two, three = 2, 3
+		sharingStatusMap := map[string]interface{}{
+			"SHARED":     two,
+			"NOT_SHARED": three,
+		}
+		pVal := sharingStatusMap[d.Get("sharing_status").(string)]
+		if pVal == nil {
+			updateSpec.SharingStatus = nil
+		} else {
+			p := volumesClient.SharingStatus(pVal.(int))
+			updateSpec.SharingStatus = &p
+		}
+	}
+	if d.HasChange("target_prefix") {
+		updateSpec.TargetPrefix = utils.StringPtr(d.Get("target_prefix").(string))
+	}
+	if d.HasChange("target_name") {
+		updateSpec.TargetName = utils.StringPtr(d.Get("target_name").(string))
+	}
+	if d.HasChange("enabled_authentications") {
+		const CHAP, NONE = 2, 3
+		enabledAuthenticationsMap := map[string]interface{}{
+			"CHAP": CHAP,
+			"NONE": NONE,
+		}
+		pVal := enabledAuthenticationsMap[d.Get("enabled_authentications").(string)]
+		if pVal == nil {
+			updateSpec.EnabledAuthentications = nil
+		} else {
+			p := volumesClient.AuthenticationType(pVal.(int))
+			updateSpec.EnabledAuthentications = &p
+		}
+	}
+	if d.HasChange("iscsi_features") {
+		updateSpec.IscsiFeatures = expandIscsiFeatures(d.Get("iscsi_features").([]interface{}))
+	}
+	var warnings diag.Diagnostics
+	if d.HasChange("enabled_authentications") || d.HasChange("iscsi_features.0.enabled_authentications") {
+		if err := checkChapTargetSecret(d.GetRawConfig()); err != nil {
+			warnings = append(warnings, diag.Diagnostic{Severity: diag.Warning, Summary: err.Error()})
+		}
+	}
+	if d.HasChange("storage_features") {
+		updateSpec.StorageFeatures = expandStorageFeatures(d.Get("storage_features").([]interface{}))
+		warnings = append(warnings, volumeGroupFlashTierDiags(d, meta, d.Get("cluster_reference").(string))...)
+	}
+	if d.HasChange("usage_type") {
+		const two, three, four, five = 2, 3, 4, 5
+		usageTypeMap := map[string]interface{}{
+			"USER":          two,
+			"INTERNAL":      three,
+			"TEMPORARY":     four,
+			"BACKUP_TARGET": five,
+		}
+		pInt := usageTypeMap[d.Get("usage_type").(string)]
+		if pInt == nil {
+			updateSpec.UsageType = nil
+		} else {
+			p := volumesClient.UsageType(pInt.(int))
+			updateSpec.UsageType = &p
+		}
+	}
+	if d.HasChange("is_hidden") {
+		updateSpec.IsHidden = utils.BoolPtr(d.Get("is_hidden").(bool))
+	}
+
+	updateResp, err := conn.VolumeAPIInstance.UpdateVolumeGroupById(utils.StringPtr(d.Id()), &updateSpec, args)
+	if err != nil {
+		return utils.WithRemediationHint(diag.Errorf("error while updating Volume Group : %v", err), err)
+	}
+
+	TaskRef := updateResp.Data.GetValue().(volumesPrism.TaskReference)
+	taskUUID := TaskRef.ExtId
+
+	taskconn := meta.(*conns.Client).PrismAPI
+	stateConf := &resource.StateChangeConf{
+		Pending: utils.TaskPendingStates,
+		Target:  utils.TaskTargetStates,
+		Refresh: taskStateRefreshPrismTaskGroupFunc(ctx, taskconn, utils.StringValue(taskUUID), utils.ExpandTaskPollRetry(d.Get("task_poll_retry"))),
+		Timeout: d.Timeout(schema.TimeoutUpdate),
+	}
+
+	if _, errWaitTask := stateConf.WaitForStateContext(ctx); errWaitTask != nil {
+		return diag.Errorf("error waiting for Volume Group (%s) to update: %s", utils.StringValue(taskUUID), errWaitTask)
+	}
+	d.Set("last_task_ext_id", utils.StringValue(taskUUID))
+
+	return append(ResourceNutanixVolumeGroupV2Read(ctx, d, meta), warnings...)
+}
+
+// resourceNutanixVolumeGroupV2Diff rejects both target_prefix and target_name
+// set, duplicate disk indexes, CHAP created without a target secret and, with
+// check_name_uniqueness, a name already used on the cluster. check_flash_tier
+// is only logged, a plan can not carry warnings, the apply returns it again.
+func resourceNutanixVolumeGroupV2Diff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	rawConfig := d.GetRawConfig()
+	if rawConfig.IsNull() || !rawConfig.IsKnown() {
+		return nil
+	}
+	if !rawConfig.GetAttr("target_prefix").IsNull() && !rawConfig.GetAttr("target_name").IsNull() {
+		return fmt.Errorf("only one of target_prefix or target_name can be set, use target_name for a fixed target name or target_prefix to let the cluster generate it")
+	}
+	if err := checkDuplicateDiskIndexes(configuredDiskIndexes(rawConfig)); err != nil {
+		return err
+	}
+	// enabling CHAP on an existing Volume Group is only warned about by the
+	// update, its secret may have been set outside of Terraform
+	if d.Id() == "" {
+		if err := checkChapTargetSecret(rawConfig); err != nil {
+			return err
+		}
+	}
+	if d.Get("check_flash_tier").(bool) && (d.Id() == "" || d.HasChange("storage_features")) && d.NewValueKnown("cluster_reference") &&
+		flashModeEnabled(d.Get("storage_features").([]interface{})) {
+		client := meta.(*conns.Client)
+		if clusterExtID, err := client.ClusterExtIDOrDefault(d.Get("cluster_reference").(string), "cluster_reference"); err == nil {
+			if msg, err := flashTierMissingMessage(client.ClusterAPI, clusterExtID); err != nil {
+				log.Printf("[WARN] could not check the storage tiers of cluster %s : %v", clusterExtID, err)
+			} else if msg != "" {
+				log.Printf("[WARN] %s", msg)
+			}
+		}
+	}
+	if d.Get("check_name_uniqueness").(bool) && (d.Id() == "" || d.HasChange("name")) && d.NewValueKnown("name") {
+		return checkVolumeGroupNameUnique(d, meta.(*conns.Client))
+	}
+	return nil
+}
+
+// checkChapTargetSecret fails when the configuration enables CHAP, with
+// enabled_authentications or iscsi_features, without a target secret. Without
+// it the target could not be logged in to. The secret is never read back, so
+// only the configuration is checked.
+func checkChapTargetSecret(rawConfig cty.Value) error {
+	chap := stringAttrEquals(rawConfig.GetAttr("enabled_authentications"), "CHAP")
+	secret := false
+	iscsiFeatures := rawConfig.GetAttr("iscsi_features")
+	if !iscsiFeatures.IsNull() && iscsiFeatures.IsKnown() && iscsiFeatures.LengthInt() > 0 {
+		features := iscsiFeatures.AsValueSlice()[0]
+		if features.IsNull() || !features.IsKnown() {
+			return nil
+		}
+		chap = chap || stringAttrEquals(features.GetAttr("enabled_authentications"), "CHAP")
+		targetSecret := features.GetAttr("target_secret")
+		// an unknown secret is only known at apply, it is given
+		secret = !targetSecret.IsKnown() || (!targetSecret.IsNull() && targetSecret.AsString() != "")
+	}
+	if chap && !secret {
+		return fmt.Errorf("enabled_authentications is CHAP but iscsi_features.0.target_secret is not set, a CHAP protected target needs a secret")
+	}
 	return nil
 }
 
+// stringAttrEquals tells whether a configuration value is the known string s.
+func stringAttrEquals(v cty.Value, s string) bool {
+	return v.IsKnown() && !v.IsNull() && v.AsString() == s
+}
+
+// flashModeEnabled tells whether storage_features enables flash mode.
+func flashModeEnabled(storageFeatures []interface{}) bool {
+	if len(storageFeatures) == 0 || storageFeatures[0] == nil {
+		return false
+	}
+	flashMode := storageFeatures[0].(map[string]interface{})["flash_mode"].([]interface{})
+	if len(flashMode) == 0 || flashMode[0] == nil {
+		return false
+	}
+	return flashMode[0].(map[string]interface{})["is_enabled"].(bool)
+}
+
+// volumeGroupFlashTierDiags returns a warning when check_flash_tier is set and
+// flash mode is enabled on a cluster without an SSD tier. The check is best
+// effort, a failed lookup is only logged.
+func volumeGroupFlashTierDiags(d *schema.ResourceData, meta interface{}, clusterExtID string) diag.Diagnostics {
+	if !d.Get("check_flash_tier").(bool) || !flashModeEnabled(d.Get("storage_features").([]interface{})) {
+		return nil
+	}
+	msg, err := flashTierMissingMessage(meta.(*conns.Client).ClusterAPI, clusterExtID)
+	if err != nil {
+		log.Printf("[WARN] could not check the storage tiers of cluster %s : %v", clusterExtID, err)
+		return nil
+	}
+	if msg == "" {
+		return nil
+	}
+	return diag.Diagnostics{{Severity: diag.Warning, Summary: msg}}
+}
+
+// flashTierMissingMessage lists the disks of the hosts of a cluster and returns
+// a message when none of them is an SSD. A cluster reporting no disk at all is
+// not warned about, its tiers are unknown rather than HDD only.
+func flashTierMissingMessage(conn *clusters.Client, clusterExtID string) (string, error) {
+	hosts, err := utils.ListAllPages(nil, nil, func(page, limit *int) ([]interface{}, *int, error) {
+		resp, err := conn.ClusterEntityAPI.ListHostsByClusterId(utils.StringPtr(clusterExtID), page, limit, nil, nil, nil, nil)
+		if err != nil {
+			return nil, nil, err
+		}
+		var total *int
+		if resp.Metadata != nil {
+			total = resp.Metadata.TotalAvailableResults
+		}
+		if resp.Data == nil {
+			return nil, total, nil
+		}
+		hosts, _ := resp.Data.GetValue().([]clustermgmtConfig.Host)
+		items := make([]interface{}, len(hosts))
+		for k, v := range hosts {
+			items[k] = v
+		}
+		return items, total, nil
+	})
+	if err != nil {
+		return "", err
+	}
+	return hddOnlyClusterMessage(clusterExtID, hosts), nil
+}
+
+// hddOnlyClusterMessage returns the flash tier message of a cluster from the
+// hosts it lists, empty unless all of their disks are HDD.
+func hddOnlyClusterMessage(clusterExtID string, hosts []interface{}) string {
+	disks := 0
+	for _, host := range hosts {
+		for _, disk := range host.(clustermgmtConfig.Host).Disk {
+			if disk.StorageTier == nil {
+				continue
+			}
+			switch *disk.StorageTier {
+			case clustermgmtConfig.STORAGETIERREFERENCE_PCIE_SSD, clustermgmtConfig.STORAGETIERREFERENCE_SATA_SSD:
+				return ""
+			case clustermgmtConfig.STORAGETIERREFERENCE_HDD:
+				disks++
+			}
+		}
+	}
+	if disks == 0 {
+		return ""
+	}
+	return fmt.Sprintf("flash mode is enabled but cluster %s has no SSD tier, its %d disks are all HDD and flash mode has no effect", clusterExtID, disks)
+}
+
+// checkVolumeGroupNameUnique fails if a Volume Group other than this one
+// already has the planned name on the target cluster. The cluster is only
+// scoped when it is known at plan time, otherwise every cluster is checked.
+func checkVolumeGroupNameUnique(d *schema.ResourceDiff, client *conns.Client) error {
+	name := d.Get("name").(string)
+
+	filter := utils.StringPtr(fmt.Sprintf("name eq %s", utils.ODataString(name)))
+	if d.NewValueKnown("cluster_reference") {
+		if clusterExtID, err := client.ClusterExtIDOrDefault(d.Get("cluster_reference").(string), "cluster_reference"); err == nil {
+			filter = utils.AndFilter(filter, fmt.Sprintf("clusterReference eq %s", utils.ODataString(clusterExtID)))
+		}
+	}
+
+	resp, err := client.VolumeAPI.VolumeAPIInstance.ListVolumeGroups(nil, nil, filter, nil, nil, nil)
+	if err != nil {
+		return fmt.Errorf("error while checking the uniqueness of Volume Group name %s : %v", name, err)
+	}
+	if resp.Data == nil {
+		return nil
+	}
+	volumeGroups, _ := resp.Data.GetValue().([]volumesClient.VolumeGroup)
+	for _, vg := range volumeGroups {
+		if utils.StringValue(vg.ExtId) == d.Id() {
+			continue
+		}
+		return fmt.Errorf("a Volume Group named %s already exists on cluster %s (ext_id %s), set a different name or check_name_uniqueness = false",
+			name, utils.StringValue(vg.ClusterReference), utils.StringValue(vg.ExtId))
+	}
+	return nil
+}
+
+// reconcileVolumeGroupCreateTask checks the create task of a Volume Group
+// submitted with wait_for_task = false. The id is set to the Volume Group as
+// soon as the task reports it, task_ext_id is cleared once the task succeeded
+// and a failed create is handled by handleFailedVolumeGroupCreate. A refresh
+// can not taint the resource, so its error is returned as a warning: a Volume
+// Group left behind is kept in state, otherwise it is removed so that it is
+// created again. pending is true while the task runs, the Volume Group may not
+// be readable yet.
+func reconcileVolumeGroupCreateTask(ctx context.Context, d *schema.ResourceData, meta interface{}) (pending bool, diags diag.Diagnostics, err error) {
+	taskUUID := d.Get("task_ext_id").(string)
+	task, err := meta.(*conns.Client).PrismAPI.GetTask(ctx, taskUUID)
+	if err != nil {
+		return false, nil, fmt.Errorf("error while fetching the create task %s of the Volume Group : %v", taskUUID, err)
+	}
+
+	status := utils.TaskStatusName(task.Status)
+	if utils.IsTaskFailed(status) {
+		log.Printf("[WARN] create task %s of Volume Group %s is %s", taskUUID, d.Id(), status)
+		// the id is only set again for a Volume Group left behind by the task
+		d.SetId("")
+		diags = handleFailedVolumeGroupCreate(ctx, d, meta, utils.NewTaskFailedError(taskUUID, status, task))
+		for i := range diags {
+			diags[i].Severity = diag.Warning
+		}
+		d.Set("task_ext_id", "")
+		return false, diags, nil
+	}
+	if len(task.EntitiesAffected) > 0 && task.EntitiesAffected[0].ExtId != nil {
+		d.SetId(*task.EntitiesAffected[0].ExtId)
+		d.Set("ext_id", *task.EntitiesAffected[0].ExtId)
+	}
+	if status != "SUCCEEDED" {
+		log.Printf("[DEBUG] create task %s of Volume Group %s is %s", taskUUID, d.Id(), status)
+		return true, nil, nil
+	}
+	if d.Id() == taskUUID {
+		extID, err := volumeGroupExtIDFromCreateTask(meta.(*conns.Client).VolumeAPI, task, d.Get("name").(string), d.Get("cluster_reference").(string))
+		if err != nil {
+			return false, nil, err
+		}
+		d.SetId(extID)
+		d.Set("ext_id", extID)
+	}
+
+	d.Set("task_ext_id", "")
+	d.Set("last_task_ext_id", taskUUID)
+	return false, nil, nil
+}
+
+// handleFailedVolumeGroupCreate handles a create task which failed after
+// creating the Volume Group, e.g. while creating one of its disks. Only a
+// Volume Group reported by the task is considered, one looked up by name could
+// predate the apply. With rollback_on_failure it is deleted with its disks,
+// otherwise it is kept in state, tainted, and the error names what was created.
+func handleFailedVolumeGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}, taskFailed *utils.TaskFailedError) diag.Diagnostics {
+	conn := meta.(*conns.Client).VolumeAPI
+
+	volumeGroupExtID := ""
+	for _, entity := range taskFailed.EntitiesAffected {
+		// entities are reported as rel:ext_id when the task names the relation
+		extID := entity[strings.LastIndex(entity, ":")+1:]
+		if _, err := conn.VolumeAPIInstance.GetVolumeGroupById(utils.StringPtr(extID)); err == nil {
+			volumeGroupExtID = extID
+			break
+		}
+	}
+	if volumeGroupExtID == "" {
+		return diag.Errorf("error waiting for Volume Group create task (%s) : %v, no Volume Group was left behind by the task", taskFailed.TaskExtID, taskFailed)
+	}
+
+	created := []string{fmt.Sprintf("Volume Group %s", volumeGroupExtID)}
+	disks, err := listVolumeGroupDisks(conn, volumeGroupExtID)
+	if err != nil {
+		log.Printf("[WARN] could not list the disks of the partially created Volume Group %s : %v", volumeGroupExtID, err)
+	}
+	for _, disk := range disks {
+		created = append(created, fmt.Sprintf("disk %s (index %d, %d bytes)", utils.StringValue(disk.ExtId), utils.IntValue(disk.Index), utils.Int64Value(disk.DiskSizeBytes)))
+	}
+
+	if d.Get("rollback_on_failure").(bool) {
+		err := deleteVolumeGroupAndWait(ctx, meta, volumeGroupExtID, d.Timeout(schema.TimeoutCreate), utils.ExpandTaskPollRetry(d.Get("task_poll_retry")))
+		if err == nil {
+			return diag.Errorf("error waiting for Volume Group create task (%s) : %v, rolled back by deleting %s", taskFailed.TaskExtID, taskFailed, strings.Join(created, ", "))
+		}
+		log.Printf("[WARN] rolling back the partially created Volume Group %s failed : %v", volumeGroupExtID, err)
+		created[0] = fmt.Sprintf("Volume Group %s (rollback failed : %v)", volumeGroupExtID, err)
+	}
+
+	// an error returned with the id set marks the Volume Group as tainted, the
+	// next apply replaces it unless it is untainted
+	d.SetId(volumeGroupExtID)
+	d.Set("ext_id", volumeGroupExtID)
+	d.Set("last_task_ext_id", taskFailed.TaskExtID)
+	return diag.Errorf("error waiting for Volume Group create task (%s) : %v, the task created %s which are kept in state", taskFailed.TaskExtID, taskFailed, strings.Join(created, ", "))
+}
+
+// deleteVolumeGroupAndWait deletes a Volume Group, with its disks, and waits
+// for the delete task.
+func deleteVolumeGroupAndWait(ctx context.Context, meta interface{}, volumeGroupExtID string, timeout time.Duration, retry utils.TaskPollRetry) error {
+	resp, err := meta.(*conns.Client).VolumeAPI.VolumeAPIInstance.DeleteVolumeGroupById(utils.StringPtr(volumeGroupExtID))
+	if err != nil {
+		return err
+	}
+	taskUUID := resp.Data.GetValue().(volumesPrism.TaskReference).ExtId
+
+	stateConf := &resource.StateChangeConf{
+		Pending: utils.TaskPendingStates,
+		Target:  utils.TaskTargetStates,
+		Refresh: taskStateRefreshPrismTaskGroupFunc(ctx, meta.(*conns.Client).PrismAPI, utils.StringValue(taskUUID), retry),
+		Timeout: timeout,
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("error waiting for Volume Group (%s) to delete: %w", utils.StringValue(taskUUID), err)
+	}
+	return nil
+}
+
+// volumeGroupExtIDFromCreateTask returns the ext_id of the Volume Group created
+// by a succeeded task. Some tasks succeed without reporting the entities they
+// affected, the Volume Group is then looked up by its name on its cluster.
+func volumeGroupExtIDFromCreateTask(conn *volumes.Client, task taskPoll.Task, name, clusterExtID string) (string, error) {
+	for _, entity := range task.EntitiesAffected {
+		if entity.ExtId != nil && *entity.ExtId != "" {
+			return *entity.ExtId, nil
+		}
+	}
+	taskExtID := utils.StringValue(task.ExtId)
+	log.Printf("[WARN] create task %s reported no Volume Group, looking it up by name %s", taskExtID, name)
+
+	filter := utils.StringPtr(fmt.Sprintf("name eq %s", utils.ODataString(name)))
+	if clusterExtID != "" {
+		filter = utils.AndFilter(filter, fmt.Sprintf("clusterReference eq %s", utils.ODataString(clusterExtID)))
+	}
+	resp, err := conn.VolumeAPIInstance.ListVolumeGroups(nil, nil, filter, nil, nil, nil)
+	if err != nil {
+		return "", fmt.Errorf("create task %s reported no Volume Group and looking it up by name %s failed : %v", taskExtID, name, err)
+	}
+	var volumeGroups []volumesClient.VolumeGroup
+	if resp.Data != nil {
+		volumeGroups, _ = resp.Data.GetValue().([]volumesClient.VolumeGroup)
+	}
+	switch len(volumeGroups) {
+	case 1:
+		return utils.StringValue(volumeGroups[0].ExtId), nil
+	case 0:
+		return "", fmt.Errorf("create task %s succeeded without reporting the Volume Group, and no Volume Group named %s was found", taskExtID, name)
+	default:
+		return "", fmt.Errorf("create task %s succeeded without reporting the Volume Group, and %d Volume Groups are named %s, import the created one", taskExtID, len(volumeGroups), name)
+	}
+}
+
+// volumeGroupImportDisksSuffix is appended to the ext_id of a Volume Group to
+// import it together with its disks, e.g. `<ext_id>/disks`.
+const volumeGroupImportDisksSuffix = "/disks"
+
+// resourceNutanixVolumeGroupV2Import imports a Volume Group by ext_id. With the
+// `<ext_id>/disks` format the disks of the Volume Group are imported in the
+// inline disks list as well, so that a configuration declaring them inline
+// plans cleanly after the import.
+func resourceNutanixVolumeGroupV2Import(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	volumeGroupExtID := d.Id()
+	withDisks := strings.HasSuffix(volumeGroupExtID, volumeGroupImportDisksSuffix)
+	if withDisks {
+		volumeGroupExtID = strings.TrimSuffix(volumeGroupExtID, volumeGroupImportDisksSuffix)
+	}
+	if volumeGroupExtID == "" || strings.Contains(volumeGroupExtID, "/") {
+		return nil, fmt.Errorf("invalid Volume Group import id %q, expected <ext_id> or <ext_id>%s", d.Id(), volumeGroupImportDisksSuffix)
+	}
+	log.Printf("[DEBUG] Importing Volume Group %s (with disks: %t)", volumeGroupExtID, withDisks)
+
+	d.SetId(volumeGroupExtID)
+	if err := d.Set("force_detach", false); err != nil {
+		return nil, err
+	}
+	if err := d.Set("check_name_uniqueness", false); err != nil {
+		return nil, err
+	}
+	if err := d.Set("check_flash_tier", false); err != nil {
+		return nil, err
+	}
+	if err := d.Set("wait_for_task", true); err != nil {
+		return nil, err
+	}
+	if err := d.Set("rollback_on_failure", false); err != nil {
+		return nil, err
+	}
+
+	if withDisks {
+		conn := meta.(*conns.Client).VolumeAPI
+		disks, err := listVolumeGroupDisks(conn, volumeGroupExtID)
+		if err != nil {
+			return nil, fmt.Errorf("error while listing the disks of Volume Group %s : %v", volumeGroupExtID, err)
+		}
+		if err := d.Set("disks", flattenVolumeGroupInlineDisks(disks)); err != nil {
+			return nil, err
+		}
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
 func ResourceNutanixVolumeGroupV2Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn := meta.(*conns.Client).VolumeAPI
 
+	if d.Get("task_ext_id").(string) != "" {
+		pending, _, err := reconcileVolumeGroupCreateTask(ctx, d, meta)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if d.Id() == "" {
+			return nil
+		}
+		if pending {
+			return diag.Errorf("Volume Group is still being created by task %s, destroy again once it has completed", d.Get("task_ext_id").(string))
+		}
+	}
+
+	if d.Get("force_detach").(bool) {
+		if err := detachAllVolumeGroupAttachments(ctx, conn, meta.(*conns.Client).PrismAPI, d.Id(), d.Timeout(schema.TimeoutDelete), utils.ExpandTaskPollRetry(d.Get("task_poll_retry"))); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	resp, err := conn.VolumeAPIInstance.DeleteVolumeGroupById(utils.StringPtr(d.Id()))
 	if err != nil {
-		return diag.Errorf("error while Deleting Volume group : %v", err)
+		// name the attachments blocking the delete instead of only the raw API error
+		vmAttachments, iscsiAttachments, errList := listVolumeGroupAttachments(conn, d.Id())
+		if errList == nil && len(vmAttachments)+len(iscsiAttachments) > 0 {
+			return diag.Errorf("error while Deleting Volume group : it is still attached to %s, detach them or set force_detach = true : %v",
+				strings.Join(describeVolumeGroupAttachments(vmAttachments, iscsiAttachments), ", "), err)
+		}
+		return utils.WithRemediationHint(diag.Errorf("error while Deleting Volume group : %v", err), err)
 	}
 
 	TaskRef := resp.Data.GetValue().(volumesPrism.TaskReference)
@@ -433,9 +1200,9 @@ func ResourceNutanixVolumeGroupV2Delete(ctx context.Context, d *schema.ResourceD
 	taskconn := meta.(*conns.Client).PrismAPI
 	// Wait for the VM to be available
 	stateConf := &resource.StateChangeConf{
-		Pending: []string{"PENDING", "RUNNING", "QUEUED"},
-		Target:  []string{"SUCCEEDED"},
-		Refresh: taskStateRefreshPrismTaskGroupFunc(ctx, taskconn, utils.StringValue(taskUUID)),
+		Pending: utils.TaskPendingStates,
+		Target:  utils.TaskTargetStates,
+		Refresh: taskStateRefreshPrismTaskGroupFunc(ctx, taskconn, utils.StringValue(taskUUID), utils.ExpandTaskPollRetry(d.Get("task_poll_retry"))),
 		Timeout: d.Timeout(schema.TimeoutCreate),
 	}
 
@@ -464,17 +1231,193 @@ func expandIscsiFeatures(iscsiFeaturesList interface{}) *volumesClient.IscsiFeat
 				"CHAP": two,
 				"NONE": three,
 			}
-			pVal := enabledAuthenticationsMap[enabledAuthentications.(string)]
-			p := volumesClient.AuthenticationType(pVal.(int))
-			iscsiFeature.EnabledAuthentications = &p
+			// left to the backend when only the target secret is set
+			if pVal := enabledAuthenticationsMap[enabledAuthentications.(string)]; pVal != nil {
+				p := volumesClient.AuthenticationType(pVal.(int))
+				iscsiFeature.EnabledAuthentications = &p
+			}
 		}
-		log.Printf("[INFO_VG] iscsiFeature.EnabledAuthentications: %v", *iscsiFeature.EnabledAuthentications)
-		log.Printf("[INFO_VG] iscsiFeature.TargetSecret: %v", *iscsiFeature.TargetSecret)
 		return iscsiFeature
 	}
 	return nil
 }
 
+// checkSharingStatusTransition fails fast when the sharing status change can not
+// be applied to the Volume Group, instead of submitting a task that will fail.
+// A Volume Group can not go from SHARED to NOT_SHARED while it has more than one
+// attachment.
+func checkSharingStatusTransition(conn *volumes.Client, volumeGroupExtID, oldSharingStatus, newSharingStatus string) error {
+	if oldSharingStatus != "SHARED" || newSharingStatus != "NOT_SHARED" {
+		return nil
+	}
+
+	attachments, err := countVolumeGroupAttachments(conn, volumeGroupExtID)
+	if err != nil {
+		return err
+	}
+	if attachments > 1 {
+		return fmt.Errorf("sharing_status of Volume Group %s can not be changed from SHARED to NOT_SHARED, "+
+			"it has %d attachments and a NOT_SHARED Volume Group can have at most one attachment. Detach the extra VMs or iSCSI clients first",
+			volumeGroupExtID, attachments)
+	}
+	return nil
+}
+
+// countVolumeGroupAttachments returns the number of VM and external iSCSI
+// attachments of a Volume Group.
+func countVolumeGroupAttachments(conn *volumes.Client, volumeGroupExtID string) (int, error) {
+	vmAttachments, iscsiAttachments, err := listVolumeGroupAttachments(conn, volumeGroupExtID)
+	if err != nil {
+		return 0, err
+	}
+	return len(vmAttachments) + len(iscsiAttachments), nil
+}
+
+// listVolumeGroupAttachments returns every VM and iSCSI client attached to the
+// Volume Group.
+func listVolumeGroupAttachments(conn *volumes.Client, volumeGroupExtID string) ([]volumesClient.VmAttachment, []volumesClient.IscsiClientAttachment, error) {
+	vms, err := utils.ListAllPages(nil, nil, func(page, limit *int) ([]interface{}, *int, error) {
+		resp, err := conn.VolumeAPIInstance.ListVmAttachmentsByVolumeGroupId(utils.StringPtr(volumeGroupExtID), page, limit, nil, nil)
+		if err != nil {
+			return nil, nil, err
+		}
+		var total *int
+		if resp.Metadata != nil {
+			total = resp.Metadata.TotalAvailableResults
+		}
+		if resp.Data == nil {
+			return nil, total, nil
+		}
+		attachments, _ := resp.Data.GetValue().([]volumesClient.VmAttachment)
+		items := make([]interface{}, len(attachments))
+		for k, v := range attachments {
+			items[k] = v
+		}
+		return items, total, nil
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("error while fetching VM attachments of Volume Group %s : %v", volumeGroupExtID, err)
+	}
+
+	iscsiClients, err := utils.ListAllPages(nil, nil, func(page, limit *int) ([]interface{}, *int, error) {
+		resp, err := conn.VolumeAPIInstance.ListExternalIscsiAttachmentsByVolumeGroupId(utils.StringPtr(volumeGroupExtID), page, limit, nil, nil, nil, nil)
+		if err != nil {
+			return nil, nil, err
+		}
+		var total *int
+		if resp.Metadata != nil {
+			total = resp.Metadata.TotalAvailableResults
+		}
+		if resp.Data == nil {
+			return nil, total, nil
+		}
+		attachments, _ := resp.Data.GetValue().([]volumesClient.IscsiClientAttachment)
+		items := make([]interface{}, len(attachments))
+		for k, v := range attachments {
+			items[k] = v
+		}
+		return items, total, nil
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("error while fetching iSCSI client attachments of Volume Group %s : %v", volumeGroupExtID, err)
+	}
+
+	vmAttachments := make([]volumesClient.VmAttachment, len(vms))
+	for k, v := range vms {
+		vmAttachments[k] = v.(volumesClient.VmAttachment)
+	}
+	iscsiAttachments := make([]volumesClient.IscsiClientAttachment, len(iscsiClients))
+	for k, v := range iscsiClients {
+		iscsiAttachments[k] = v.(volumesClient.IscsiClientAttachment)
+	}
+	return vmAttachments, iscsiAttachments, nil
+}
+
+func describeVolumeGroupAttachments(vmAttachments []volumesClient.VmAttachment, iscsiAttachments []volumesClient.IscsiClientAttachment) []string {
+	attachments := make([]string, 0, len(vmAttachments)+len(iscsiAttachments))
+	for _, v := range vmAttachments {
+		attachments = append(attachments, fmt.Sprintf("vm %s", utils.StringValue(v.ExtId)))
+	}
+	for _, v := range iscsiAttachments {
+		attachments = append(attachments, fmt.Sprintf("iscsi client %s", utils.StringValue(v.ExtId)))
+	}
+	return attachments
+}
+
+// detachAllVolumeGroupAttachments detaches every VM and iSCSI client from the
+// Volume Group, waiting for each detach task to complete.
+func detachAllVolumeGroupAttachments(ctx context.Context, conn *volumes.Client, taskconn *prism.Client, volumeGroupExtID string, timeout time.Duration, retry utils.TaskPollRetry) error {
+	vmAttachments, iscsiAttachments, err := listVolumeGroupAttachments(conn, volumeGroupExtID)
+	if err != nil {
+		return err
+	}
+
+	waitForDetach := func(taskUUID *string, attachment string) error {
+		stateConf := &resource.StateChangeConf{
+			Pending: utils.TaskPendingStates,
+			Target:  utils.TaskTargetStates,
+			Refresh: taskStateRefreshPrismTaskGroupFunc(ctx, taskconn, utils.StringValue(taskUUID), retry),
+			Timeout: timeout,
+		}
+		if _, errWaitTask := stateConf.WaitForStateContext(ctx); errWaitTask != nil {
+			return fmt.Errorf("error waiting for task (%s) to detach %s from Volume Group %s: %s", utils.StringValue(taskUUID), attachment, volumeGroupExtID, errWaitTask)
+		}
+		return nil
+	}
+
+	for _, v := range vmAttachments {
+		body := volumesClient.VmAttachment{ExtId: v.ExtId, Index: v.Index}
+		resp, err := conn.VolumeAPIInstance.DetachVm(utils.StringPtr(volumeGroupExtID), &body)
+		if err != nil {
+			return fmt.Errorf("error while detaching vm %s from Volume Group %s : %v", utils.StringValue(v.ExtId), volumeGroupExtID, err)
+		}
+		taskRef := resp.Data.GetValue().(volumesPrism.TaskReference)
+		if err := waitForDetach(taskRef.ExtId, "vm "+utils.StringValue(v.ExtId)); err != nil {
+			return err
+		}
+	}
+	for _, v := range iscsiAttachments {
+		body := volumesClient.IscsiClientAttachment{ExtId: v.ExtId}
+		resp, err := conn.VolumeAPIInstance.DetachIscsiClient(utils.StringPtr(volumeGroupExtID), &body)
+		if err != nil {
+			return fmt.Errorf("error while detaching iscsi client %s from Volume Group %s : %v", utils.StringValue(v.ExtId), volumeGroupExtID, err)
+		}
+		taskRef := resp.Data.GetValue().(volumesPrism.TaskReference)
+		if err := waitForDetach(taskRef.ExtId, "iscsi client "+utils.StringValue(v.ExtId)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// reconcileEnabledAuthentications returns the authentication type of the Volume
+// Group, preferring the top level value and falling back to the one reported
+// in iscsi_features. A Volume Group without any authentication type reported
+// is unauthenticated, which is mapped to NONE.
+func reconcileEnabledAuthentications(enabledAuthentications *volumesClient.AuthenticationType, iscsiFeatures *volumesClient.IscsiFeatures) string {
+	authType := flattenEnabledAuthentications(enabledAuthentications)
+	if authType == "" && iscsiFeatures != nil {
+		authType = flattenEnabledAuthentications(iscsiFeatures.EnabledAuthentications)
+	}
+	if authType == "" {
+		return "NONE"
+	}
+	return authType
+}
+
+// flattenIscsiFeaturesPreservingSecret flattens iscsi_features using the
+// reconciled authentication type. The target secret can not be read back from
+// the API, so the value known to terraform is kept as is.
+func flattenIscsiFeaturesPreservingSecret(d *schema.ResourceData, enabledAuthentications string) []map[string]interface{} {
+	targetSecret := d.Get("iscsi_features.0.target_secret").(string)
+	return []map[string]interface{}{
+		{
+			"enabled_authentications": enabledAuthentications,
+			"target_secret":           targetSecret,
+		},
+	}
+}
+
 func expandStorageFeatures(storageFeaturesList []interface{}) *volumesClient.StorageFeatures {
 	if len(storageFeaturesList) > 0 {
 		storageFeature := volumesClient.StorageFeatures{}
@@ -536,43 +1479,281 @@ func expandDisks(disks []interface{}) []volumesClient.VolumeDisk {
 	return disksList
 }
 
-func taskStateRefreshPrismTaskGroupFunc(ctx context.Context, client *prism.Client, taskUUID string) resource.StateRefreshFunc {
+// flattenVolumeGroupInlineDisks flattens disks into the inline disks list of the
+// Volume Group, ordered by index. Disks created in a storage container do not
+// report a data source, the container is used as their reference as it is in
+// the configuration creating them.
+func flattenVolumeGroupInlineDisks(disks []volumesClient.VolumeDisk) []map[string]interface{} {
+	sorted := make([]volumesClient.VolumeDisk, len(disks))
+	copy(sorted, disks)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return utils.IntValue(sorted[i].Index) < utils.IntValue(sorted[j].Index)
+	})
+
+	disksList := make([]map[string]interface{}, len(sorted))
+	for k, v := range sorted {
+		disk := map[string]interface{}{
+			"index":                 utils.IntValue(v.Index),
+			"disk_size_bytes":       utils.Int64Value(v.DiskSizeBytes),
+			"description":           utils.StringValue(v.Description),
+			"disk_storage_features": flattenDiskStorageFeatures(v.DiskStorageFeatures),
+		}
+
+		reference := map[string]interface{}{}
+		switch {
+		case v.DiskDataSourceReference != nil:
+			reference["ext_id"] = utils.StringValue(v.DiskDataSourceReference.ExtId)
+			reference["name"] = utils.StringValue(v.DiskDataSourceReference.Name)
+			reference["uris"] = v.DiskDataSourceReference.Uris
+			if v.DiskDataSourceReference.EntityType != nil {
+				reference["entity_type"] = v.DiskDataSourceReference.EntityType.GetName()
+			}
+		case v.StorageContainerId != nil:
+			reference["ext_id"] = utils.StringValue(v.StorageContainerId)
+			reference["entity_type"] = "STORAGE_CONTAINER"
+		}
+		if len(reference) > 0 {
+			disk["disk_data_source_reference"] = []map[string]interface{}{reference}
+		}
+		disksList[k] = disk
+	}
+	return disksList
+}
+
+// cloneVolumeGroupDisks returns disks cloning every disk of the source Volume
+// Group, for a Volume Group created on clusterExtID. A disk can only be cloned
+// from a disk of the same cluster.
+func cloneVolumeGroupDisks(conn *volumes.Client, sourceExtID, clusterExtID string) ([]volumesClient.VolumeDisk, error) {
+	resp, err := conn.VolumeAPIInstance.GetVolumeGroupById(utils.StringPtr(sourceExtID))
+	if err != nil {
+		if utils.IsV4NotFound(err) {
+			return nil, fmt.Errorf("source Volume Group %s not found", sourceExtID)
+		}
+		return nil, fmt.Errorf("error while fetching source Volume Group %s : %v", sourceExtID, err)
+	}
+	source := resp.Data.GetValue().(volumesClient.VolumeGroup)
+	if sourceCluster := utils.StringValue(source.ClusterReference); sourceCluster != clusterExtID {
+		return nil, fmt.Errorf("source Volume Group %s is on cluster %s, its disks can not be cloned into a Volume Group on cluster %s", sourceExtID, sourceCluster, clusterExtID)
+	}
+
+	sourceDisks, err := listVolumeGroupDisks(conn, sourceExtID)
+	if err != nil {
+		return nil, fmt.Errorf("error while listing the disks of source Volume Group %s : %v", sourceExtID, err)
+	}
+	if len(sourceDisks) == 0 {
+		return nil, fmt.Errorf("source Volume Group %s has no disks to clone", sourceExtID)
+	}
+
+	disks := make([]volumesClient.VolumeDisk, len(sourceDisks))
+	for k, v := range sourceDisks {
+		disks[k] = volumesClient.VolumeDisk{
+			Index:         v.Index,
+			DiskSizeBytes: v.DiskSizeBytes,
+			Description:   v.Description,
+			DiskDataSourceReference: &config.EntityReference{
+				ExtId:      v.ExtId,
+				EntityType: config.ENTITYTYPE_VOLUME_DISK.Ref(),
+			},
+			DiskStorageFeatures: v.DiskStorageFeatures,
+		}
+	}
+	return disks, nil
+}
+
+// configuredDiskIndexes returns the index of every disk of the raw
+// configuration, nil where it is not set or not known yet.
+func configuredDiskIndexes(rawConfig cty.Value) []*int {
+	if rawConfig.IsNull() || !rawConfig.IsKnown() {
+		return nil
+	}
+	disks := rawConfig.GetAttr("disks")
+	if disks.IsNull() || !disks.IsKnown() {
+		return nil
+	}
+	indexes := make([]*int, 0, disks.LengthInt())
+	for _, disk := range disks.AsValueSlice() {
+		var index *int
+		if !disk.IsNull() && disk.IsKnown() {
+			if v := disk.GetAttr("index"); !v.IsNull() && v.IsKnown() {
+				i, _ := v.AsBigFloat().Int64()
+				index = utils.IntPtr(int(i))
+			}
+		}
+		indexes = append(indexes, index)
+	}
+	return indexes
+}
+
+// checkDuplicateDiskIndexes fails if two disks are given the same index, the
+// backend would otherwise reject the request only once the task runs.
+func checkDuplicateDiskIndexes(indexes []*int) error {
+	seen := make(map[int]bool, len(indexes))
+	for _, index := range indexes {
+		if index == nil {
+			continue
+		}
+		if seen[*index] {
+			return fmt.Errorf("disk index %d is set on more than one disk of the Volume Group, disk indexes must be unique", *index)
+		}
+		seen[*index] = true
+	}
+	return nil
+}
+
+// taskStateRefreshPrismTaskGroupFunc polls the task taskUUID, retrying the
+// polls failing with a transient error as configured by retry.
+func taskStateRefreshPrismTaskGroupFunc(ctx context.Context, client *prism.Client, taskUUID string, retry utils.TaskPollRetry) resource.StateRefreshFunc {
+	poll := &utils.TaskStatusPoll{}
 	return func() (interface{}, string, error) {
-		vresp, err := client.TaskRefAPI.GetTaskById(utils.StringPtr(taskUUID), nil)
+		// batched with the other running tasks when task_poll_batch_interval is set
+		var v taskPoll.Task
+		err := retry.Do(ctx, func() error {
+			var err error
+			v, err = client.GetTask(ctx, taskUUID)
+			return err
+		})
 		if err != nil {
-			return "", "", (fmt.Errorf("error while polling prism task: %v", err))
+			return "", "", &utils.TaskPollError{TaskExtID: taskUUID, Err: err}
 		}
 
-		// get the group results
-
-		v := vresp.Data.GetValue().(taskPoll.Task)
+		status, err := poll.Status(taskUUID, v.Status)
+		utils.LogTaskPoll(client.LogFormat, v, status)
+		if err != nil {
+			return v, status, err
+		}
 
-		if getTaskStatus(v.Status) == "CANCELED" || getTaskStatus(v.Status) == "FAILED" {
-			return v, getTaskStatus(v.Status),
-				fmt.Errorf("error_detail: %s, progress_message: %d", utils.StringValue(v.ErrorMessages[0].Message), utils.IntValue(v.ProgressPercentage))
+		if utils.IsTaskFailed(status) {
+			return v, status, utils.NewTaskFailedError(taskUUID, status, v)
 		}
-		return v, getTaskStatus(v.Status), nil
+		return v, status, nil
 	}
 }
 
 func getTaskStatus(taskStatus *taskPoll.TaskStatus) string {
-	if taskStatus != nil {
-		const two, three, five, six, seven = 2, 3, 5, 6, 7
-		if *taskStatus == taskPoll.TaskStatus(six) {
-			return "FAILED"
+	return utils.TaskStatusName(taskStatus)
+}
+
+// iSCSI targets listen on the default iSCSI port of the data services IP
+const volumeGroupTargetPort = 3260
+
+// listVolumeGroupCategories returns the ext_ids of the categories associated
+// with a Volume Group.
+func listVolumeGroupCategories(conn *volumes.Client, volumeGroupExtID string) ([]string, error) {
+	items, err := utils.ListAllPages(nil, nil, func(page, limit *int) ([]interface{}, *int, error) {
+		resp, err := conn.VolumeAPIInstance.ListCategoryAssociationsByVolumeGroupId(utils.StringPtr(volumeGroupExtID), page, limit)
+		if err != nil {
+			return nil, nil, err
 		}
-		if *taskStatus == taskPoll.TaskStatus(seven) {
-			return "CANCELED"
+		var total *int
+		if resp.Metadata != nil {
+			total = resp.Metadata.TotalAvailableResults
 		}
-		if *taskStatus == taskPoll.TaskStatus(two) {
-			return "QUEUED"
+		if resp.Data == nil {
+			return nil, total, nil
 		}
-		if *taskStatus == taskPoll.TaskStatus(three) {
-			return "RUNNING"
+		categories, _ := resp.Data.GetValue().([]volumesClient.CategoryDetails)
+		items := make([]interface{}, len(categories))
+		for k, v := range categories {
+			items[k] = v
 		}
-		if *taskStatus == taskPoll.TaskStatus(five) {
-			return "SUCCEEDED"
+		return items, total, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error while fetching the categories of Volume Group %s : %v", volumeGroupExtID, err)
+	}
+
+	categories := make([]string, 0, len(items))
+	for _, item := range items {
+		categories = append(categories, utils.StringValue(item.(volumesClient.CategoryDetails).ExtId))
+	}
+	return categories, nil
+}
+
+// expandCategoryExtIDs returns the category ext_ids of a set, sorted so that
+// the calls and errors do not depend on the set order.
+func expandCategoryExtIDs(categories *schema.Set) []string {
+	extIDs := make([]string, 0, categories.Len())
+	for _, v := range categories.List() {
+		extIDs = append(extIDs, v.(string))
+	}
+	sort.Strings(extIDs)
+	return extIDs
+}
+
+// updateVolumeGroupCategories associates the categories with a Volume Group,
+// or disassociates them when associate is false, and waits for the task.
+func updateVolumeGroupCategories(ctx context.Context, meta interface{}, volumeGroupExtID string, categoryExtIDs []string, associate bool, timeout time.Duration, retry utils.TaskPollRetry) error {
+	conn := meta.(*conns.Client).VolumeAPI
+
+	body := volumesClient.NewCategoryEntityReferences()
+	for _, extID := range categoryExtIDs {
+		reference := config.NewEntityReference()
+		reference.ExtId = utils.StringPtr(extID)
+		reference.EntityType = config.ENTITYTYPE_CATEGORY.Ref()
+		body.Categories = append(body.Categories, *reference)
+	}
+
+	action := "associate"
+	var taskRef volumesPrism.TaskReference
+	if associate {
+		resp, err := conn.VolumeAPIInstance.AssociateCategory(utils.StringPtr(volumeGroupExtID), body)
+		if err != nil {
+			return fmt.Errorf("error while associating categories %s with Volume Group %s : %v", strings.Join(categoryExtIDs, ", "), volumeGroupExtID, err)
+		}
+		taskRef = resp.Data.GetValue().(volumesPrism.TaskReference)
+	} else {
+		action = "disassociate"
+		resp, err := conn.VolumeAPIInstance.DisassociateCategory(utils.StringPtr(volumeGroupExtID), body)
+		if err != nil {
+			return fmt.Errorf("error while disassociating categories %s from Volume Group %s : %v", strings.Join(categoryExtIDs, ", "), volumeGroupExtID, err)
 		}
+		taskRef = resp.Data.GetValue().(volumesPrism.TaskReference)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: utils.TaskPendingStates,
+		Target:  utils.TaskTargetStates,
+		Refresh: taskStateRefreshPrismTaskGroupFunc(ctx, meta.(*conns.Client).PrismAPI, utils.StringValue(taskRef.ExtId), retry),
+		Timeout: timeout,
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("error waiting for categories of Volume Group %s to %s (%s): %w", volumeGroupExtID, action, utils.StringValue(taskRef.ExtId), err)
+	}
+	return nil
+}
+
+// volumeGroupTargetPortals returns the iSCSI portals of the Volume Groups of a
+// cluster, one per address family of its external data services IP.
+func volumeGroupTargetPortals(conn *clusters.Client, clusterExtID string) ([]interface{}, error) {
+	portals := make([]interface{}, 0)
+	if clusterExtID == "" {
+		return portals, nil
+	}
+
+	resp, err := conn.ClusterEntityAPI.GetClusterById(utils.StringPtr(clusterExtID), nil)
+	if err != nil {
+		return nil, fmt.Errorf("error while fetching cluster %s : %v", clusterExtID, err)
+	}
+	cluster, ok := resp.Data.GetValue().(clustermgmtConfig.Cluster)
+	if !ok || cluster.Network == nil || cluster.Network.ExternalDataServiceIp == nil {
+		return portals, nil
+	}
+
+	dataServicesIP := cluster.Network.ExternalDataServiceIp
+	addresses := make([][2]string, 0, 2)
+	if dataServicesIP.Ipv4 != nil && utils.StringValue(dataServicesIP.Ipv4.Value) != "" {
+		addresses = append(addresses, [2]string{"IPV4", *dataServicesIP.Ipv4.Value})
+	}
+	if dataServicesIP.Ipv6 != nil && utils.StringValue(dataServicesIP.Ipv6.Value) != "" {
+		addresses = append(addresses, [2]string{"IPV6", *dataServicesIP.Ipv6.Value})
+	}
+	for _, address := range addresses {
+		portals = append(portals, map[string]interface{}{
+			"address": address[1],
+			"port":    volumeGroupTargetPort,
+			"family":  address[0],
+			"portal":  net.JoinHostPort(address[1], strconv.Itoa(volumeGroupTargetPort)),
+		})
 	}
-	return "UNKNOWN"
+	return portals, nil
 }
@@ -2,7 +2,9 @@ package volumesv2_test
 
 import (
 	"fmt"
+	"os"
 	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -28,6 +30,7 @@ func TestAccV2NutanixVolumeGroupResource_Basic(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr(resourceNameVolumeGroup, "name", name),
 					resource.TestCheckResourceAttr(resourceNameVolumeGroup, "description", desc),
+					resource.TestCheckResourceAttrSet(resourceNameVolumeGroup, "target_name"),
 					resource.TestCheckResourceAttr(resourceNameVolumeGroup, "should_load_balance_vm_attachments", "false"),
 					resource.TestCheckResourceAttr(resourceNameVolumeGroup, "sharing_status", "SHARED"),
 					resource.TestCheckResourceAttr(resourceNameVolumeGroup, "created_by", "admin"),
@@ -35,6 +38,356 @@ func TestAccV2NutanixVolumeGroupResource_Basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceNameVolumeGroup, "storage_features.0.flash_mode.0.is_enabled", "true"),
 					resource.TestCheckResourceAttr(resourceNameVolumeGroup, "is_hidden", "false"),
 					resource.TestCheckResourceAttr(resourceNameVolumeGroup, "usage_type", "USER"),
+					resource.TestCheckResourceAttrSet(resourceNameVolumeGroup, "last_task_ext_id"),
+					resource.TestCheckResourceAttr(resourceNameVolumeGroup, "target_portals.0.port", "3260"),
+					resource.TestMatchResourceAttr(resourceNameVolumeGroup, "target_portals.0.family", regexp.MustCompile("^IPV(4|6)$")),
+					resource.TestCheckResourceAttrSet(resourceNameVolumeGroup, "target_portals.0.portal"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccV2NutanixVolumeGroupResource_Disappears(t *testing.T) {
+	r := acctest.RandInt()
+	name := fmt.Sprintf("tf-test-volume-group-%d", r)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckNutanixVolumeGroupV2Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVolumeGroupV2RequiredAttributes(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceNameVolumeGroup, "name", name),
+					testAccCheckNutanixVolumeGroupV2Disappears(resourceNameVolumeGroup),
+				),
+				// the volume group deleted out of band is planned for recreation
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccV2NutanixVolumeGroupResource_ChapNoDrift(t *testing.T) {
+	r := acctest.RandInt()
+	name := fmt.Sprintf("tf-test-volume-group-%d", r)
+	desc := "test volume group chap drift description"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckNutanixVolumeGroupV2Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVolumeGroupResourceChapConfig(name, desc),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceNameVolumeGroup, "enabled_authentications", "CHAP"),
+					resource.TestCheckResourceAttr(resourceNameVolumeGroup, "iscsi_features.0.enabled_authentications", "CHAP"),
+					resource.TestCheckResourceAttr(resourceNameVolumeGroup, "iscsi_features.0.target_secret", "1234567891011"),
+				),
+			},
+			// re-planning the same config must not report any diff on iscsi_features
+			{
+				Config:   testAccVolumeGroupResourceChapConfig(name, desc),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccV2NutanixVolumeGroupResource_ChapEndToEnd(t *testing.T) {
+	r := acctest.RandInt()
+	name := fmt.Sprintf("tf-test-volume-group-%d", r)
+	chapWithoutSecret := regexp.MustCompile("enabled_authentications is CHAP but iscsi_features.0.target_secret is not set")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckNutanixVolumeGroupV2Destroy,
+		Steps: []resource.TestStep{
+			// creating with CHAP but no secret is rejected at plan time, whichever argument enables it
+			{
+				Config:      testAccVolumeGroupResourceChapEndToEndConfig(name, `enabled_authentications = "CHAP"`),
+				ExpectError: chapWithoutSecret,
+			},
+			{
+				Config: testAccVolumeGroupResourceChapEndToEndConfig(name, `
+		iscsi_features {
+			enabled_authentications = "CHAP"
+		}`),
+				ExpectError: chapWithoutSecret,
+			},
+			{
+				Config: testAccVolumeGroupResourceChapEndToEndConfig(name, `
+		iscsi_features {
+			target_secret           = "chap-secret-123"
+			enabled_authentications = "CHAP"
+		}`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceNameVolumeGroup, "enabled_authentications", "CHAP"),
+					resource.TestCheckResourceAttr(resourceNameVolumeGroup, "iscsi_features.0.enabled_authentications", "CHAP"),
+					resource.TestCheckResourceAttr(resourceNameVolumeGroup, "iscsi_features.0.target_secret", "chap-secret-123"),
+					resource.TestCheckResourceAttrSet(resourceNameVolumeGroup, "target_name"),
+					testAccCheckVolumeGroupAuthentication(resourceNameVolumeGroup, "CHAP"),
+				),
+			},
+			{
+				Config: testAccVolumeGroupResourceChapEndToEndConfig(name, `
+		iscsi_features {
+			target_secret           = "chap-secret-123"
+			enabled_authentications = "CHAP"
+		}`),
+				PlanOnly: true,
+			},
+			// turning CHAP off needs no secret
+			{
+				Config: testAccVolumeGroupResourceChapEndToEndConfig(name, `
+		iscsi_features {
+			enabled_authentications = "NONE"
+		}`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceNameVolumeGroup, "iscsi_features.0.enabled_authentications", "NONE"),
+					testAccCheckVolumeGroupAuthentication(resourceNameVolumeGroup, "NONE"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccV2NutanixVolumeGroupResource_SharedToNotSharedWithAttachments(t *testing.T) {
+	r := acctest.RandInt()
+	name := fmt.Sprintf("tf-test-volume-group-%d", r)
+	desc := "test volume group sharing status description"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckNutanixVolumeGroupV2Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVolumeGroupResourceWithTwoIscsiClientsConfig(name, desc, "SHARED"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceNameVolumeGroup, "sharing_status", "SHARED"),
+				),
+			},
+			{
+				Config:      testAccVolumeGroupResourceWithTwoIscsiClientsConfig(name, desc, "NOT_SHARED"),
+				ExpectError: regexp.MustCompile("can not be changed from SHARED to NOT_SHARED, it has 2 attachments"),
+			},
+		},
+	})
+}
+
+func TestAccV2NutanixVolumeGroupResource_Update(t *testing.T) {
+	r := acctest.RandInt()
+	name := fmt.Sprintf("tf-test-volume-group-%d", r)
+	desc := "test volume group description"
+	updatedDesc := "test volume group description updated"
+	datasourceNameVolumeGroup := "data.nutanix_volume_group_v2.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckNutanixVolumeGroupV2Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVolumeGroupResourceUpdateConfig(name, desc, "NOT_SHARED"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceNameVolumeGroup, "description", desc),
+					resource.TestCheckResourceAttr(resourceNameVolumeGroup, "sharing_status", "NOT_SHARED"),
+					resource.TestCheckResourceAttr(datasourceNameVolumeGroup, "description", desc),
+					resource.TestCheckResourceAttr(datasourceNameVolumeGroup, "sharing_status", "NOT_SHARED"),
+				),
+			},
+			// the data source reads the volume group back from the cluster, so the
+			// new values are checked there and not only in state
+			{
+				Config: testAccVolumeGroupResourceUpdateConfig(name, updatedDesc, "SHARED"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceNameVolumeGroup, "description", updatedDesc),
+					resource.TestCheckResourceAttr(resourceNameVolumeGroup, "sharing_status", "SHARED"),
+					resource.TestCheckResourceAttr(datasourceNameVolumeGroup, "description", updatedDesc),
+					resource.TestCheckResourceAttr(datasourceNameVolumeGroup, "sharing_status", "SHARED"),
+					resource.TestCheckResourceAttrPair(datasourceNameVolumeGroup, "ext_id", resourceNameVolumeGroup, "id"),
+				),
+			},
+			{
+				Config: testAccVolumeGroupResourceWithTwoIscsiClientsConfig(name, updatedDesc, "SHARED"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceNameVolumeGroup, "sharing_status", "SHARED"),
+				),
+			},
+			{
+				Config:      testAccVolumeGroupResourceWithTwoIscsiClientsConfig(name, updatedDesc, "NOT_SHARED"),
+				ExpectError: regexp.MustCompile("can not be changed from SHARED to NOT_SHARED, it has 2 attachments"),
+			},
+		},
+	})
+}
+
+// hiding a Volume Group updates it in place, the disk and data source
+// referencing its ext_id keep working
+func TestAccV2NutanixVolumeGroupResource_IsHidden(t *testing.T) {
+	r := acctest.RandInt()
+	name := fmt.Sprintf("tf-test-volume-group-%d", r)
+	desc := "test volume group is_hidden"
+	datasourceNameVolumeGroup := "data.nutanix_volume_group_v2.test"
+	var volumeGroupExtID string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckNutanixVolumeGroupV2Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVolumeGroupResourceIsHiddenConfig(name, desc, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceNameVolumeGroup, "is_hidden", "false"),
+					testAccCheckVolumeGroupExtID(resourceNameVolumeGroup, &volumeGroupExtID, false),
+				),
+			},
+			{
+				Config: testAccVolumeGroupResourceIsHiddenConfig(name, desc, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceNameVolumeGroup, "is_hidden", "true"),
+					resource.TestCheckResourceAttr(datasourceNameVolumeGroup, "is_hidden", "true"),
+					testAccCheckVolumeGroupExtID(resourceNameVolumeGroup, &volumeGroupExtID, true),
+					resource.TestCheckResourceAttrPair(resourceVolumeGroupDisk, "volume_group_ext_id", resourceNameVolumeGroup, "id"),
+					resource.TestCheckResourceAttrPair(datasourceNameVolumeGroup, "ext_id", resourceNameVolumeGroup, "id"),
+				),
+			},
+			{
+				Config: testAccVolumeGroupResourceIsHiddenConfig(name, desc, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceNameVolumeGroup, "is_hidden", "false"),
+					resource.TestCheckResourceAttr(datasourceNameVolumeGroup, "is_hidden", "false"),
+					testAccCheckVolumeGroupExtID(resourceNameVolumeGroup, &volumeGroupExtID, true),
+				),
+			},
+		},
+	})
+}
+
+func TestAccV2NutanixVolumeGroupResource_TargetPrefixAndTargetName(t *testing.T) {
+	r := acctest.RandInt()
+	name := fmt.Sprintf("tf-test-volume-group-%d", r)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccVolumeGroupResourceTargetPrefixAndNameConfig(name),
+				ExpectError: regexp.MustCompile("only one of target_prefix or target_name can be set"),
+			},
+		},
+	})
+}
+
+func TestAccV2NutanixVolumeGroupResource_DuplicateDiskIndex(t *testing.T) {
+	r := acctest.RandInt()
+	name := fmt.Sprintf("tf-test-volume-group-%d", r)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccVolumeGroupResourceDuplicateDiskIndexConfig(name),
+				ExpectError: regexp.MustCompile("disk index 2 is set on more than one disk"),
+			},
+		},
+	})
+}
+
+func TestAccV2NutanixVolumeGroupResource_CheckNameUniqueness(t *testing.T) {
+	r := acctest.RandInt()
+	name := fmt.Sprintf("tf-test-volume-group-%d", r)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVolumeGroupV2CheckNameUniquenessConfig(name, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceNameVolumeGroup, "name", name),
+					resource.TestCheckResourceAttr(resourceNameVolumeGroup, "check_name_uniqueness", "true"),
+				),
+			},
+			{
+				Config:      testAccVolumeGroupV2CheckNameUniquenessConfig(name, true),
+				ExpectError: regexp.MustCompile(fmt.Sprintf("a Volume Group named %s already exists", name)),
+			},
+		},
+	})
+}
+
+func TestAccV2NutanixVolumeGroupResource_RollbackOnFailure(t *testing.T) {
+	r := acctest.RandInt()
+	name := fmt.Sprintf("tf-test-volume-group-%d", r)
+
+	// the second disk clones a VM disk which does not exist, failing the create
+	// task after the Volume Group and its first disk are created
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckNutanixVolumeGroupV2Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccVolumeGroupV2RollbackOnFailureConfig(name),
+				ExpectError: regexp.MustCompile("rolled back by deleting Volume Group|no Volume Group was left behind"),
+			},
+			{
+				Config: testAccVolumeGroupV2RollbackOnFailureCheckConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.nutanix_volume_groups_v2.test", "volumes.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccV2NutanixVolumeGroupResource_Categories(t *testing.T) {
+	r := acctest.RandInt()
+	name := fmt.Sprintf("tf-test-volume-group-%d", r)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckNutanixVolumeGroupV2Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVolumeGroupV2CategoriesConfig(name, r, "nutanix_category_v2.first.id", "nutanix_category_v2.second.id"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceNameVolumeGroup, "categories.#", "2"),
+					resource.TestCheckTypeSetElemAttrPair(resourceNameVolumeGroup, "categories.*", "nutanix_category_v2.first", "id"),
+					resource.TestCheckTypeSetElemAttrPair(resourceNameVolumeGroup, "categories.*", "nutanix_category_v2.second", "id"),
+					testAccCheckVolumeGroupCategories(resourceNameVolumeGroup, "nutanix_category_v2.first", "nutanix_category_v2.second"),
+				),
+			},
+			// removing a category from the set disassociates it
+			{
+				Config: testAccVolumeGroupV2CategoriesConfig(name, r, "nutanix_category_v2.first.id"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceNameVolumeGroup, "categories.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceNameVolumeGroup, "categories.*", "nutanix_category_v2.first", "id"),
+					testAccCheckVolumeGroupCategories(resourceNameVolumeGroup, "nutanix_category_v2.first"),
+				),
+			},
+			// omitting the argument keeps the associated categories
+			{
+				Config:   testAccVolumeGroupV2CategoriesOmittedConfig(name, r),
+				PlanOnly: true,
+			},
+			// an empty set disassociates the last category
+			{
+				Config: testAccVolumeGroupV2CategoriesConfig(name, r),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceNameVolumeGroup, "categories.#", "0"),
+					testAccCheckVolumeGroupCategories(resourceNameVolumeGroup),
 				),
 			},
 		},
@@ -52,9 +405,72 @@ func TestAccV2NutanixVolumeGroupResource_RequiredAttr(t *testing.T) {
 				Config: testAccVolumeGroupV2RequiredAttributes(name),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr(resourceNameVolumeGroup, "name", name),
+					resource.TestCheckResourceAttr(resourceNameVolumeGroup, "enabled_authentications", "NONE"),
+					resource.TestCheckResourceAttrSet(resourceNameVolumeGroup, "created_by"),
 					testAndCheckComputedValues(resourceNameVolumeGroup),
 				),
 			},
+			// omitting enabled_authentications or created_by must not show any drift
+			{
+				Config:   testAccVolumeGroupV2RequiredAttributes(name),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccV2NutanixVolumeGroupResource_TaskPollRetry(t *testing.T) {
+	r := acctest.RandInt()
+	name := fmt.Sprintf("tf-test-volume-group-%d", r)
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckNutanixVolumeGroupV2Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVolumeGroupV2TaskPollRetryConfig(name, 5),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceNameVolumeGroup, "name", name),
+					resource.TestCheckResourceAttr(resourceNameVolumeGroup, "task_poll_retry.0.max_retries", "5"),
+					resource.TestCheckResourceAttr(resourceNameVolumeGroup, "task_poll_retry.0.backoff_base", "2"),
+				),
+			},
+			// changing the retries alone does not update the Volume Group
+			{
+				Config: testAccVolumeGroupV2TaskPollRetryConfig(name, 10),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceNameVolumeGroup, "task_poll_retry.0.max_retries", "10"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccV2NutanixVolumeGroupResource_WithoutWaitForTask(t *testing.T) {
+	r := acctest.RandInt()
+	name := fmt.Sprintf("tf-test-volume-group-%d", r)
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckNutanixVolumeGroupV2Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVolumeGroupV2WithoutWaitForTaskConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceNameVolumeGroup, "wait_for_task", "false"),
+					testAccCheckVolumeGroupCreateTaskDone(resourceNameVolumeGroup),
+				),
+			},
+			// the refresh of the next apply reconciles the Volume Group
+			{
+				Config: testAccVolumeGroupV2WithoutWaitForTaskConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceNameVolumeGroup, "task_ext_id", ""),
+					resource.TestCheckResourceAttr(resourceNameVolumeGroup, "name", name),
+					resource.TestCheckResourceAttrPair(resourceNameVolumeGroup, "id", resourceNameVolumeGroup, "ext_id"),
+					resource.TestCheckResourceAttrSet(resourceNameVolumeGroup, "last_task_ext_id"),
+				),
+			},
 		},
 	})
 }
@@ -81,7 +497,95 @@ func TestAccV2NutanixVolumeGroupResource_WithNoClusterReference(t *testing.T) {
 		Steps: []resource.TestStep{
 			{
 				Config:      testAccVolumeGroupV2ConfigWithNoClusterReference(name),
-				ExpectError: regexp.MustCompile("Missing required argument"),
+				ExpectError: regexp.MustCompile("cluster_reference is not set and no default_cluster_ext_id is configured"),
+			},
+		},
+	})
+}
+
+func TestAccV2NutanixVolumeGroupResource_DefaultClusterExtID(t *testing.T) {
+	r := acctest.RandInt()
+	name := fmt.Sprintf("tf-test-volume-group-%d", r)
+
+	// the provider default is read from the environment when the provider is
+	// configured, which happens again for every step
+	defer os.Unsetenv("NUTANIX_DEFAULT_CLUSTER_EXT_ID")
+
+	var clusterExtID string
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckNutanixVolumeGroupV2Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVolumeGroupV2ClusterOutputConfig(),
+				Check:  testAccCaptureOutput("cluster", &clusterExtID),
+			},
+			// without cluster_reference the Volume Group lands on the provider default
+			{
+				PreConfig: func() { os.Setenv("NUTANIX_DEFAULT_CLUSTER_EXT_ID", clusterExtID) },
+				Config:    testAccVolumeGroupV2ClusterOutputConfig() + testAccVolumeGroupV2ConfigWithNoClusterReference(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceNameVolumeGroup, "name", name),
+					resource.TestCheckResourceAttrPtr(resourceNameVolumeGroup, "cluster_reference", &clusterExtID),
+				),
+			},
+		},
+	})
+}
+
+func TestAccV2NutanixVolumeGroupResource_ClusterReferenceOverridesDefault(t *testing.T) {
+	r := acctest.RandInt()
+	name := fmt.Sprintf("tf-test-volume-group-%d", r)
+
+	// a default cluster which does not exist, the create only succeeds when the
+	// configured cluster_reference is used
+	os.Setenv("NUTANIX_DEFAULT_CLUSTER_EXT_ID", "00000000-0000-0000-0000-000000000000")
+	defer os.Unsetenv("NUTANIX_DEFAULT_CLUSTER_EXT_ID")
+
+	var clusterExtID string
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckNutanixVolumeGroupV2Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVolumeGroupV2ClusterOutputConfig() + fmt.Sprintf(`
+	resource "nutanix_volume_group_v2" "test" {
+		name              = "%s"
+		cluster_reference = local.cluster1
+	}
+`, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceNameVolumeGroup, "name", name),
+					testAccCaptureOutput("cluster", &clusterExtID),
+					resource.TestCheckResourceAttrPtr(resourceNameVolumeGroup, "cluster_reference", &clusterExtID),
+				),
+			},
+		},
+	})
+}
+
+func TestAccV2NutanixVolumeGroupResource_CreateOnlyArgs(t *testing.T) {
+	r := acctest.RandInt()
+	name := fmt.Sprintf("tf-test-volume-group-%d", r)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckNutanixVolumeGroupV2Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVolumeGroupV2ProtocolConfig(name, "ISCSI"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceNameVolumeGroup, "protocol", "ISCSI"),
+				),
+			},
+			// the protocol is only sent by the create, changing it must fail
+			// instead of being dropped
+			{
+				Config:      testAccVolumeGroupV2ProtocolConfig(name, "NVMF"),
+				ExpectError: regexp.MustCompile("protocol can not be updated on an existing Volume Group"),
 			},
 		},
 	})
@@ -120,6 +624,71 @@ func TestAccV2NutanixVolumeGroupResource_WithAttachmentTypeAndProtocolAndDisks(t
 	})
 }
 
+func TestAccV2NutanixVolumeGroupResource_FromSourceVolumeGroup(t *testing.T) {
+	r := acctest.RandInt()
+	name := fmt.Sprintf("tf-test-volume-group-%d", r)
+	desc := "test volume group cloned from a source volume group"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckNutanixVolumeGroupV2Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVolumeGroupResourceFromSourceConfig(name, desc),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceNameVolumeGroup, "name", name+"-clone"),
+					resource.TestCheckResourceAttrPair(resourceNameVolumeGroup, "source_volume_group_ext_id", "nutanix_volume_group_v2.source", "id"),
+					resource.TestCheckResourceAttr("data.nutanix_volume_group_disks_v2.clone", "disks.#", "2"),
+					testAccCheckVolumeGroupDisksClonedFrom(resourceNameVolumeGroup, "nutanix_volume_group_v2.source"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccV2NutanixVolumeGroupResource_ImportWithDisks(t *testing.T) {
+	r := acctest.RandInt()
+	name := fmt.Sprintf("tf-test-volume-group-%d", r)
+	desc := "test volume group import with disks"
+
+	// attributes only known to the configuration, the API does not return them
+	ignored := []string{
+		"iscsi_features.0.target_secret",
+		"attachment_type",
+		"protocol",
+		"last_task_ext_id",
+		"disks.0.disk_data_source_reference.0.name",
+		"disks.0.disk_data_source_reference.0.uris",
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckNutanixVolumeGroupV2Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVolumeGroupResourceConfigWithAttachmentTypeAndProtocolAndDisks(name, desc),
+			},
+			// the plain import leaves the disks unmanaged
+			{
+				ResourceName:            resourceNameVolumeGroup,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: append([]string{"disks"}, ignored...),
+			},
+			// <ext_id>/disks imports the inline disks as well
+			{
+				ResourceName:            resourceNameVolumeGroup,
+				ImportState:             true,
+				ImportStateIdFunc:       testAccVolumeGroupImportStateID("/disks", resourceNameVolumeGroup),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: ignored,
+			},
+		},
+	})
+}
+
 // VG just required attributes
 func testAccVolumeGroupV2RequiredAttributes(name string) string {
 	return fmt.Sprintf(`
@@ -157,6 +726,33 @@ func testAccVolumeGroupV2ConfigWithNoName() string {
 	`
 }
 
+func testAccVolumeGroupV2ClusterOutputConfig() string {
+	return `
+	data "nutanix_clusters_v2" "clusters" {}
+
+	locals {
+		cluster1 = [
+			for cluster in data.nutanix_clusters_v2.clusters.cluster_entities :
+			cluster.ext_id if cluster.config[0].cluster_function[0] != "PRISM_CENTRAL"
+		][0]
+	}
+
+	output "cluster" {
+		value = local.cluster1
+	}
+`
+}
+
+func testAccVolumeGroupV2ProtocolConfig(name, protocol string) string {
+	return testAccVolumeGroupV2ClusterOutputConfig() + fmt.Sprintf(`
+	resource "nutanix_volume_group_v2" "test" {
+		name              = "%s"
+		cluster_reference = local.cluster1
+		protocol          = "%s"
+	}
+`, name, protocol)
+}
+
 func testAccVolumeGroupV2ConfigWithNoClusterReference(name string) string {
 	return fmt.Sprintf(`
 	resource "nutanix_volume_group_v2" "test" {
@@ -224,3 +820,368 @@ func testAccVolumeGroupResourceConfigWithAttachmentTypeAndProtocolAndDisks(name
 	  }	  
 	`, name, desc)
 }
+
+func testAccVolumeGroupResourceChapConfig(name, desc string) string {
+	return fmt.Sprintf(`
+	data "nutanix_clusters_v2" "clusters" {}
+
+	locals {
+		cluster1 =  [
+			  for cluster in data.nutanix_clusters_v2.clusters.cluster_entities :
+			  cluster.ext_id if cluster.config[0].cluster_function[0] != "PRISM_CENTRAL"
+		][0]
+	}
+
+	resource "nutanix_volume_group_v2" "test" {
+		name                               = "%[1]s"
+		description                        = "%[2]s"
+		should_load_balance_vm_attachments = false
+		sharing_status                     = "SHARED"
+		created_by                         = "admin"
+		cluster_reference                  = local.cluster1
+		iscsi_features {
+			target_secret            = "1234567891011"
+			enabled_authentications  = "CHAP"
+		}
+		usage_type = "USER"
+		is_hidden  = false
+	}
+	`, name, desc)
+}
+
+func testAccVolumeGroupResourceChapEndToEndConfig(name, authentication string) string {
+	return fmt.Sprintf(`
+	data "nutanix_clusters_v2" "clusters" {}
+
+	locals {
+		cluster1 =  [
+			  for cluster in data.nutanix_clusters_v2.clusters.cluster_entities :
+			  cluster.ext_id if cluster.config[0].cluster_function[0] != "PRISM_CENTRAL"
+		][0]
+	}
+
+	resource "nutanix_volume_group_v2" "test" {
+		name              = "%[1]s"
+		sharing_status    = "SHARED"
+		cluster_reference = local.cluster1
+		%[2]s
+	}
+	`, name, authentication)
+}
+
+func testAccVolumeGroupResourceWithTwoIscsiClientsConfig(name, desc, sharingStatus string) string {
+	return fmt.Sprintf(`
+	data "nutanix_clusters_v2" "clusters" {}
+
+	locals {
+		cluster1 =  [
+			  for cluster in data.nutanix_clusters_v2.clusters.cluster_entities :
+			  cluster.ext_id if cluster.config[0].cluster_function[0] != "PRISM_CENTRAL"
+		][0]
+	}
+
+	resource "nutanix_volume_group_v2" "test" {
+		name                               = "%[1]s"
+		description                        = "%[2]s"
+		should_load_balance_vm_attachments = false
+		sharing_status                     = "%[3]s"
+		cluster_reference                  = local.cluster1
+		usage_type                         = "USER"
+	}
+
+	data "nutanix_volume_iscsi_clients_v2" "test" {}
+
+	resource "nutanix_volume_group_iscsi_client_v2" "test" {
+		count                = 2
+		vg_ext_id            = resource.nutanix_volume_group_v2.test.id
+		ext_id               = data.nutanix_volume_iscsi_clients_v2.test.iscsi_clients[count.index].ext_id
+		iscsi_initiator_name = data.nutanix_volume_iscsi_clients_v2.test.iscsi_clients[count.index].iscsi_initiator_name
+	}
+	`, name, desc, sharingStatus)
+}
+
+func testAccVolumeGroupResourceUpdateConfig(name, desc, sharingStatus string) string {
+	return fmt.Sprintf(`
+	data "nutanix_clusters_v2" "clusters" {}
+
+	locals {
+		cluster1 =  [
+			  for cluster in data.nutanix_clusters_v2.clusters.cluster_entities :
+			  cluster.ext_id if cluster.config[0].cluster_function[0] != "PRISM_CENTRAL"
+		][0]
+	}
+
+	resource "nutanix_volume_group_v2" "test" {
+		name                               = "%[1]s"
+		description                        = "%[2]s"
+		should_load_balance_vm_attachments = false
+		sharing_status                     = "%[3]s"
+		cluster_reference                  = local.cluster1
+		usage_type                         = "USER"
+	}
+
+	data "nutanix_volume_group_v2" "test" {
+		ext_id = nutanix_volume_group_v2.test.id
+	}
+	`, name, desc, sharingStatus)
+}
+
+func testAccVolumeGroupResourceTargetPrefixAndNameConfig(name string) string {
+	return fmt.Sprintf(`
+	resource "nutanix_volume_group_v2" "test" {
+		name              = "%[1]s"
+		cluster_reference = "00000000-0000-0000-0000-000000000000"
+		target_prefix     = "tf-test-prefix"
+		target_name       = "tf-test-target-%[1]s"
+	}
+	`, name)
+}
+
+func testAccVolumeGroupV2CheckNameUniquenessConfig(name string, duplicate bool) string {
+	config := fmt.Sprintf(`
+	data "nutanix_clusters" "clusters" {}
+
+	locals{
+		cluster1 = [
+			for cluster in data.nutanix_clusters.clusters.entities :
+			cluster.metadata.uuid if cluster.service_list[0] != "PRISM_CENTRAL"
+		][0]
+	}
+
+	resource "nutanix_volume_group_v2" "test" {
+		name                  = "%s"
+		cluster_reference     = local.cluster1
+		check_name_uniqueness = true
+	}
+`, name)
+	if duplicate {
+		config += fmt.Sprintf(`
+	resource "nutanix_volume_group_v2" "duplicate" {
+		name                  = "%s"
+		cluster_reference     = local.cluster1
+		check_name_uniqueness = true
+		depends_on            = [nutanix_volume_group_v2.test]
+	}
+`, name)
+	}
+	return config
+}
+
+func testAccVolumeGroupV2RollbackOnFailureConfig(name string) string {
+	return fmt.Sprintf(`
+	data "nutanix_clusters" "clusters" {}
+
+	locals{
+		cluster1 = [
+			for cluster in data.nutanix_clusters.clusters.entities :
+			cluster.metadata.uuid if cluster.service_list[0] != "PRISM_CENTRAL"
+		][0]
+	}
+
+	resource "nutanix_volume_group_v2" "test" {
+		name                = "%s"
+		cluster_reference   = local.cluster1
+		rollback_on_failure = true
+		disks {
+			index           = 0
+			disk_size_bytes = 1024 * 1024 * 1024
+		}
+		disks {
+			index           = 1
+			disk_size_bytes = 1024 * 1024 * 1024
+			disk_data_source_reference {
+				ext_id      = "00000000-0000-0000-0000-000000000000"
+				entity_type = "VM_DISK"
+			}
+		}
+	}
+`, name)
+}
+
+func testAccVolumeGroupV2RollbackOnFailureCheckConfig(name string) string {
+	return fmt.Sprintf(`
+	data "nutanix_volume_groups_v2" "test" {
+		filter = "name eq '%s'"
+	}
+`, name)
+}
+
+func testAccVolumeGroupV2CategoriesConfig(name string, r int, categories ...string) string {
+	return fmt.Sprintf(`
+	data "nutanix_clusters" "clusters" {}
+
+	locals{
+		cluster1 = [
+			for cluster in data.nutanix_clusters.clusters.entities :
+			cluster.metadata.uuid if cluster.service_list[0] != "PRISM_CENTRAL"
+		][0]
+	}
+
+	resource "nutanix_category_v2" "first" {
+		key   = "tf-test-vg-category-%[2]d"
+		value = "first"
+	}
+
+	resource "nutanix_category_v2" "second" {
+		key   = "tf-test-vg-category-%[2]d"
+		value = "second"
+	}
+
+	resource "nutanix_volume_group_v2" "test" {
+		name              = "%[1]s"
+		cluster_reference = local.cluster1
+		categories        = [%[3]s]
+	}
+`, name, r, strings.Join(categories, ", "))
+}
+
+func testAccVolumeGroupV2CategoriesOmittedConfig(name string, r int) string {
+	return strings.Replace(testAccVolumeGroupV2CategoriesConfig(name, r), "categories        = []", "", 1)
+}
+
+func testAccVolumeGroupResourceDuplicateDiskIndexConfig(name string) string {
+	return fmt.Sprintf(`
+	resource "nutanix_volume_group_v2" "test" {
+		name              = "%s"
+		cluster_reference = "00000000-0000-0000-0000-000000000000"
+
+		disks {
+			index           = 2
+			disk_size_bytes = 1073741824
+			disk_data_source_reference {
+				ext_id      = "00000000-0000-0000-0000-000000000000"
+				entity_type = "STORAGE_CONTAINER"
+			}
+		}
+		disks {
+			index           = 2
+			disk_size_bytes = 1073741824
+			disk_data_source_reference {
+				ext_id      = "00000000-0000-0000-0000-000000000000"
+				entity_type = "STORAGE_CONTAINER"
+			}
+		}
+	}
+	`, name)
+}
+
+func testAccVolumeGroupV2TaskPollRetryConfig(name string, maxRetries int) string {
+	return fmt.Sprintf(`
+	data "nutanix_clusters" "clusters" {}
+
+	locals{
+		cluster1 = [
+			for cluster in data.nutanix_clusters.clusters.entities :
+			cluster.metadata.uuid if cluster.service_list[0] != "PRISM_CENTRAL"
+		][0]
+	}
+
+	resource "nutanix_volume_group_v2" "test" {
+		name              = "%s"
+		cluster_reference = local.cluster1
+
+		task_poll_retry {
+			max_retries  = %d
+			backoff_base = 2
+		}
+	}
+`, name, maxRetries)
+}
+
+func testAccVolumeGroupV2WithoutWaitForTaskConfig(name string) string {
+	return fmt.Sprintf(`
+	data "nutanix_clusters" "clusters" {}
+
+	locals{
+		cluster1 = [
+			for cluster in data.nutanix_clusters.clusters.entities :
+			cluster.metadata.uuid if cluster.service_list[0] != "PRISM_CENTRAL"
+		][0]
+	}
+
+	resource "nutanix_volume_group_v2" "test" {
+		name              = "%s"
+		cluster_reference = local.cluster1
+		wait_for_task     = false
+	}
+`, name)
+}
+
+func testAccVolumeGroupResourceFromSourceConfig(name, desc string) string {
+	return fmt.Sprintf(`
+	data "nutanix_clusters_v2" "clusters" {}
+
+	locals {
+		cluster1 =  [
+			  for cluster in data.nutanix_clusters_v2.clusters.cluster_entities :
+			  cluster.ext_id if cluster.config[0].cluster_function[0] != "PRISM_CENTRAL"
+		][0]
+	}
+
+	data "nutanix_storage_containers_v2" "test" {
+	  filter = "clusterExtId eq '${local.cluster1}'"
+	  limit  = 1
+	}
+
+	resource "nutanix_volume_group_v2" "source" {
+		name              = "%[1]s-source"
+		cluster_reference = local.cluster1
+		disks {
+			disk_size_bytes = 10 * 1024 * 1024 * 1024
+			index = 1
+			disk_data_source_reference {
+			  ext_id      = data.nutanix_storage_containers_v2.test.storage_containers[0].ext_id
+			  entity_type = "STORAGE_CONTAINER"
+			}
+		}
+		disks {
+			disk_size_bytes = 5 * 1024 * 1024 * 1024
+			index = 2
+			disk_data_source_reference {
+			  ext_id      = data.nutanix_storage_containers_v2.test.storage_containers[0].ext_id
+			  entity_type = "STORAGE_CONTAINER"
+			}
+		}
+	}
+
+	resource "nutanix_volume_group_v2" "test" {
+		name                       = "%[1]s-clone"
+		description                = "%[2]s"
+		cluster_reference          = local.cluster1
+		source_volume_group_ext_id = nutanix_volume_group_v2.source.id
+	}
+
+	data "nutanix_volume_group_disks_v2" "clone" {
+		volume_group_ext_id = nutanix_volume_group_v2.test.id
+	}
+	`, name, desc)
+}
+
+func testAccVolumeGroupResourceIsHiddenConfig(name, desc string, isHidden bool) string {
+	return fmt.Sprintf(`
+	data "nutanix_clusters_v2" "clusters" {}
+
+	locals {
+		cluster1 =  [
+			  for cluster in data.nutanix_clusters_v2.clusters.cluster_entities :
+			  cluster.ext_id if cluster.config[0].cluster_function[0] != "PRISM_CENTRAL"
+		][0]
+	}
+
+	resource "nutanix_volume_group_v2" "test" {
+		name                               = "%[1]s"
+		description                        = "%[2]s"
+		should_load_balance_vm_attachments = false
+		sharing_status                     = "SHARED"
+		created_by                         = "admin"
+		cluster_reference                  = local.cluster1
+		usage_type                         = "USER"
+		is_hidden                          = %[3]t
+	}
+
+	data "nutanix_volume_group_v2" "test" {
+		ext_id     = nutanix_volume_group_v2.test.id
+		depends_on = [resource.nutanix_volume_group_v2.test]
+	}
+	`, name, desc, isHidden) + testAccVolumeGroupDiskResourceConfig(name, desc)
+}
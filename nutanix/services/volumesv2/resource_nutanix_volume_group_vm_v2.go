@@ -2,6 +2,8 @@ package volumesv2
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -10,6 +12,7 @@ import (
 	volumesPrism "github.com/nutanix/ntnx-api-golang-clients/volumes-go-client/v4/models/prism/v4/config"
 	volumesClient "github.com/nutanix/ntnx-api-golang-clients/volumes-go-client/v4/models/volumes/v4/config"
 	conns "github.com/terraform-providers/terraform-provider-nutanix/nutanix"
+	"github.com/terraform-providers/terraform-provider-nutanix/nutanix/sdks/v4/volumes"
 	"github.com/terraform-providers/terraform-provider-nutanix/utils"
 )
 
@@ -62,9 +65,15 @@ func ResourceNutanixVolumeAttachVMToVolumeGroupV2Create(ctx context.Context, d *
 		body.Index = utils.IntPtr(index.(int))
 	}
 
+	// a freshly created volume group is not always visible to the attach call
+	// yet, wait until it can be fetched before attaching the VM
+	if err := waitForVolumeGroupAttachable(ctx, conn, volumeGroupExtID.(string), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.FromErr(err)
+	}
+
 	resp, err := conn.VolumeAPIInstance.AttachVm(utils.StringPtr(volumeGroupExtID.(string)), &body)
 	if err != nil {
-		return diag.Errorf("error while Attaching Vm to Volume Group : %v", err)
+		return utils.WithRemediationHint(diag.Errorf("error while Attaching Vm to Volume Group : %v", err), err)
 	}
 
 	TaskRef := resp.Data.GetValue().(volumesPrism.TaskReference)
@@ -73,9 +82,9 @@ func ResourceNutanixVolumeAttachVMToVolumeGroupV2Create(ctx context.Context, d *
 	taskconn := meta.(*conns.Client).PrismAPI
 	// Wait for the VM to be available
 	stateConf := &resource.StateChangeConf{
-		Pending: []string{"PENDING", "RUNNING", "QUEUED", "QUEUED"},
-		Target:  []string{"SUCCEEDED"},
-		Refresh: taskStateRefreshPrismTaskGroupFunc(ctx, taskconn, utils.StringValue(taskUUID)),
+		Pending: utils.TaskPendingStates,
+		Target:  utils.TaskTargetStates,
+		Refresh: taskStateRefreshPrismTaskGroupFunc(ctx, taskconn, utils.StringValue(taskUUID), utils.TaskPollRetry{}),
 		Timeout: d.Timeout(schema.TimeoutCreate),
 	}
 
@@ -99,6 +108,58 @@ func ResourceNutanixVolumeAttachVMToVolumeGroupV2Create(ctx context.Context, d *
 	return nil
 }
 
+const (
+	volumeGroupAttachableTimeout = 2 * time.Minute
+	volumeGroupAttachableDelay   = 5 * time.Second
+)
+
+// waitForVolumeGroupAttachable polls the volume group until it can be fetched,
+// for at most volumeGroupAttachableTimeout (or timeout if shorter). Only
+// transient errors and conflicts, e.g. a VG still busy with its create task,
+// are retried, any other error such as a 403 or 404 is returned at once. The
+// error carries the last state observed so a VG that never became ready is
+// visible.
+func waitForVolumeGroupAttachable(ctx context.Context, conn *volumes.Client, volumeGroupExtID string, timeout time.Duration) error {
+	if timeout <= 0 || timeout > volumeGroupAttachableTimeout {
+		timeout = volumeGroupAttachableTimeout
+	}
+
+	observed := "UNKNOWN"
+	var fetchErr error
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"UNAVAILABLE"},
+		Target:  []string{"ATTACHABLE"},
+		Refresh: func() (interface{}, string, error) {
+			resp, err := conn.VolumeAPIInstance.GetVolumeGroupById(utils.StringPtr(volumeGroupExtID))
+			if err != nil {
+				if !utils.IsTransientError(err) && !utils.IsV4Conflict(err) {
+					fetchErr = fmt.Errorf("error while fetching volume group %s : %v", volumeGroupExtID, err)
+					return nil, "", fetchErr
+				}
+				observed = fmt.Sprintf("UNAVAILABLE (%v)", err)
+				return "", "UNAVAILABLE", nil
+			}
+			vg, ok := resp.Data.GetValue().(volumesClient.VolumeGroup)
+			if !ok || vg.ExtId == nil {
+				observed = "UNAVAILABLE (empty volume group in response)"
+				return "", "UNAVAILABLE", nil
+			}
+			observed = fmt.Sprintf("ATTACHABLE (sharing_status: %s)", flattenSharingStatus(vg.SharingStatus))
+			return vg, "ATTACHABLE", nil
+		},
+		Timeout:    timeout,
+		MinTimeout: volumeGroupAttachableDelay,
+	}
+
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		if fetchErr != nil {
+			return fetchErr
+		}
+		return fmt.Errorf("volume group %s did not become attachable within %s, last observed state: %s: %v", volumeGroupExtID, timeout, observed, err)
+	}
+	return nil
+}
+
 func ResourceNutanixVolumeAttachVMToVolumeGroupV2Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	return nil
 }
@@ -124,7 +185,7 @@ func ResourceNutanixVolumeAttachVMToVolumeGroupV2Delete(ctx context.Context, d *
 
 	resp, err := conn.VolumeAPIInstance.DetachVm(utils.StringPtr(volumeGroupExtID.(string)), &body)
 	if err != nil {
-		return diag.Errorf("error while Detaching Vm to Volume Group : %v", err)
+		return utils.WithRemediationHint(diag.Errorf("error while Detaching Vm to Volume Group : %v", err), err)
 	}
 
 	TaskRef := resp.Data.GetValue().(volumesPrism.TaskReference)
@@ -133,9 +194,9 @@ func ResourceNutanixVolumeAttachVMToVolumeGroupV2Delete(ctx context.Context, d *
 	taskconn := meta.(*conns.Client).PrismAPI
 	// Wait for the VM to be available
 	stateConf := &resource.StateChangeConf{
-		Pending: []string{"PENDING", "RUNNING", "QUEUED"},
-		Target:  []string{"SUCCEEDED"},
-		Refresh: taskStateRefreshPrismTaskGroupFunc(ctx, taskconn, utils.StringValue(taskUUID)),
+		Pending: utils.TaskPendingStates,
+		Target:  utils.TaskTargetStates,
+		Refresh: taskStateRefreshPrismTaskGroupFunc(ctx, taskconn, utils.StringValue(taskUUID), utils.TaskPollRetry{}),
 		Timeout: d.Timeout(schema.TimeoutCreate),
 	}
 
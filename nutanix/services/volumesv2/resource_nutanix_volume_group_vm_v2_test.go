@@ -32,6 +32,67 @@ func TestAccV2NutanixVolumeGroupVmResource_Basic(t *testing.T) {
 	})
 }
 
+func TestAccV2NutanixVolumeGroupVmResource_LoadBalanced(t *testing.T) {
+	r := acctest.RandInt()
+	name := fmt.Sprintf("test-volume-group-%d", r)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: resourceVolumeGroupVMLoadBalanced(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceNameVolumeGroup, "should_load_balance_vm_attachments", "true"),
+					resource.TestCheckResourceAttrSet("nutanix_volume_group_vm_v2.test.0", "vm_ext_id"),
+					resource.TestCheckResourceAttrSet("nutanix_volume_group_vm_v2.test.1", "vm_ext_id"),
+				),
+			},
+		},
+	})
+}
+
+func resourceVolumeGroupVMLoadBalanced(name string) string {
+	return fmt.Sprintf(`
+	data "nutanix_clusters_v2" "clusters" {}
+
+	locals {
+		cluster1 =  [
+			  for cluster in data.nutanix_clusters_v2.clusters.cluster_entities :
+			  cluster.ext_id if cluster.config[0].cluster_function[0] != "PRISM_CENTRAL"
+		][0]
+	}
+
+	resource "nutanix_volume_group_v2" "test" {
+		name                               = "%[1]s"
+		should_load_balance_vm_attachments = true
+		sharing_status                     = "SHARED"
+		cluster_reference                  = local.cluster1
+	}
+
+	resource "nutanix_virtual_machine_v2" "test" {
+		count                = 2
+		name                 = "tf-test-vg-vm-%[1]s-${count.index}"
+		num_cores_per_socket = 1
+		num_sockets          = 1
+		cluster {
+			ext_id = local.cluster1
+		}
+		lifecycle {
+			ignore_changes = [
+				disks
+			]
+		}
+	}
+
+	resource "nutanix_volume_group_vm_v2" "test" {
+		count               = 2
+		volume_group_ext_id = resource.nutanix_volume_group_v2.test.id
+		vm_ext_id           = resource.nutanix_virtual_machine_v2.test[count.index].id
+	}
+	`, name)
+}
+
 func resourceVolumeGroupVMBasic(filepath, name, desc string) string {
 	return testAccVolumeGroupResourceConfig(name, desc) + fmt.Sprintf(`	
           resource "nutanix_virtual_machine_v2" "test"{
@@ -0,0 +1,41 @@
+package utils
+
+import "sync"
+
+// Cache keeps values fetched once for the lifetime of the provider, e.g. the
+// settings of a cluster read on every refresh. A nil Cache does not cache
+// anything.
+type Cache struct {
+	mu     sync.Mutex
+	values map[string]interface{}
+}
+
+// NewCache returns an empty Cache.
+func NewCache() *Cache {
+	return &Cache{values: make(map[string]interface{})}
+}
+
+// Get returns the value cached for key, or calls fetch and caches the value
+// it returns. An error is returned as is and not cached, so that the next Get
+// fetches again. Concurrent Gets of a missing key may each call fetch.
+func (c *Cache) Get(key string, fetch func() (interface{}, error)) (interface{}, error) {
+	if c == nil {
+		return fetch()
+	}
+
+	c.mu.Lock()
+	value, ok := c.values[key]
+	c.mu.Unlock()
+	if ok {
+		return value, nil
+	}
+
+	value, err := fetch()
+	if err != nil {
+		return nil, err
+	}
+	c.mu.Lock()
+	c.values[key] = value
+	c.mu.Unlock()
+	return value, nil
+}
@@ -0,0 +1,56 @@
+package utils
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestCache(t *testing.T) {
+	c := NewCache()
+	calls := 0
+	fetch := func() (interface{}, error) {
+		calls++
+		return calls, nil
+	}
+
+	for i := 0; i < 3; i++ {
+		v, err := c.Get("a", fetch)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if v.(int) != 1 {
+			t.Errorf("Get(a) = %v, expected the first fetched value 1", v)
+		}
+	}
+	if v, _ := c.Get("b", fetch); v.(int) != 2 {
+		t.Errorf("Get(b) = %v, expected 2", v)
+	}
+	if calls != 2 {
+		t.Errorf("fetch called %d times, expected once per key", calls)
+	}
+}
+
+func TestCacheDoesNotCacheErrors(t *testing.T) {
+	c := NewCache()
+	failing := errors.New("unreachable")
+	if _, err := c.Get("a", func() (interface{}, error) { return nil, failing }); err != failing {
+		t.Fatalf("Get returned %v, expected the fetch error", err)
+	}
+	v, err := c.Get("a", func() (interface{}, error) { return "ok", nil })
+	if err != nil || v.(string) != "ok" {
+		t.Errorf("Get after an error = %v, %v, expected the value fetched again", v, err)
+	}
+}
+
+func TestNilCache(t *testing.T) {
+	var c *Cache
+	calls := 0
+	for i := 0; i < 2; i++ {
+		if _, err := c.Get("a", func() (interface{}, error) { calls++; return calls, nil }); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if calls != 2 {
+		t.Errorf("a nil Cache fetched %d times, expected every time", calls)
+	}
+}
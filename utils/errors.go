@@ -0,0 +1,111 @@
+package utils
+
+import (
+	"encoding/json"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// V4Error is a v4 API error reduced to what callers branch on: the error code
+// and message of the response body and the HTTP status code. HTTPStatus is 0
+// for an error which is not a v4 API error, e.g. a connection error.
+type V4Error struct {
+	Code       string
+	Message    string
+	HTTPStatus int
+}
+
+// ParseV4Error returns the code, message and HTTP status of a v4 API error.
+// The code and message are those of the first error of the response body, an
+// error whose body can not be parsed keeps its whole text as the message.
+func ParseV4Error(err error) V4Error {
+	if err == nil {
+		return V4Error{}
+	}
+
+	v4Err := V4Error{Message: err.Error()}
+	if status := strings.Fields(v4ErrorStatus(err)); len(status) > 0 {
+		v4Err.HTTPStatus, _ = strconv.Atoi(status[0])
+	}
+
+	var body struct {
+		Data struct {
+			Error json.RawMessage `json:"error"`
+		} `json:"data"`
+	}
+	if json.Unmarshal([]byte(err.Error()), &body) != nil || len(body.Data.Error) == 0 {
+		return v4Err
+	}
+
+	type errorMessage struct {
+		Code    string `json:"code"`
+		Message string `json:"message"`
+	}
+	// the error is a list of messages, or a single schema validation error
+	// holding its messages in validationErrorMessages
+	var messages []errorMessage
+	if json.Unmarshal(body.Data.Error, &messages) != nil {
+		var validationErr struct {
+			errorMessage
+			ValidationErrorMessages []errorMessage `json:"validationErrorMessages"`
+		}
+		if json.Unmarshal(body.Data.Error, &validationErr) != nil {
+			return v4Err
+		}
+		messages = append([]errorMessage{validationErr.errorMessage}, validationErr.ValidationErrorMessages...)
+	}
+
+	for _, m := range messages {
+		if m.Code == "" && m.Message == "" {
+			continue
+		}
+		v4Err.Code = m.Code
+		if m.Message != "" {
+			v4Err.Message = m.Message
+		}
+		break
+	}
+	return v4Err
+}
+
+// IsV4NotFound reports whether err is a v4 API error for an entity which does
+// not exist.
+func IsV4NotFound(err error) bool {
+	if err == nil {
+		return false
+	}
+	if ParseV4Error(err).HTTPStatus == 404 {
+		return true
+	}
+
+	// some services answer with their own error code, e.g. VOLUME_UNKNOWN_ENTITY_ERROR
+	msg := err.Error()
+	return strings.Contains(msg, "ENTITY_NOT_FOUND") || strings.Contains(msg, "UNKNOWN_ENTITY")
+}
+
+// IsV4Conflict reports whether err is a v4 API error for a request conflicting
+// with the current state of the entity, which may succeed once retried.
+func IsV4Conflict(err error) bool {
+	return ParseV4Error(err).HTTPStatus == 409
+}
+
+// v4ErrorStatus returns the HTTP status line of a v4 API error, e.g.
+// "404 Not Found", or an empty string for any other error. Every v4 SDK has
+// its own GenericOpenAPIError type, they all carry the HTTP status line in a
+// Status field which is read here by reflection.
+func v4ErrorStatus(err error) string {
+	v := reflect.ValueOf(err)
+	if v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return ""
+		}
+		v = v.Elem()
+	}
+	if v.Kind() == reflect.Struct {
+		if status := v.FieldByName("Status"); status.IsValid() && status.Kind() == reflect.String {
+			return status.String()
+		}
+	}
+	return ""
+}
@@ -0,0 +1,80 @@
+package utils
+
+import (
+	"errors"
+	"testing"
+
+	volumesClient "github.com/nutanix/ntnx-api-golang-clients/volumes-go-client/v4/client"
+)
+
+func TestIsV4NotFound(t *testing.T) {
+	cases := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"nil", nil, false},
+		{"not found", volumesClient.GenericOpenAPIError{Status: "404 Not Found", Body: []byte(`{}`)}, true},
+		{"not found pointer", &volumesClient.GenericOpenAPIError{Status: "404 Not Found"}, true},
+		{"server error", volumesClient.GenericOpenAPIError{Status: "500 Internal Server Error", Body: []byte(`{}`)}, false},
+		{"entity not found message", errors.New("ENTITY_NOT_FOUND: vm does not exist"), true},
+		{"unknown entity code", errors.New(`{"data":{"error":[{"code":"VOLUME_UNKNOWN_ENTITY_ERROR"}]}}`), true},
+		{"other error", errors.New("connection refused"), false},
+	}
+
+	for _, tc := range cases {
+		if got := IsV4NotFound(tc.err); got != tc.want {
+			t.Errorf("%s: got %v, want %v", tc.name, got, tc.want)
+		}
+	}
+}
+
+func TestParseV4Error(t *testing.T) {
+	cases := []struct {
+		name string
+		err  error
+		want V4Error
+	}{
+		{"nil", nil, V4Error{}},
+		{"connection error", errors.New("connection refused"), V4Error{Message: "connection refused"}},
+		{
+			"error list",
+			volumesClient.GenericOpenAPIError{
+				Status: "409 Conflict",
+				Body:   []byte(`{"data":{"error":[{"code":"VOL-10010","message":"Volume group has attachments"}]}}`),
+			},
+			V4Error{Code: "VOL-10010", Message: "Volume group has attachments", HTTPStatus: 409},
+		},
+		{
+			"schema validation error",
+			&volumesClient.GenericOpenAPIError{
+				Status: "400 Bad Request",
+				Body:   []byte(`{"data":{"error":{"validationErrorMessages":[{"code":"VOL-40001","message":"name is too long"}]}}}`),
+			},
+			V4Error{Code: "VOL-40001", Message: "name is too long", HTTPStatus: 400},
+		},
+		{
+			"body without error",
+			volumesClient.GenericOpenAPIError{Status: "503 Service Unavailable", Body: []byte(`upstream timeout`)},
+			V4Error{Message: "upstream timeout", HTTPStatus: 503},
+		},
+	}
+
+	for _, tc := range cases {
+		if got := ParseV4Error(tc.err); got != tc.want {
+			t.Errorf("%s: got %+v, want %+v", tc.name, got, tc.want)
+		}
+	}
+}
+
+func TestIsV4Conflict(t *testing.T) {
+	if !IsV4Conflict(volumesClient.GenericOpenAPIError{Status: "409 Conflict"}) {
+		t.Error("a 409 is a conflict")
+	}
+	if IsV4Conflict(volumesClient.GenericOpenAPIError{Status: "404 Not Found"}) {
+		t.Error("a 404 is not a conflict")
+	}
+	if IsV4Conflict(errors.New("409")) {
+		t.Error("an error without a status is not a conflict")
+	}
+}
@@ -0,0 +1,33 @@
+package utils
+
+import "context"
+
+// ParallelLimiter bounds the number of operations running at the same time.
+// A nil ParallelLimiter does not limit anything.
+type ParallelLimiter struct {
+	slots chan struct{}
+}
+
+// NewParallelLimiter returns a limiter allowing at most limit operations at
+// the same time. It returns nil, i.e. no limit, if limit is not positive.
+func NewParallelLimiter(limit int) *ParallelLimiter {
+	if limit <= 0 {
+		return nil
+	}
+	return &ParallelLimiter{slots: make(chan struct{}, limit)}
+}
+
+// Acquire waits for a free slot and returns the function releasing it. It
+// returns the context error if the context is done before a slot is free.
+func (l *ParallelLimiter) Acquire(ctx context.Context) (func(), error) {
+	if l == nil {
+		return func() {}, nil
+	}
+
+	select {
+	case l.slots <- struct{}{}:
+		return func() { <-l.slots }, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
@@ -0,0 +1,71 @@
+package utils
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestParallelLimiter(t *testing.T) {
+	const limit, workers = 3, 20
+	l := NewParallelLimiter(limit)
+
+	var running, maxRunning int32
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			release, err := l.Acquire(context.Background())
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			defer release()
+
+			n := atomic.AddInt32(&running, 1)
+			for {
+				m := atomic.LoadInt32(&maxRunning)
+				if n <= m || atomic.CompareAndSwapInt32(&maxRunning, m, n) {
+					break
+				}
+			}
+			time.Sleep(time.Millisecond)
+			atomic.AddInt32(&running, -1)
+		}()
+	}
+	wg.Wait()
+
+	if maxRunning > limit {
+		t.Errorf("got %d operations running at the same time, want at most %d", maxRunning, limit)
+	}
+}
+
+func TestParallelLimiterUnlimited(t *testing.T) {
+	l := NewParallelLimiter(0)
+	if l != nil {
+		t.Fatalf("expected no limiter for a limit of 0")
+	}
+	release, err := l.Acquire(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	release()
+}
+
+func TestParallelLimiterContextDone(t *testing.T) {
+	l := NewParallelLimiter(1)
+	release, err := l.Acquire(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer release()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := l.Acquire(ctx); err == nil {
+		t.Errorf("expected an error when the context is done before a slot is free")
+	}
+}
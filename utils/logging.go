@@ -0,0 +1,73 @@
+package utils
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	prismConfig "github.com/nutanix/ntnx-api-golang-clients/prism-go-client/v4/models/prism/v4/config"
+)
+
+const (
+	// LogFormatText writes log lines as "[LEVEL] message: key=value ..."
+	LogFormatText = "text"
+	// LogFormatJSON writes log lines as "[LEVEL] {json object}"
+	LogFormatJSON = "json"
+)
+
+// LogFields are the structured fields attached to a log line.
+type LogFields map[string]interface{}
+
+// LogEvent writes a log line at the given level with the given fields, in the
+// log_format of the provider, unknown formats fall back to text. The level
+// prefix is always kept so that TF_LOG filtering keeps working.
+func LogEvent(format, level, msg string, fields LogFields) {
+	log.Printf("[%s] %s", level, formatLogEvent(format, msg, fields))
+}
+
+func formatLogEvent(format, msg string, fields LogFields) string {
+	if format == LogFormatJSON {
+		event := make(map[string]interface{}, len(fields)+1)
+		for k, v := range fields {
+			event[k] = v
+		}
+		event["message"] = msg
+
+		b, err := json.Marshal(event)
+		if err == nil {
+			return string(b)
+		}
+	}
+
+	keys := make([]string, 0, len(fields))
+	for k := range fields {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	pairs := make([]string, 0, len(keys))
+	for _, k := range keys {
+		pairs = append(pairs, fmt.Sprintf("%s=%v", k, fields[k]))
+	}
+	if len(pairs) == 0 {
+		return msg
+	}
+	return fmt.Sprintf("%s: %s", msg, strings.Join(pairs, " "))
+}
+
+// LogTaskPoll logs the state of a polled prism task.
+func LogTaskPoll(format string, task prismConfig.Task, status string) {
+	var resource string
+	if len(task.EntitiesAffected) > 0 {
+		resource = StringValue(task.EntitiesAffected[0].Rel)
+	}
+
+	LogEvent(format, "DEBUG", "polling task", LogFields{
+		"resource":    resource,
+		"task_ext_id": StringValue(task.ExtId),
+		"status":      status,
+		"progress":    IntValue(task.ProgressPercentage),
+	})
+}
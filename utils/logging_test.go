@@ -0,0 +1,32 @@
+package utils
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestFormatLogEvent(t *testing.T) {
+	fields := LogFields{
+		"task_ext_id": "ZXJnb24=:abc",
+		"status":      "RUNNING",
+		"progress":    50,
+	}
+
+	got := formatLogEvent(LogFormatText, "polling task", fields)
+	want := "polling task: progress=50 status=RUNNING task_ext_id=ZXJnb24=:abc"
+	if got != want {
+		t.Errorf("text format: got %q, want %q", got, want)
+	}
+
+	if got := formatLogEvent(LogFormatText, "polling task", nil); got != "polling task" {
+		t.Errorf("text format without fields: got %q", got)
+	}
+
+	var event map[string]interface{}
+	if err := json.Unmarshal([]byte(formatLogEvent(LogFormatJSON, "polling task", fields)), &event); err != nil {
+		t.Fatalf("json format is not valid json: %v", err)
+	}
+	if event["message"] != "polling task" || event["status"] != "RUNNING" || event["progress"] != float64(50) {
+		t.Errorf("json format: unexpected event %v", event)
+	}
+}
@@ -0,0 +1,116 @@
+package utils
+
+import (
+	"fmt"
+	"strings"
+)
+
+// AndFilter appends clause to an OData $filter expression. A nil or empty
+// filter results in the clause alone, an empty clause leaves filter untouched.
+func AndFilter(filter *string, clause string) *string {
+	if clause == "" {
+		return filter
+	}
+	if filter == nil || *filter == "" {
+		return StringPtr(clause)
+	}
+	return StringPtr(fmt.Sprintf("(%s) and %s", *filter, clause))
+}
+
+// TenantFilter returns the OData clause scoping a list call to a tenant, or an
+// empty clause if tenantID is empty.
+func TenantFilter(tenantID string) string {
+	if tenantID == "" {
+		return ""
+	}
+	return fmt.Sprintf("tenantId eq %s", ODataString(tenantID))
+}
+
+// ODataString returns value as an OData string literal, quoted and with its
+// single quotes doubled, so that any value can be embedded in a $filter.
+func ODataString(value string) string {
+	return "'" + strings.ReplaceAll(value, "'", "''") + "'"
+}
+
+// operators users carry over from other query languages, which OData spells
+// differently
+var odataOperatorHints = map[string]string{
+	"==": "eq",
+	"!=": "ne",
+	"&&": "and",
+	"||": "or",
+}
+
+// CheckODataFilter checks the basic syntax of a $filter expression: string
+// literals are terminated, parentheses are balanced and only OData operators
+// are used. It is no full parser, it catches the mistakes which would
+// otherwise fail the list call with a bare 400.
+func CheckODataFilter(filter string) error {
+	depth := 0
+	literalStart := -1
+	for i := 0; i < len(filter); i++ {
+		c := filter[i]
+		if literalStart >= 0 {
+			if c == '\'' {
+				// a doubled quote is an escaped quote within the literal
+				if i+1 < len(filter) && filter[i+1] == '\'' {
+					i++
+					continue
+				}
+				literalStart = -1
+			}
+			continue
+		}
+		switch c {
+		case '\'':
+			literalStart = i
+		case '"':
+			return fmt.Errorf("malformed filter %q: string values are quoted with single quotes, e.g. name eq 'vg'", filter)
+		case '(':
+			depth++
+		case ')':
+			depth--
+			if depth < 0 {
+				return fmt.Errorf("malformed filter %q: unbalanced ) at position %d", filter, i)
+			}
+		}
+		if i+1 < len(filter) {
+			if op, ok := odataOperatorHints[filter[i:i+2]]; ok {
+				return fmt.Errorf("malformed filter %q: %s is not an OData operator, use %s", filter, filter[i:i+2], op)
+			}
+		}
+	}
+	if literalStart >= 0 {
+		return fmt.Errorf("malformed filter %q: unterminated string value starting at position %d, a quote within a value is escaped by doubling it, e.g. name eq 'O''Brien'", filter, literalStart)
+	}
+	if depth > 0 {
+		return fmt.Errorf("malformed filter %q: %d unclosed (", filter, depth)
+	}
+	return nil
+}
+
+// NormalizeODataFilter trims a user supplied $filter expression and checks it
+// with CheckODataFilter. An empty filter results in nil, i.e. no filter.
+func NormalizeODataFilter(filter string) (*string, error) {
+	filter = strings.TrimSpace(filter)
+	if filter == "" {
+		return nil, nil
+	}
+	if err := CheckODataFilter(filter); err != nil {
+		return nil, err
+	}
+	return StringPtr(filter), nil
+}
+
+// ValidateODataFilter is a schema.SchemaValidateFunc for filter arguments, see
+// CheckODataFilter.
+func ValidateODataFilter(v interface{}, k string) ([]string, []error) {
+	filter, ok := v.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %s to be string", k)}
+	}
+	if err := CheckODataFilter(filter); err != nil {
+		return nil, []error{err}
+	}
+	return nil, nil
+}
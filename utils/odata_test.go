@@ -0,0 +1,98 @@
+package utils
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestAndFilter(t *testing.T) {
+	cases := []struct {
+		filter *string
+		clause string
+		want   *string
+	}{
+		{nil, "", nil},
+		{nil, "tenantId eq 'a'", StringPtr("tenantId eq 'a'")},
+		{StringPtr(""), "tenantId eq 'a'", StringPtr("tenantId eq 'a'")},
+		{StringPtr("name eq 'vg'"), "", StringPtr("name eq 'vg'")},
+		{StringPtr("name eq 'vg' or name eq 'vg2'"), "tenantId eq 'a'", StringPtr("(name eq 'vg' or name eq 'vg2') and tenantId eq 'a'")},
+	}
+	for _, c := range cases {
+		got := AndFilter(c.filter, c.clause)
+		if StringValue(got) != StringValue(c.want) || (got == nil) != (c.want == nil) {
+			t.Errorf("AndFilter(%v, %q) = %v, want %v", StringValue(c.filter), c.clause, StringValue(got), StringValue(c.want))
+		}
+	}
+}
+
+func TestTenantFilter(t *testing.T) {
+	if got := TenantFilter(""); got != "" {
+		t.Errorf("empty tenant: got %q", got)
+	}
+	if got := TenantFilter("abc"); got != "tenantId eq 'abc'" {
+		t.Errorf("got %q", got)
+	}
+}
+
+func TestODataString(t *testing.T) {
+	cases := map[string]string{
+		"":        "''",
+		"vg":      "'vg'",
+		"O'Brien": "'O''Brien'",
+		"''":      "''''''",
+	}
+	for value, want := range cases {
+		if got := ODataString(value); got != want {
+			t.Errorf("ODataString(%q) = %q, want %q", value, got, want)
+		}
+		if err := CheckODataFilter("name eq " + ODataString(value)); err != nil {
+			t.Errorf("filter of ODataString(%q) is rejected: %v", value, err)
+		}
+	}
+}
+
+func TestCheckODataFilter(t *testing.T) {
+	valid := []string{
+		"name eq 'vg'",
+		"name eq 'O''Brien'",
+		"(name eq 'a' or name eq 'b') and tenantId eq 't'",
+		"startswith(displayName, 'Create_')",
+		"name eq 'a == b (c'",
+		"sizeBytes gt 1024",
+	}
+	for _, filter := range valid {
+		if err := CheckODataFilter(filter); err != nil {
+			t.Errorf("CheckODataFilter(%q) = %v, want nil", filter, err)
+		}
+	}
+
+	invalid := map[string]string{
+		"name eq 'O'Brien'":          "unterminated string value",
+		"name eq 'vg":                "unterminated string value",
+		"name eq \"vg\"":             "single quotes",
+		"(name eq 'vg'":              "unclosed (",
+		"name eq 'vg')":              "unbalanced )",
+		"name == 'vg'":               "use eq",
+		"name eq 'a' && name eq 'b'": "use and",
+		"name eq 'a' || name eq 'b'": "use or",
+		"name != 'vg'":               "use ne",
+	}
+	for filter, want := range invalid {
+		err := CheckODataFilter(filter)
+		if err == nil || !strings.Contains(err.Error(), want) {
+			t.Errorf("CheckODataFilter(%q) = %v, want an error containing %q", filter, err, want)
+		}
+	}
+}
+
+func TestNormalizeODataFilter(t *testing.T) {
+	if got, err := NormalizeODataFilter("  "); got != nil || err != nil {
+		t.Errorf("blank filter: got %v, %v", StringValue(got), err)
+	}
+	if got, err := NormalizeODataFilter(" name eq 'vg' "); err != nil || StringValue(got) != "name eq 'vg'" {
+		t.Errorf("got %q, %v", StringValue(got), err)
+	}
+	if _, err := NormalizeODataFilter("name eq 'vg"); err == nil {
+		t.Error("malformed filter: got no error")
+	}
+}
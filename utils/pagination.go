@@ -0,0 +1,47 @@
+package utils
+
+import "fmt"
+
+// DefaultPageLimit is the page size used while walking every page of a v4 list
+// call. It is the largest limit accepted by the v4 APIs.
+const DefaultPageLimit = 100
+
+// maxPages bounds the number of pages walked by ListAllPages so that a server
+// which keeps returning full pages can not trap the provider in an endless loop.
+const maxPages = 10000
+
+// PageFetcher fetches one page of a v4 list call. It returns the entities of
+// that page and the metadata.totalAvailableResults value of the response, which
+// may be nil if the server did not report it.
+type PageFetcher func(page, limit *int) ([]interface{}, *int, error)
+
+// ListAllPages returns the entities of a v4 list call.
+//
+// If the caller provided page or limit, the request is honoured as is and a
+// single page is returned. Otherwise every page is fetched with DefaultPageLimit
+// until totalAvailableResults entities have been accumulated or a short page
+// is returned.
+func ListAllPages(page, limit *int, fetch PageFetcher) ([]interface{}, error) {
+	if page != nil || limit != nil {
+		entities, _, err := fetch(page, limit)
+		return entities, err
+	}
+
+	entities := make([]interface{}, 0)
+	pageSize := DefaultPageLimit
+	for p := 0; p < maxPages; p++ {
+		pageEntities, total, err := fetch(IntPtr(p), IntPtr(pageSize))
+		if err != nil {
+			return nil, err
+		}
+		entities = append(entities, pageEntities...)
+
+		if len(pageEntities) < pageSize {
+			return entities, nil
+		}
+		if total != nil && len(entities) >= *total {
+			return entities, nil
+		}
+	}
+	return nil, fmt.Errorf("more than %d pages returned by the list call", maxPages)
+}
@@ -0,0 +1,65 @@
+package utils
+
+import (
+	"errors"
+	"testing"
+)
+
+func pagesFetcher(total int, calls *int) PageFetcher {
+	return func(page, limit *int) ([]interface{}, *int, error) {
+		*calls++
+		// the v4 APIs default to the first page of 50 records
+		pageSize := 50
+		if limit != nil {
+			pageSize = *limit
+		}
+		start := IntValue(page) * pageSize
+		items := make([]interface{}, 0)
+		for i := start; i < total && i < start+pageSize; i++ {
+			items = append(items, i)
+		}
+		return items, IntPtr(total), nil
+	}
+}
+
+func TestListAllPages(t *testing.T) {
+	cases := []struct {
+		name          string
+		total         int
+		page, limit   *int
+		expectedCount int
+		expectedCalls int
+	}{
+		{"empty", 0, nil, nil, 0, 1},
+		{"single short page", 42, nil, nil, 42, 1},
+		{"exact multiple of the page size", 2 * DefaultPageLimit, nil, nil, 2 * DefaultPageLimit, 2},
+		{"several pages", 2*DefaultPageLimit + 1, nil, nil, 2*DefaultPageLimit + 1, 3},
+		{"explicit page", 250, IntPtr(2), IntPtr(100), 50, 1},
+		{"explicit limit", 250, nil, IntPtr(3), 3, 1},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			calls := 0
+			entities, err := ListAllPages(tc.page, tc.limit, pagesFetcher(tc.total, &calls))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(entities) != tc.expectedCount {
+				t.Errorf("got %d entities, expected %d", len(entities), tc.expectedCount)
+			}
+			if calls != tc.expectedCalls {
+				t.Errorf("got %d calls, expected %d", calls, tc.expectedCalls)
+			}
+		})
+	}
+}
+
+func TestListAllPagesError(t *testing.T) {
+	_, err := ListAllPages(nil, nil, func(page, limit *int) ([]interface{}, *int, error) {
+		return nil, nil, errors.New("boom")
+	})
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+}
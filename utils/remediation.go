@@ -0,0 +1,112 @@
+package utils
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+// RemediationHint tells what to do about a well-known API failure. A hint with
+// Codes applies to a v4 API error whose code, as returned by ParseV4Error, is
+// one of them. A hint without Codes is a fallback applying to an error whose
+// HTTP status starts with Status, when no hint matched its code.
+type RemediationHint struct {
+	Codes  []string
+	Status string
+	Hint   string
+}
+
+// remediationHints are checked in order, the first match wins.
+var remediationHints = []RemediationHint{
+	{
+		Codes: []string{"VOL-10013"},
+		Hint:  "The sharing status of a Volume Group can not change while it has attachments. Detach the VMs and iSCSI clients first.",
+	},
+	{
+		Codes: []string{"VOL-10018"},
+		Hint:  "Load balancing of VM attachments can not be enabled while iSCSI clients are attached to the Volume Group, and vice versa. Detach the iSCSI clients first.",
+	},
+	{
+		Codes: []string{"VOL-10010"},
+		Hint:  "The Volume Group still has attachments. Detach them first or set force_detach = true to detach them on delete.",
+	},
+	{
+		Codes: []string{"CLU-10014"},
+		Hint:  "The Storage Container still holds vdisks or files. Remove them before deleting it.",
+	},
+	{
+		Codes: []string{"CLU-10021"},
+		Hint:  "The replication factor must be supported by the fault tolerance of the cluster, see the fault_tolerance_state of the Storage Container.",
+	},
+	{
+		Codes: []string{"IAM-10044"},
+		Hint:  "System defined roles can not be modified or deleted. Create a new role with the operations you need instead.",
+	},
+	{
+		Codes: []string{"IAM-10105"},
+		Hint:  "The password does not meet the password policy of Prism Central, use a longer password mixing upper and lower case letters, digits and special characters.",
+	},
+	{
+		Codes: []string{"VOL-10004", "CLU-10002", "IAM-10012"},
+		Hint:  "An entity with the same name already exists. Pick another name or import the existing entity into the state.",
+	},
+	{
+		Status: "412",
+		Hint:   "The entity was modified since it was read. Run terraform refresh and apply again.",
+	},
+	{
+		Status: "401",
+		Hint:   "The credentials of the provider were rejected. Check the username and password of the provider configuration.",
+	},
+	{
+		Status: "403",
+		Hint:   "The provider user is not allowed to perform this operation. Check the roles and authorization policies assigned to it.",
+	},
+}
+
+// RemediationHintFor returns the hint for a well-known failure, or an empty
+// string if err is not one.
+func RemediationHintFor(err error) string {
+	if err == nil {
+		return ""
+	}
+	v4Err := ParseV4Error(err)
+
+	if v4Err.Code != "" {
+		for _, h := range remediationHints {
+			for _, code := range h.Codes {
+				if code == v4Err.Code {
+					return h.Hint
+				}
+			}
+		}
+	}
+	status := strconv.Itoa(v4Err.HTTPStatus)
+	for _, h := range remediationHints {
+		if len(h.Codes) == 0 && v4Err.HTTPStatus != 0 && strings.HasPrefix(status, h.Status) {
+			return h.Hint
+		}
+	}
+	return ""
+}
+
+// WithRemediationHint sets the remediation hint of err, if it is a well-known
+// failure, as the detail of the error diagnostics of diags.
+func WithRemediationHint(diags diag.Diagnostics, err error) diag.Diagnostics {
+	hint := RemediationHintFor(err)
+	if hint == "" {
+		return diags
+	}
+	for i := range diags {
+		if diags[i].Severity != diag.Error {
+			continue
+		}
+		if diags[i].Detail == "" {
+			diags[i].Detail = hint
+		} else {
+			diags[i].Detail += "\n\n" + hint
+		}
+	}
+	return diags
+}
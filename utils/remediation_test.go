@@ -0,0 +1,76 @@
+package utils
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	volumesClient "github.com/nutanix/ntnx-api-golang-clients/volumes-go-client/v4/client"
+)
+
+// v4ErrorBody returns a v4 API error response body holding a single message.
+func v4ErrorBody(namespace, code, message string) []byte {
+	return []byte(`{"data":{"error":[{"message":"` + message + `","severity":"ERROR","code":"` + code + `","locale":"en_US",` +
+		`"errorGroup":"` + namespace + `_ERROR","$objectType":"` + namespace + `.v4.error.AppMessage"}],` +
+		`"$errorItemDiscriminator":"List<` + namespace + `.v4.error.AppMessage>","$objectType":"` + namespace + `.v4.error.ErrorResponse"},` +
+		`"$dataItemDiscriminator":"` + namespace + `.v4.error.ErrorResponse"}`)
+}
+
+func TestRemediationHintFor(t *testing.T) {
+	cases := []struct {
+		name string
+		err  error
+		want string
+	}{
+		{"nil", nil, ""},
+		{"unknown", errors.New("connection refused"), ""},
+		{
+			"sharing status",
+			volumesClient.GenericOpenAPIError{Status: "400 Bad Request", Body: v4ErrorBody("volumes", "VOL-10013", "Cannot change the sharing status of a Volume Group with attachments")},
+			remediationHints[0].Hint,
+		},
+		{
+			"attachments on delete",
+			&volumesClient.GenericOpenAPIError{Status: "409 Conflict", Body: v4ErrorBody("volumes", "VOL-10010", "Volume group has attachments")},
+			"The Volume Group still has attachments. Detach them first or set force_detach = true to detach them on delete.",
+		},
+		{
+			"code wins over status",
+			volumesClient.GenericOpenAPIError{Status: "403 Forbidden", Body: v4ErrorBody("iam", "IAM-10044", "Cannot modify a system defined role")},
+			"System defined roles can not be modified or deleted. Create a new role with the operations you need instead.",
+		},
+		{
+			"message is not matched",
+			volumesClient.GenericOpenAPIError{Status: "400 Bad Request", Body: v4ErrorBody("volumes", "VOL-40001", "Volume group already exists")},
+			"",
+		},
+		{
+			"unknown code falls back to the status",
+			volumesClient.GenericOpenAPIError{Status: "403 Forbidden", Body: v4ErrorBody("volumes", "VOL-40003", "Access denied")},
+			"The provider user is not allowed to perform this operation. Check the roles and authorization policies assigned to it.",
+		},
+		{"etag without code", volumesClient.GenericOpenAPIError{Status: "412 Precondition Failed", Body: []byte(`{}`)}, "The entity was modified since it was read. Run terraform refresh and apply again."},
+		{"status without hint", volumesClient.GenericOpenAPIError{Status: "500 Internal Server Error", Body: []byte(`upstream error`)}, ""},
+	}
+
+	for _, tc := range cases {
+		if got := RemediationHintFor(tc.err); got != tc.want {
+			t.Errorf("%s: got %q, want %q", tc.name, got, tc.want)
+		}
+	}
+}
+
+func TestWithRemediationHint(t *testing.T) {
+	err := volumesClient.GenericOpenAPIError{Status: "403 Forbidden", Body: []byte(`{}`)}
+
+	diags := WithRemediationHint(diag.Errorf("error while creating Volume Group : %v", err), err)
+	if len(diags) != 1 || diags[0].Detail != RemediationHintFor(err) {
+		t.Errorf("expected the hint as detail, got %+v", diags)
+	}
+
+	other := errors.New("connection refused")
+	diags = WithRemediationHint(diag.Errorf("error while creating Volume Group : %v", other), other)
+	if len(diags) != 1 || diags[0].Detail != "" {
+		t.Errorf("expected no detail, got %+v", diags)
+	}
+}
@@ -0,0 +1,153 @@
+package utils
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// DefaultTaskPollBackoffBase is the wait before the first retry of a failed
+// task poll when task_poll_retry does not set backoff_base.
+const DefaultTaskPollBackoffBase = time.Second
+
+// MaxTaskPollBackoff bounds the wait between two retries of a task poll.
+const MaxTaskPollBackoff = time.Minute
+
+// TaskPollRetry is how often a task poll failing with a transient error is
+// retried. The wait doubles from BackoffBase after every retry. The zero value
+// does not retry, a failed poll fails the operation.
+type TaskPollRetry struct {
+	MaxRetries  int
+	BackoffBase time.Duration
+}
+
+// IsTransientError reports whether err may go away by itself: an error without
+// an HTTP status, e.g. a connection reset, a 429 or a 5xx.
+func IsTransientError(err error) bool {
+	if err == nil {
+		return false
+	}
+	status := ParseV4Error(err).HTTPStatus
+	return status == 0 || status == 429 || status >= 500
+}
+
+// Do calls fn until it succeeds, fails with an error which is not transient,
+// or MaxRetries retries have failed. It returns the last error of fn, or the
+// context error if the context is done while waiting.
+func (r TaskPollRetry) Do(ctx context.Context, fn func() error) error {
+	backoff := r.BackoffBase
+	if backoff <= 0 {
+		backoff = DefaultTaskPollBackoffBase
+	}
+
+	for retry := 0; ; retry++ {
+		err := fn()
+		if err == nil || retry >= r.MaxRetries || !IsTransientError(err) {
+			return err
+		}
+
+		log.Printf("[DEBUG] task poll failed, retrying in %s (%d/%d) : %v", backoff, retry+1, r.MaxRetries, err)
+		select {
+		case <-time.After(backoff):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+		backoff *= 2
+		if backoff > MaxTaskPollBackoff {
+			backoff = MaxTaskPollBackoff
+		}
+	}
+}
+
+// TaskPollRetrySchema is the optional task_poll_retry block of the resources
+// whose task polling retries can be tuned, read with ExpandTaskPollRetry.
+func TaskPollRetrySchema() *schema.Schema {
+	return &schema.Schema{
+		Description: "How the polling of the tasks of this resource retries transient errors, e.g. a busy Prism Central. By default a failed poll fails the operation.",
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"max_retries": {
+					Description:  "The number of retries of a task poll failing with a transient error.",
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      0,
+					ValidateFunc: validation.IntAtLeast(0),
+				},
+				"backoff_base": {
+					Description:  "The wait in seconds before the first retry, doubled after every retry up to a minute.",
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      int(DefaultTaskPollBackoffBase / time.Second),
+					ValidateFunc: validation.IntAtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+// ExpandTaskPollRetry returns the TaskPollRetry of a task_poll_retry block.
+func ExpandTaskPollRetry(taskPollRetry interface{}) TaskPollRetry {
+	list, ok := taskPollRetry.([]interface{})
+	if !ok || len(list) == 0 || list[0] == nil {
+		return TaskPollRetry{}
+	}
+	config := list[0].(map[string]interface{})
+	return TaskPollRetry{
+		MaxRetries:  config["max_retries"].(int),
+		BackoffBase: time.Duration(config["backoff_base"].(int)) * time.Second,
+	}
+}
+
+// NotFoundRetry is how long an entity which was just created may still be
+// reported as not found, e.g. while a busy Prism Central catches up after the
+// create task, before the read gives up on it. The wait between two reads
+// doubles from BackoffBase up to MaxTaskPollBackoff.
+type NotFoundRetry struct {
+	Timeout     time.Duration
+	BackoffBase time.Duration
+}
+
+// CreateNotFoundRetry is the NotFoundRetry of the read following a create.
+var CreateNotFoundRetry = NotFoundRetry{Timeout: 30 * time.Second, BackoffBase: DefaultTaskPollBackoffBase}
+
+// Do calls fn until it succeeds, fails with an error which is not a v4 not
+// found error, or Timeout has elapsed. It returns the last error of fn, or the
+// context error if the context is done while waiting.
+func (r NotFoundRetry) Do(ctx context.Context, fn func() error) error {
+	backoff := r.BackoffBase
+	if backoff <= 0 {
+		backoff = DefaultTaskPollBackoffBase
+	}
+	deadline := time.Now().Add(r.Timeout)
+
+	for {
+		err := fn()
+		if err == nil || !IsV4NotFound(err) {
+			return err
+		}
+		remaining := time.Until(deadline)
+		if remaining <= 0 {
+			return err
+		}
+		if backoff > remaining {
+			backoff = remaining
+		}
+
+		log.Printf("[DEBUG] entity not found yet, retrying in %s : %v", backoff, err)
+		select {
+		case <-time.After(backoff):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+		backoff *= 2
+		if backoff > MaxTaskPollBackoff {
+			backoff = MaxTaskPollBackoff
+		}
+	}
+}
@@ -0,0 +1,140 @@
+package utils
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	volumesClient "github.com/nutanix/ntnx-api-golang-clients/volumes-go-client/v4/client"
+)
+
+func TestIsTransientError(t *testing.T) {
+	cases := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"nil", nil, false},
+		{"connection error", errors.New("connection reset by peer"), true},
+		{"too many requests", volumesClient.GenericOpenAPIError{Status: "429 Too Many Requests"}, true},
+		{"server error", volumesClient.GenericOpenAPIError{Status: "503 Service Unavailable"}, true},
+		{"not found", volumesClient.GenericOpenAPIError{Status: "404 Not Found"}, false},
+		{"forbidden", &volumesClient.GenericOpenAPIError{Status: "403 Forbidden"}, false},
+	}
+
+	for _, tc := range cases {
+		if got := IsTransientError(tc.err); got != tc.want {
+			t.Errorf("%s: got %v, want %v", tc.name, got, tc.want)
+		}
+	}
+}
+
+func TestTaskPollRetryDo(t *testing.T) {
+	transient := volumesClient.GenericOpenAPIError{Status: "503 Service Unavailable"}
+	permanent := volumesClient.GenericOpenAPIError{Status: "404 Not Found"}
+
+	cases := []struct {
+		name          string
+		retry         TaskPollRetry
+		failures      int
+		err           error
+		expectedCalls int
+		expectError   bool
+	}{
+		{"no retry by default", TaskPollRetry{}, 1, transient, 1, true},
+		{"recovers within the retries", TaskPollRetry{MaxRetries: 3, BackoffBase: time.Millisecond}, 2, transient, 3, false},
+		{"gives up after the retries", TaskPollRetry{MaxRetries: 2, BackoffBase: time.Millisecond}, 5, transient, 3, true},
+		{"does not retry a permanent error", TaskPollRetry{MaxRetries: 3, BackoffBase: time.Millisecond}, 1, permanent, 1, true},
+	}
+
+	for _, tc := range cases {
+		calls := 0
+		err := tc.retry.Do(context.Background(), func() error {
+			calls++
+			if calls <= tc.failures {
+				return tc.err
+			}
+			return nil
+		})
+		if calls != tc.expectedCalls {
+			t.Errorf("%s: got %d calls, want %d", tc.name, calls, tc.expectedCalls)
+		}
+		if (err != nil) != tc.expectError {
+			t.Errorf("%s: got error %v, expected an error: %v", tc.name, err, tc.expectError)
+		}
+	}
+}
+
+func TestTaskPollRetryDoContextDone(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err := TaskPollRetry{MaxRetries: 1, BackoffBase: time.Hour}.Do(ctx, func() error {
+		return errors.New("connection reset by peer")
+	})
+	if err != context.Canceled {
+		t.Errorf("got error %v, want %v", err, context.Canceled)
+	}
+}
+
+func TestExpandTaskPollRetry(t *testing.T) {
+	if got := ExpandTaskPollRetry(nil); got != (TaskPollRetry{}) {
+		t.Errorf("got %+v for no block, want the zero value", got)
+	}
+	got := ExpandTaskPollRetry([]interface{}{map[string]interface{}{"max_retries": 4, "backoff_base": 2}})
+	want := TaskPollRetry{MaxRetries: 4, BackoffBase: 2 * time.Second}
+	if got != want {
+		t.Errorf("got %+v, want %+v", got, want)
+	}
+}
+
+func TestNotFoundRetryDo(t *testing.T) {
+	notFound := volumesClient.GenericOpenAPIError{Status: "404 Not Found"}
+	forbidden := volumesClient.GenericOpenAPIError{Status: "403 Forbidden"}
+
+	cases := []struct {
+		name          string
+		retry         NotFoundRetry
+		failures      int
+		err           error
+		expectedCalls int
+		expectError   bool
+	}{
+		{"found at once", NotFoundRetry{Timeout: time.Second, BackoffBase: time.Millisecond}, 0, notFound, 1, false},
+		{"found after a few reads", NotFoundRetry{Timeout: time.Second, BackoffBase: time.Millisecond}, 3, notFound, 4, false},
+		{"no retry without a timeout", NotFoundRetry{BackoffBase: time.Millisecond}, 5, notFound, 1, true},
+		{"does not retry another error", NotFoundRetry{Timeout: time.Second, BackoffBase: time.Millisecond}, 5, forbidden, 1, true},
+	}
+
+	for _, tc := range cases {
+		calls := 0
+		err := tc.retry.Do(context.Background(), func() error {
+			calls++
+			if calls <= tc.failures {
+				return tc.err
+			}
+			return nil
+		})
+		if calls != tc.expectedCalls {
+			t.Errorf("%s: got %d calls, want %d", tc.name, calls, tc.expectedCalls)
+		}
+		if (err != nil) != tc.expectError {
+			t.Errorf("%s: got error %v, expected an error: %v", tc.name, err, tc.expectError)
+		}
+	}
+}
+
+func TestNotFoundRetryDoGivesUp(t *testing.T) {
+	notFound := volumesClient.GenericOpenAPIError{Status: "404 Not Found"}
+	calls := 0
+	err := NotFoundRetry{Timeout: 20 * time.Millisecond, BackoffBase: time.Millisecond}.Do(context.Background(), func() error {
+		calls++
+		return notFound
+	})
+	if !IsV4NotFound(err) {
+		t.Errorf("got error %v, want the last not found error", err)
+	}
+	if calls < 2 {
+		t.Errorf("got %d calls, want the read to be retried", calls)
+	}
+}
@@ -0,0 +1,162 @@
+package utils
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+
+	prismConfig "github.com/nutanix/ntnx-api-golang-clients/prism-go-client/v4/models/prism/v4/config"
+)
+
+// TaskEntitiesAffected returns the entities touched by a prism task as
+// "rel:ext_id" (or just ext_id when the task does not report rel), so that a
+// failed multi entity task still tells which entities were created.
+func TaskEntitiesAffected(task prismConfig.Task) []string {
+	entities := make([]string, 0, len(task.EntitiesAffected))
+	for _, entity := range task.EntitiesAffected {
+		if entity.ExtId == nil {
+			continue
+		}
+		if rel := StringValue(entity.Rel); rel != "" {
+			entities = append(entities, fmt.Sprintf("%s:%s", rel, *entity.ExtId))
+			continue
+		}
+		entities = append(entities, *entity.ExtId)
+	}
+	return entities
+}
+
+// FormatTaskEntitiesAffected formats TaskEntitiesAffected for error messages.
+func FormatTaskEntitiesAffected(task prismConfig.Task) string {
+	return fmt.Sprintf("[%s]", strings.Join(TaskEntitiesAffected(task), ", "))
+}
+
+// TaskPendingStates are the prism task statuses, as named by TaskStatusName, on
+// which polling keeps waiting. CANCELING and SUSPENDED are emitted by newer
+// Prism Central builds and end in one of the final states.
+var TaskPendingStates = []string{"PENDING", "QUEUED", "RUNNING", "CANCELING", "SUSPENDED"}
+
+// TaskTargetStates are the prism task statuses ending a successful poll.
+var TaskTargetStates = []string{"SUCCEEDED"}
+
+// TaskFailedStates are the prism task statuses ending a failed poll.
+var TaskFailedStates = []string{"FAILED", "CANCELED"}
+
+// TaskStatusName returns the name polling uses for a prism task status, or
+// UNKNOWN when the status is missing or not known to the SDK.
+func TaskStatusName(status *prismConfig.TaskStatus) string {
+	if status == nil {
+		return "UNKNOWN"
+	}
+	switch *status {
+	case prismConfig.TASKSTATUS_QUEUED, prismConfig.TASKSTATUS_RUNNING, prismConfig.TASKSTATUS_CANCELING,
+		prismConfig.TASKSTATUS_SUCCEEDED, prismConfig.TASKSTATUS_FAILED, prismConfig.TASKSTATUS_CANCELED,
+		prismConfig.TASKSTATUS_SUSPENDED:
+		return status.GetName()
+	}
+	return "UNKNOWN"
+}
+
+// MaxUnknownTaskPolls bounds the consecutive polls of a task in a status the SDK
+// does not know before TaskStatusPoll gives up on it.
+const MaxUnknownTaskPolls = 30
+
+// TaskStatusPoll names the statuses of one task along its polling. A status the
+// SDK does not know, e.g. one added by a newer Prism Central, is logged with its
+// raw value and reported as PENDING so polling goes on, until it has been seen
+// MaxUnknownTaskPolls times in a row.
+type TaskStatusPoll struct {
+	unknownPolls int
+}
+
+// Status returns the polling name of status, see TaskStatusPoll.
+func (p *TaskStatusPoll) Status(taskUUID string, status *prismConfig.TaskStatus) (string, error) {
+	name := TaskStatusName(status)
+	if name != "UNKNOWN" {
+		p.unknownPolls = 0
+		return name, nil
+	}
+
+	p.unknownPolls++
+	raw := -1
+	if status != nil {
+		raw = int(*status)
+	}
+	log.Printf("[WARN] task %s reported unknown status %d (%d/%d)", taskUUID, raw, p.unknownPolls, MaxUnknownTaskPolls)
+	if p.unknownPolls >= MaxUnknownTaskPolls {
+		return name, fmt.Errorf("task %s reported unknown status %d for %d polls in a row", taskUUID, raw, p.unknownPolls)
+	}
+	return "PENDING", nil
+}
+
+// IsTaskFailed reports whether status, as named by TaskStatusName, is a failed final state.
+func IsTaskFailed(status string) bool {
+	for _, s := range TaskFailedStates {
+		if s == status {
+			return true
+		}
+	}
+	return false
+}
+
+// TaskFailedError is the error of a task poll ending in a failed state: the
+// task could be fetched but the operation it runs failed, polling it again
+// will not change that.
+type TaskFailedError struct {
+	TaskExtID          string
+	Status             string
+	Messages           []string
+	ProgressPercentage int
+	EntitiesAffected   []string
+}
+
+// NewTaskFailedError returns the TaskFailedError of task, which ended in
+// status as named by TaskStatusName.
+func NewTaskFailedError(taskExtID, status string, task prismConfig.Task) *TaskFailedError {
+	messages := make([]string, 0, len(task.ErrorMessages))
+	for _, m := range task.ErrorMessages {
+		if message := StringValue(m.Message); message != "" {
+			messages = append(messages, message)
+		}
+	}
+	return &TaskFailedError{
+		TaskExtID:          taskExtID,
+		Status:             status,
+		Messages:           messages,
+		ProgressPercentage: IntValue(task.ProgressPercentage),
+		EntitiesAffected:   TaskEntitiesAffected(task),
+	}
+}
+
+func (e *TaskFailedError) Error() string {
+	return fmt.Sprintf("error_detail: %s, progress_message: %d, entities_affected: [%s]",
+		strings.Join(e.Messages, "; "), e.ProgressPercentage, strings.Join(e.EntitiesAffected, ", "))
+}
+
+// TaskPollError is the error of a task poll whose request failed, e.g. on a
+// connection error or an API error. The task itself may still be running.
+type TaskPollError struct {
+	TaskExtID string
+	Err       error
+}
+
+func (e *TaskPollError) Error() string {
+	return fmt.Sprintf("error while polling prism task: %v", e.Err)
+}
+
+func (e *TaskPollError) Unwrap() error {
+	return e.Err
+}
+
+// IsTaskFailedError reports whether err is, or wraps, a TaskFailedError.
+func IsTaskFailedError(err error) bool {
+	var taskErr *TaskFailedError
+	return errors.As(err, &taskErr)
+}
+
+// IsTaskPollError reports whether err is, or wraps, a TaskPollError.
+func IsTaskPollError(err error) bool {
+	var pollErr *TaskPollError
+	return errors.As(err, &pollErr)
+}
@@ -0,0 +1,143 @@
+package utils
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+
+	prismConfig "github.com/nutanix/ntnx-api-golang-clients/prism-go-client/v4/models/prism/v4/config"
+	prismError "github.com/nutanix/ntnx-api-golang-clients/prism-go-client/v4/models/prism/v4/error"
+)
+
+func TestTaskEntitiesAffected(t *testing.T) {
+	task := prismConfig.Task{
+		EntitiesAffected: []prismConfig.EntityReference{
+			{ExtId: StringPtr("vg-1"), Rel: StringPtr("volumes:config:volume-group")},
+			{ExtId: StringPtr("disk-1")},
+			{Rel: StringPtr("volumes:config:disk")},
+		},
+	}
+
+	got := TaskEntitiesAffected(task)
+	want := []string{"volumes:config:volume-group:vg-1", "disk-1"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+
+	if got := FormatTaskEntitiesAffected(task); got != "[volumes:config:volume-group:vg-1, disk-1]" {
+		t.Errorf("unexpected format %q", got)
+	}
+	if got := FormatTaskEntitiesAffected(prismConfig.Task{}); got != "[]" {
+		t.Errorf("unexpected format for no entities %q", got)
+	}
+}
+
+func TestTaskStatusName(t *testing.T) {
+	cases := []struct {
+		status *prismConfig.TaskStatus
+		want   string
+	}{
+		{nil, "UNKNOWN"},
+		{prismConfig.TASKSTATUS_QUEUED.Ref(), "QUEUED"},
+		{prismConfig.TASKSTATUS_RUNNING.Ref(), "RUNNING"},
+		{prismConfig.TASKSTATUS_CANCELING.Ref(), "CANCELING"},
+		{prismConfig.TASKSTATUS_SUCCEEDED.Ref(), "SUCCEEDED"},
+		{prismConfig.TASKSTATUS_FAILED.Ref(), "FAILED"},
+		{prismConfig.TASKSTATUS_CANCELED.Ref(), "CANCELED"},
+		{prismConfig.TASKSTATUS_SUSPENDED.Ref(), "SUSPENDED"},
+		{prismConfig.TASKSTATUS_REDACTED.Ref(), "UNKNOWN"},
+	}
+	for _, tc := range cases {
+		if got := TaskStatusName(tc.status); got != tc.want {
+			t.Errorf("got %q, want %q", got, tc.want)
+		}
+	}
+}
+
+func TestTaskStatesCoverEveryStatus(t *testing.T) {
+	// every status the SDK knows must either keep polling or end it
+	for s := prismConfig.TASKSTATUS_QUEUED; s <= prismConfig.TASKSTATUS_SUSPENDED; s++ {
+		status := s
+		name := TaskStatusName(&status)
+		known := IsTaskFailed(name)
+		for _, v := range append(TaskPendingStates, TaskTargetStates...) {
+			known = known || v == name
+		}
+		if !known {
+			t.Errorf("task status %s is neither pending, target nor failed", name)
+		}
+	}
+}
+
+func TestTaskStatusPoll(t *testing.T) {
+	poll := &TaskStatusPoll{}
+	unknown := prismConfig.TASKSTATUS_UNKNOWN.Ref()
+
+	for i := 1; i < MaxUnknownTaskPolls; i++ {
+		status, err := poll.Status("task", unknown)
+		if err != nil || status != "PENDING" {
+			t.Fatalf("poll %d: got %q, %v, want PENDING while under the bound", i, status, err)
+		}
+	}
+
+	// a known status resets the count
+	if status, err := poll.Status("task", prismConfig.TASKSTATUS_RUNNING.Ref()); err != nil || status != "RUNNING" {
+		t.Fatalf("got %q, %v, want RUNNING", status, err)
+	}
+	for i := 1; i < MaxUnknownTaskPolls; i++ {
+		if _, err := poll.Status("task", nil); err != nil {
+			t.Fatalf("poll %d: unexpected error after reset: %v", i, err)
+		}
+	}
+
+	if _, err := poll.Status("task", unknown); err == nil {
+		t.Errorf("expected an error after %d unknown polls in a row", MaxUnknownTaskPolls)
+	}
+}
+
+func TestTaskFailedError(t *testing.T) {
+	task := prismConfig.Task{
+		ErrorMessages: []prismError.AppMessage{
+			{Message: StringPtr("disk is in use")},
+			{},
+			{Message: StringPtr("rolled back")},
+		},
+		ProgressPercentage: IntPtr(40),
+		EntitiesAffected: []prismConfig.EntityReference{
+			{ExtId: StringPtr("vg-1"), Rel: StringPtr("volumes:config:volume-group")},
+		},
+	}
+
+	err := error(NewTaskFailedError("task-1", "FAILED", task))
+	want := "error_detail: disk is in use; rolled back, progress_message: 40, entities_affected: [volumes:config:volume-group:vg-1]"
+	if err.Error() != want {
+		t.Errorf("got %q, want %q", err.Error(), want)
+	}
+	if !IsTaskFailedError(fmt.Errorf("error waiting for task: %w", err)) {
+		t.Error("a wrapped TaskFailedError must be reported as such")
+	}
+	if IsTaskPollError(err) {
+		t.Error("a TaskFailedError is not a TaskPollError")
+	}
+
+	// a failed task without messages must not panic
+	if got := NewTaskFailedError("task-2", "CANCELED", prismConfig.Task{}).Error(); got != "error_detail: , progress_message: 0, entities_affected: []" {
+		t.Errorf("unexpected error for a task without messages %q", got)
+	}
+}
+
+func TestTaskPollError(t *testing.T) {
+	cause := errors.New("connection reset by peer")
+	err := error(&TaskPollError{TaskExtID: "task-1", Err: cause})
+
+	if err.Error() != "error while polling prism task: connection reset by peer" {
+		t.Errorf("unexpected error %q", err.Error())
+	}
+	if !errors.Is(err, cause) {
+		t.Error("a TaskPollError must unwrap to its cause")
+	}
+	if !IsTaskPollError(fmt.Errorf("error waiting for task: %w", err)) || IsTaskFailedError(err) {
+		t.Error("a TaskPollError must only be reported as such")
+	}
+}
@@ -0,0 +1,124 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	prismConfig "github.com/nutanix/ntnx-api-golang-clients/prism-go-client/v4/models/prism/v4/config"
+)
+
+// MaxTaskWatchBatch bounds the number of tasks fetched by a single request of
+// a TaskWatcher, larger batches are split.
+const MaxTaskWatchBatch = 50
+
+// TaskFetcher fetches the prism tasks with the given ext ids in one request.
+// Tasks it does not return are reported as not found to their watchers.
+type TaskFetcher func(extIDs []string) ([]prismConfig.Task, error)
+
+// TaskWatcher batches the polls of tasks running at the same time, e.g. during
+// an apply creating many resources, into one request per interval instead of
+// one request per task. A nil TaskWatcher does not batch anything.
+type TaskWatcher struct {
+	fetch    TaskFetcher
+	interval time.Duration
+
+	mu      sync.Mutex
+	waiting map[string][]chan taskWatchResult
+}
+
+type taskWatchResult struct {
+	task prismConfig.Task
+	err  error
+}
+
+// NewTaskWatcher returns a watcher fetching the tasks polled within interval
+// with fetch. It returns nil, i.e. no batching, if interval is not positive.
+func NewTaskWatcher(fetch TaskFetcher, interval time.Duration) *TaskWatcher {
+	if interval <= 0 {
+		return nil
+	}
+	return &TaskWatcher{
+		fetch:    fetch,
+		interval: interval,
+		waiting:  make(map[string][]chan taskWatchResult),
+	}
+}
+
+// Get returns the task extID, fetched together with the other tasks polled
+// until the end of the current interval. It returns the context error if the
+// context is done before.
+func (w *TaskWatcher) Get(ctx context.Context, extID string) (prismConfig.Task, error) {
+	result := make(chan taskWatchResult, 1)
+
+	w.mu.Lock()
+	if len(w.waiting) == 0 {
+		time.AfterFunc(w.interval, w.flush)
+	}
+	w.waiting[extID] = append(w.waiting[extID], result)
+	w.mu.Unlock()
+
+	select {
+	case r := <-result:
+		return r.task, r.err
+	case <-ctx.Done():
+		return prismConfig.Task{}, ctx.Err()
+	}
+}
+
+// flush fetches every task polled since the previous flush and hands them to
+// their watchers.
+func (w *TaskWatcher) flush() {
+	w.mu.Lock()
+	waiting := w.waiting
+	w.waiting = make(map[string][]chan taskWatchResult)
+	w.mu.Unlock()
+
+	extIDs := make([]string, 0, len(waiting))
+	for extID := range waiting {
+		extIDs = append(extIDs, extID)
+	}
+
+	for start := 0; start < len(extIDs); start += MaxTaskWatchBatch {
+		end := start + MaxTaskWatchBatch
+		if end > len(extIDs) {
+			end = len(extIDs)
+		}
+		batch := extIDs[start:end]
+
+		tasks, err := w.fetch(batch)
+		found := make(map[string]prismConfig.Task, len(tasks))
+		for _, task := range tasks {
+			found[StringValue(task.ExtId)] = task
+		}
+
+		for _, extID := range batch {
+			r := taskWatchResult{err: err}
+			if err == nil {
+				task, ok := found[extID]
+				if ok {
+					r.task = task
+				} else {
+					r.err = fmt.Errorf("task %s not found", extID)
+				}
+			}
+			for _, result := range waiting[extID] {
+				result <- r
+			}
+		}
+	}
+}
+
+// TaskExtIDsFilter returns the OData clause selecting the tasks with the given
+// ext ids.
+func TaskExtIDsFilter(extIDs []string) string {
+	filter := ""
+	for i, extID := range extIDs {
+		if i > 0 {
+			filter += " or "
+		}
+		filter += fmt.Sprintf("extId eq %s", ODataString(extID))
+	}
+	return filter
+}
@@ -0,0 +1,96 @@
+package utils
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	prismConfig "github.com/nutanix/ntnx-api-golang-clients/prism-go-client/v4/models/prism/v4/config"
+)
+
+func TestTaskWatcherBatches(t *testing.T) {
+	const tasks = 2*MaxTaskWatchBatch + 1
+
+	var mu sync.Mutex
+	var requests int
+	fetch := func(extIDs []string) ([]prismConfig.Task, error) {
+		mu.Lock()
+		requests++
+		mu.Unlock()
+		if len(extIDs) > MaxTaskWatchBatch {
+			t.Errorf("got a batch of %d tasks, want at most %d", len(extIDs), MaxTaskWatchBatch)
+		}
+		result := make([]prismConfig.Task, len(extIDs))
+		for i, extID := range extIDs {
+			result[i] = prismConfig.Task{ExtId: StringPtr(extID)}
+		}
+		return result, nil
+	}
+	w := NewTaskWatcher(fetch, 10*time.Millisecond)
+
+	var wg sync.WaitGroup
+	for i := 0; i < tasks; i++ {
+		wg.Add(1)
+		go func(extID string) {
+			defer wg.Done()
+			task, err := w.Get(context.Background(), extID)
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			if StringValue(task.ExtId) != extID {
+				t.Errorf("got task %s, want %s", StringValue(task.ExtId), extID)
+			}
+		}(fmt.Sprintf("task-%d", i))
+	}
+	wg.Wait()
+
+	if requests > 3*2 {
+		t.Errorf("got %d requests for %d tasks, expected them to be batched", requests, tasks)
+	}
+}
+
+func TestTaskWatcherErrors(t *testing.T) {
+	fetchErr := errors.New("boom")
+	w := NewTaskWatcher(func(extIDs []string) ([]prismConfig.Task, error) {
+		return nil, fetchErr
+	}, time.Millisecond)
+	if _, err := w.Get(context.Background(), "task"); err != fetchErr {
+		t.Errorf("got error %v, want %v", err, fetchErr)
+	}
+
+	w = NewTaskWatcher(func(extIDs []string) ([]prismConfig.Task, error) {
+		return nil, nil
+	}, time.Millisecond)
+	if _, err := w.Get(context.Background(), "task"); err == nil {
+		t.Errorf("expected an error for a task missing from the response")
+	}
+}
+
+func TestTaskWatcherContextDone(t *testing.T) {
+	w := NewTaskWatcher(func(extIDs []string) ([]prismConfig.Task, error) {
+		return nil, nil
+	}, time.Hour)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := w.Get(ctx, "task"); err != context.Canceled {
+		t.Errorf("got error %v, want %v", err, context.Canceled)
+	}
+}
+
+func TestTaskWatcherDisabled(t *testing.T) {
+	if w := NewTaskWatcher(nil, 0); w != nil {
+		t.Errorf("expected no watcher for an interval of 0")
+	}
+}
+
+func TestTaskExtIDsFilter(t *testing.T) {
+	got := TaskExtIDsFilter([]string{"a", "b"})
+	want := "extId eq 'a' or extId eq 'b'"
+	if got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
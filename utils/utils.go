@@ -102,15 +102,8 @@ func HashcodeStrings(strings []string) string {
 	return fmt.Sprintf("%d", HashcodeString(buf.String()))
 }
 
-// Extract error from v4 API response
+// ExtractErrorFromV4APIResponse returns the message of a v4 API error, see
+// ParseV4Error for its code and HTTP status.
 func ExtractErrorFromV4APIResponse(err error) string {
-	var errordata map[string]interface{}
-	e := json.Unmarshal([]byte(err.Error()), &errordata)
-	if e != nil {
-		return e.Error()
-	}
-	data := errordata["data"].(map[string]interface{})
-	errorList := data["error"].([]interface{})
-	errorMessage := errorList[0].(map[string]interface{})["message"]
-	return errorMessage.(string)
+	return ParseV4Error(err).Message
 }